@@ -4,16 +4,267 @@ CREATE EXTENSION IF NOT EXISTS pg_trgm;
 -- 用户表
 CREATE TABLE public.users
 (
-    id            bigserial      NOT NULL PRIMARY KEY,
-    nickname      varchar(20)    NOT NULL,
-    email         varchar(80)    NOT NULL UNIQUE,
-    github_id     varchar(60)    NULL UNIQUE,
-    --     google_id     varchar(60) NULL UNIQUE,
-    password_hash text           NULL,
+    id                    bigserial      NOT NULL PRIMARY KEY,
+    nickname              varchar(20)    NOT NULL,
+    avatar                varchar(500)   NULL,
+    email                 varchar(80)    NULL UNIQUE,
+    password_hash         text           NULL,
+    email_verified_at     timestamptz(6) NULL,
+    phone                 varchar(20)    NULL UNIQUE,
+    phone_verified_at     timestamptz(6) NULL,
+    created_at            timestamptz(6) NOT NULL DEFAULT now(),
+    updated_at            timestamptz(6) NOT NULL DEFAULT now(),
+    last_login_at         timestamptz(6) NOT NULL,
+    -- 管理员禁用账号的时间 禁用后无法登录与刷新令牌
+    disabled_at           timestamptz(6) NULL,
+    -- 申请注销后到期清理的时间 宽限期内可登录撤销
+    deletion_scheduled_at timestamptz(6) NULL,
+    -- 注销完成后个人信息已清除 只保留ID供其他记录引用
+    deleted_at            timestamptz(6) NULL
+);
+-- 管理后台按 (created_at, id) 游标分页
+CREATE INDEX IF NOT EXISTS idx_users_created_at_id ON public.users (created_at, id);
+CREATE INDEX IF NOT EXISTS idx_users_deletion_scheduled_at ON public.users (deletion_scheduled_at) WHERE deletion_scheduled_at IS NOT NULL;
+-- 管理后台按邮箱与昵称模糊检索
+CREATE INDEX IF NOT EXISTS idx_users_email_trgm ON public.users USING gin (email gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_nickname_trgm ON public.users USING gin (nickname gin_trgm_ops);
+
+-- 第三方登录身份表 一个用户可关联多个提供商账号
+CREATE TABLE public.user_identities
+(
+    id           bigserial      NOT NULL PRIMARY KEY,
+    user_id      bigint         NOT NULL REFERENCES public.users (id) ON DELETE CASCADE,
+    provider     varchar(30)    NOT NULL,
+    subject      varchar(255)   NOT NULL,
+    email        varchar(80)    NULL,
+    created_at   timestamptz(6) NOT NULL DEFAULT now(),
+    updated_at   timestamptz(6) NOT NULL DEFAULT now(),
+    last_used_at timestamptz(6) NULL,
+    UNIQUE (provider, subject)
+);
+CREATE INDEX IF NOT EXISTS idx_user_identities_user_id ON public.user_identities (user_id);
+
+-- 个人访问令牌 仅保存哈希 明文只在创建时返回一次
+CREATE TABLE public.api_keys
+(
+    id           bigserial      NOT NULL PRIMARY KEY,
+    user_id      bigint         NOT NULL REFERENCES public.users (id) ON DELETE CASCADE,
+    name         varchar(50)    NOT NULL,
+    prefix       varchar(16)    NOT NULL,
+    token_hash   varchar(64)    NOT NULL UNIQUE,
+    scopes       text[]         NOT NULL DEFAULT '{}',
+    expires_at   timestamptz(6) NULL,
+    last_used_at timestamptz(6) NULL,
+    created_at   timestamptz(6) NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_api_keys_user_id ON public.api_keys (user_id);
+
+-- 两步验证 TOTP 密钥使用 AES-256 加密存储 enabled_at 为空表示尚未确认绑定
+CREATE TABLE public.user_mfa
+(
+    user_id     bigint         NOT NULL PRIMARY KEY REFERENCES public.users (id) ON DELETE CASCADE,
+    totp_secret text           NOT NULL,
+    enabled_at  timestamptz(6) NULL,
+    created_at  timestamptz(6) NOT NULL DEFAULT now(),
+    updated_at  timestamptz(6) NOT NULL DEFAULT now()
+);
+
+-- 两步验证恢复码 只保存摘要 每个恢复码只能使用一次
+CREATE TABLE public.user_recovery_codes
+(
+    id         bigserial      NOT NULL PRIMARY KEY,
+    user_id    bigint         NOT NULL REFERENCES public.users (id) ON DELETE CASCADE,
+    code_hash  varchar(64)    NOT NULL,
+    used_at    timestamptz(6) NULL,
+    created_at timestamptz(6) NOT NULL DEFAULT now(),
+    UNIQUE (user_id, code_hash)
+);
+
+-- 通行密钥 credential_id 与 public_key 为认证器返回的原始字节
+-- sign_count 用于检测被克隆的认证器 每次登录后更新
+CREATE TABLE public.webauthn_credentials
+(
+    id               bigserial      NOT NULL PRIMARY KEY,
+    user_id          bigint         NOT NULL REFERENCES public.users (id) ON DELETE CASCADE,
+    name             varchar(50)    NOT NULL DEFAULT '',
+    credential_id    bytea          NOT NULL UNIQUE,
+    public_key       bytea          NOT NULL,
+    attestation_type varchar(32)    NOT NULL DEFAULT '',
+    transports       text[]         NOT NULL DEFAULT '{}',
+    aaguid           bytea          NOT NULL,
+    sign_count       bigint         NOT NULL DEFAULT 0,
+    backup_eligible  boolean        NOT NULL DEFAULT false,
+    backup_state     boolean        NOT NULL DEFAULT false,
+    last_used_at     timestamptz(6) NULL,
+    created_at       timestamptz(6) NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_webauthn_credentials_user_id ON public.webauthn_credentials (user_id);
+
+-- 管理操作审计日志 只追加不修改 用户注销后仍保留
+-- action 如 user.impersonate target_user_id 为被操作的用户
+CREATE TABLE public.audit_logs
+(
+    id             bigserial      NOT NULL PRIMARY KEY,
+    actor_id       bigint         NOT NULL REFERENCES public.users (id),
+    action         varchar(50)    NOT NULL,
+    target_user_id bigint         NULL REFERENCES public.users (id),
+    reason         varchar(200)   NOT NULL DEFAULT '',
+    ip             varchar(45)    NOT NULL DEFAULT '',
+    user_agent     varchar(255)   NOT NULL DEFAULT '',
+    created_at     timestamptz(6) NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_actor_id ON public.audit_logs (actor_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_target_user_id ON public.audit_logs (target_user_id, created_at);
+
+-- 旧版本迁移: 将用户表中的第三方用户ID迁入身份表 执行 migrations/001_user_identities.sql
+
+-- 旧版本迁移: 支持手机号登录 仅绑定手机号的用户没有邮箱
+-- ALTER TABLE public.users ALTER COLUMN email DROP NOT NULL;
+-- ALTER TABLE public.users ADD COLUMN phone varchar(20) NULL UNIQUE;
+-- ALTER TABLE public.users ADD COLUMN phone_verified_at timestamptz(6) NULL;
+
+-- 旧版本迁移: 保存用户头像地址
+-- ALTER TABLE public.users ADD COLUMN avatar varchar(500) NULL;
+
+-- 旧版本迁移: 账号注销
+-- ALTER TABLE public.users ADD COLUMN deletion_scheduled_at timestamptz(6) NULL;
+-- ALTER TABLE public.users ADD COLUMN deleted_at timestamptz(6) NULL;
+-- CREATE INDEX IF NOT EXISTS idx_users_deletion_scheduled_at ON public.users (deletion_scheduled_at) WHERE deletion_scheduled_at IS NOT NULL;
+
+-- 旧版本迁移: 管理员禁用账号与用户检索
+-- ALTER TABLE public.users ADD COLUMN disabled_at timestamptz(6) NULL;
+-- DROP INDEX IF EXISTS public.idx_users_created_at;
+-- DROP INDEX IF EXISTS public.idx_users_nickname;
+-- CREATE INDEX IF NOT EXISTS idx_users_created_at_id ON public.users (created_at, id);
+-- CREATE INDEX IF NOT EXISTS idx_users_email_trgm ON public.users USING gin (email gin_trgm_ops);
+-- CREATE INDEX IF NOT EXISTS idx_users_nickname_trgm ON public.users USING gin (nickname gin_trgm_ops);
+-- INSERT INTO public.permissions (code, description) VALUES ('user:manage', '禁用启用用户与强制下线');
+-- INSERT INTO public.role_permissions (role_id, permission_id)
+-- SELECT r.id, p.id FROM public.roles r, public.permissions p WHERE r.name = 'admin' AND p.code = 'user:manage';
+
+-- 旧版本迁移: 管理员模拟登录
+-- INSERT INTO public.permissions (code, description) VALUES ('user:impersonate', '以其他用户身份访问');
+-- INSERT INTO public.role_permissions (role_id, permission_id)
+-- SELECT r.id, p.id FROM public.roles r, public.permissions p WHERE r.name = 'admin' AND p.code = 'user:impersonate';
+
+-- 旧版本迁移: 第三方登录创建的账号补记邮箱验证时间 执行 migrations/002_oauth_email_verified.sql
+
+-- 角色表
+CREATE TABLE public.roles
+(
+    id          bigserial      NOT NULL PRIMARY KEY,
+    name        varchar(50)    NOT NULL UNIQUE,
+    description varchar(255)   NOT NULL DEFAULT '',
+    created_at  timestamptz(6) NOT NULL DEFAULT now(),
+    updated_at  timestamptz(6) NOT NULL DEFAULT now()
+);
+
+-- 权限表 code 形如 resource:action
+CREATE TABLE public.permissions
+(
+    id          bigserial      NOT NULL PRIMARY KEY,
+    code        varchar(100)   NOT NULL UNIQUE,
+    description varchar(255)   NOT NULL DEFAULT '',
+    created_at  timestamptz(6) NOT NULL DEFAULT now()
+);
+
+-- 角色与权限关联表
+CREATE TABLE public.role_permissions
+(
+    role_id       bigint         NOT NULL REFERENCES public.roles (id) ON DELETE CASCADE,
+    permission_id bigint         NOT NULL REFERENCES public.permissions (id) ON DELETE CASCADE,
     created_at    timestamptz(6) NOT NULL DEFAULT now(),
-    updated_at    timestamptz(6) NOT NULL DEFAULT now(),
-    last_login_at timestamptz(6) NOT NULL
+    PRIMARY KEY (role_id, permission_id)
+);
+CREATE INDEX IF NOT EXISTS idx_role_permissions_permission_id ON public.role_permissions (permission_id);
+
+-- 用户与角色关联表
+CREATE TABLE public.user_roles
+(
+    user_id    bigint         NOT NULL REFERENCES public.users (id) ON DELETE CASCADE,
+    role_id    bigint         NOT NULL REFERENCES public.roles (id) ON DELETE CASCADE,
+    created_at timestamptz(6) NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, role_id)
+);
+CREATE INDEX IF NOT EXISTS idx_user_roles_role_id ON public.user_roles (role_id);
+
+-- 内置管理员角色与权限
+INSERT INTO public.roles (name, description)
+VALUES ('admin', '系统管理员');
+INSERT INTO public.permissions (code, description)
+VALUES ('rbac:manage', '管理角色与权限'),
+       ('user:read', '查看用户信息'),
+       ('user:manage', '禁用启用用户与强制下线'),
+       ('user:impersonate', '以其他用户身份访问'),
+       ('captcha:answer', '获取带答案的验证码');
+INSERT INTO public.role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM public.roles r,
+     public.permissions p
+WHERE r.name = 'admin';
+
+-- 为首个管理员授权
+-- INSERT INTO public.user_roles (user_id, role_id)
+-- SELECT u.id, r.id FROM public.users u, public.roles r WHERE u.email = 'admin@example.com' AND r.name = 'admin';
+
+-- 资源级授权策略表 未配置 AUTHZ_POLICY_FILE 时从此表加载
+-- subjects 取值: * 任意已登录用户 / role:<角色名> / user:<用户id>
+-- conditions 为内置或代码中注册的条件名 如 owner same_tenant
+CREATE TABLE public.authz_policies
+(
+    id         bigserial      NOT NULL PRIMARY KEY,
+    name       varchar(100)   NOT NULL UNIQUE,
+    effect     varchar(10)    NOT NULL DEFAULT 'allow',
+    subjects   text[]         NOT NULL,
+    actions    text[]         NOT NULL,
+    resources  text[]         NOT NULL,
+    conditions text[]         NOT NULL DEFAULT '{}',
+    attributes jsonb          NOT NULL DEFAULT '{}',
+    created_at timestamptz(6) NOT NULL DEFAULT now(),
+    updated_at timestamptz(6) NOT NULL DEFAULT now()
+);
+
+-- 默认策略: 管理员可操作全部资源 资源创建者可修改与删除自己的记录
+INSERT INTO public.authz_policies (name, effect, subjects, actions, resources, conditions)
+VALUES ('admin-all', 'allow', '{role:admin}', '{*}', '{*}', '{}'),
+       ('owner-modify', 'allow', '{*}', '{update,delete}', '{*}', '{owner}');
+
+-- 组织 即多租户中的租户 slug 用于URL等对外展示
+CREATE TABLE public.organizations
+(
+    id         bigserial      NOT NULL PRIMARY KEY,
+    name       varchar(50)    NOT NULL,
+    slug       varchar(50)    NOT NULL UNIQUE,
+    created_at timestamptz(6) NOT NULL DEFAULT now(),
+    updated_at timestamptz(6) NOT NULL DEFAULT now()
+);
+
+-- 组织成员 role 取值: owner / admin / member
+CREATE TABLE public.organization_members
+(
+    organization_id bigint         NOT NULL REFERENCES public.organizations (id) ON DELETE CASCADE,
+    user_id         bigint         NOT NULL REFERENCES public.users (id),
+    role            varchar(20)    NOT NULL,
+    created_at      timestamptz(6) NOT NULL DEFAULT now(),
+    updated_at      timestamptz(6) NOT NULL DEFAULT now(),
+    PRIMARY KEY (organization_id, user_id)
+);
+CREATE INDEX IF NOT EXISTS idx_organization_members_user_id ON public.organization_members (user_id);
+
+-- 组织邀请 只保存令牌的哈希 status 取值: pending / accepted / declined / revoked
+CREATE TABLE public.organization_invitations
+(
+    id              bigserial      NOT NULL PRIMARY KEY,
+    organization_id bigint         NOT NULL REFERENCES public.organizations (id) ON DELETE CASCADE,
+    email           varchar(80)    NOT NULL,
+    role            varchar(20)    NOT NULL,
+    token_hash      varchar(64)    NOT NULL UNIQUE,
+    inviter_id      bigint         NOT NULL REFERENCES public.users (id),
+    status          varchar(20)    NOT NULL DEFAULT 'pending',
+    expires_at      timestamptz(6) NOT NULL,
+    responded_at    timestamptz(6) NULL,
+    created_at      timestamptz(6) NOT NULL DEFAULT now()
 );
-CREATE INDEX IF NOT EXISTS idx_users_created_at ON public.users (created_at);
-CREATE INDEX IF NOT EXISTS idx_users_nickname ON public.users (nickname);
-CREATE INDEX idx_users_github_id ON public.users (github_id) WHERE github_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_organization_invitations_org_status ON public.organization_invitations (organization_id, status);
+CREATE INDEX IF NOT EXISTS idx_organization_invitations_email_status ON public.organization_invitations (email, status);
@@ -0,0 +1,48 @@
+-- 将用户表中各提供商的用户ID迁入 user_identities 表后删除这些列
+-- 新建的数据库由 ddl.sql 初始化 无需执行 已有数据库升级时执行一次 重复执行不会产生重复数据
+-- psql -U postgres -d scaffold -f docker/config/migrations/001_user_identities.sql
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS public.user_identities
+(
+    id           bigserial      NOT NULL PRIMARY KEY,
+    user_id      bigint         NOT NULL REFERENCES public.users (id) ON DELETE CASCADE,
+    provider     varchar(30)    NOT NULL,
+    subject      varchar(255)   NOT NULL,
+    email        varchar(80)    NULL,
+    created_at   timestamptz(6) NOT NULL DEFAULT now(),
+    updated_at   timestamptz(6) NOT NULL DEFAULT now(),
+    last_used_at timestamptz(6) NULL,
+    UNIQUE (provider, subject)
+);
+CREATE INDEX IF NOT EXISTS idx_user_identities_user_id ON public.user_identities (user_id);
+
+-- 只支持 GitHub 登录的旧版本没有 google_id 与 oidc_id 列 补齐后统一迁移
+ALTER TABLE public.users ADD COLUMN IF NOT EXISTS github_id varchar(60) NULL;
+ALTER TABLE public.users ADD COLUMN IF NOT EXISTS google_id varchar(255) NULL;
+ALTER TABLE public.users ADD COLUMN IF NOT EXISTS oidc_id varchar(255) NULL;
+
+INSERT INTO public.user_identities (user_id, provider, subject, email, created_at, updated_at)
+SELECT id, 'github', github_id, email, created_at, updated_at
+FROM public.users
+WHERE github_id IS NOT NULL
+ON CONFLICT (provider, subject) DO NOTHING;
+
+INSERT INTO public.user_identities (user_id, provider, subject, email, created_at, updated_at)
+SELECT id, 'google', google_id, email, created_at, updated_at
+FROM public.users
+WHERE google_id IS NOT NULL
+ON CONFLICT (provider, subject) DO NOTHING;
+
+INSERT INTO public.user_identities (user_id, provider, subject, email, created_at, updated_at)
+SELECT id, 'oidc', oidc_id, email, created_at, updated_at
+FROM public.users
+WHERE oidc_id IS NOT NULL
+ON CONFLICT (provider, subject) DO NOTHING;
+
+DROP INDEX IF EXISTS public.idx_users_github_id;
+ALTER TABLE public.users DROP COLUMN github_id;
+ALTER TABLE public.users DROP COLUMN google_id;
+ALTER TABLE public.users DROP COLUMN oidc_id;
+
+COMMIT;
@@ -0,0 +1,12 @@
+-- 第三方登录创建的账号此前未记录邮箱验证时间 导致之后无法用其他方式登录同一邮箱
+-- 没有密码且邮箱与某个第三方身份的邮箱一致的账号只可能由第三方登录创建 邮箱已由提供商验证
+-- psql -U postgres -d scaffold -f docker/config/migrations/002_oauth_email_verified.sql
+UPDATE public.users u
+SET email_verified_at = u.created_at
+WHERE u.email_verified_at IS NULL
+  AND u.password_hash IS NULL
+  AND u.email IS NOT NULL
+  AND EXISTS (SELECT 1
+              FROM public.user_identities i
+              WHERE i.user_id = u.id
+                AND i.email = u.email);
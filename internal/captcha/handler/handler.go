@@ -5,7 +5,6 @@ import (
 	"scaffold/internal/captcha/service"
 	"scaffold/internal/common/reskit/codes"
 	"scaffold/internal/common/reskit/response"
-	"scaffold/internal/common/utils"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
@@ -51,21 +50,18 @@ func (h *HttpHandler) Gen(ctx *gin.Context) {
 
 // GenWithAnswer godoc
 // @Summary      生成带答案的验证码
-// @Description  创建新的验证码并返回答案（仅用于测试或开发环境）
+// @Description  创建新的验证码并返回答案（用于自动化测试，需要 captcha:answer 权限）
 // @Tags         captcha
 // @Accept       json
 // @Produce      json
+// @Security     BearerAuth
 // @Param        request query handler.GenRequest true "请求参数"
 // @Success      200  {object}  response.successResponse{data=handler.CaptchaAnswerResponse} "请求成功"
 // @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      403  {object}  response.errorResponse "没有访问权限"
 // @Failure      500  {object}  response.errorResponse "服务器错误"
-// @Router       /v1/captcha/with-answer [get]
+// @Router       /v1/captcha/with-answer [post]
 func (h *HttpHandler) GenWithAnswer(ctx *gin.Context) {
-	mode := utils.GetEnv("SERVER_MODE")
-	if mode != "dev" {
-		response.Error(ctx, codes.ErrAPIForbidden)
-	}
-
 	req := new(GenRequest)
 
 	if err := ctx.ShouldBindQuery(req); err != nil {
@@ -16,8 +16,8 @@ func RegisterV1(r *gin.RouterGroup, handler *handler.HttpHandler) func() {
 			response.Success(ctx)
 		})
 
-		// 测试路由：生成验证码并返回图片+验证答案
-		g.POST("/with-answer", auth.JWTValidate(), handler.GenWithAnswer)
+		// 测试路由：生成验证码并返回图片+验证答案 仅授予测试账号
+		g.POST("/with-answer", auth.JWTValidate(), auth.Require("captcha:answer"), handler.GenWithAnswer)
 	}
 
 	return nil
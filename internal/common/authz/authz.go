@@ -0,0 +1,101 @@
+package authz
+
+import (
+	"scaffold/internal/common/reskit/codes"
+	"sync"
+)
+
+type Action string
+
+const (
+	ActionRead   Action = "read"
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// Subject 发起操作的主体 通常由 auth.GetSubject 从令牌声明构造
+type Subject struct {
+	UserID     int64
+	Roles      []string
+	TenantID   int64
+	Attributes map[string]any
+}
+
+// Resource 被操作的资源 OwnerID 为 0 表示资源没有归属者
+type Resource struct {
+	Type       string
+	ID         int64
+	OwnerID    int64
+	TenantID   int64
+	Attributes map[string]any
+}
+
+// Request 一次授权判定的完整输入 供条件函数读取
+type Request struct {
+	Subject  *Subject
+	Action   Action
+	Resource *Resource
+}
+
+// Engine 策略引擎 默认拒绝 命中任一 deny 策略即拒绝 否则需命中至少一条 allow 策略
+type Engine struct {
+	source   Source
+	mu       sync.RWMutex
+	policies []*Policy
+}
+
+func NewEngine(source Source) (*Engine, error) {
+	e := &Engine{source: source}
+	if err := e.Reload(); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// Reload 重新从策略源加载 加载失败时保留原有策略
+func (e *Engine) Reload() error {
+	policies, err := e.source.Load()
+	if err != nil {
+		return err
+	}
+	for _, p := range policies {
+		if err := p.validate(); err != nil {
+			return err
+		}
+	}
+
+	e.mu.Lock()
+	e.policies = policies
+	e.mu.Unlock()
+	return nil
+}
+
+// Authorize 通过时返回 nil 否则返回 codes.ErrAccessDenied
+func (e *Engine) Authorize(subject *Subject, action Action, resource *Resource) error {
+	if subject == nil || resource == nil {
+		return codes.ErrAccessDenied
+	}
+
+	req := &Request{Subject: subject, Action: action, Resource: resource}
+
+	e.mu.RLock()
+	policies := e.policies
+	e.mu.RUnlock()
+
+	allowed := false
+	for _, p := range policies {
+		if !p.matches(req) {
+			continue
+		}
+		if p.Effect == EffectDeny {
+			return codes.ErrAccessDenied.WithSlug(p.Name)
+		}
+		allowed = true
+	}
+
+	if !allowed {
+		return codes.ErrAccessDenied
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package authz
+
+import (
+	"scaffold/internal/common/utils"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+var (
+	defaultEngine     *Engine
+	defaultEngineOnce sync.Once
+)
+
+// Default 全局策略引擎 配置了 AUTHZ_POLICY_FILE 时读取文件 否则读取数据库
+// 数据库来源按 AUTHZ_RELOAD_SECONDS 定期重新加载 策略变更无需重启
+func Default() *Engine {
+	defaultEngineOnce.Do(func() {
+		var source Source
+		path := utils.GetEnvWithDefault("AUTHZ_POLICY_FILE", "")
+		if path != "" {
+			source = NewFileSource(path)
+		} else {
+			source = NewPSQLSource()
+		}
+
+		engine, err := NewEngine(source)
+		if err != nil {
+			panic(errors.WithMessage(err, "授权策略加载失败"))
+		}
+		defaultEngine = engine
+
+		interval, _ := strconv.Atoi(utils.GetEnvWithDefault("AUTHZ_RELOAD_SECONDS", "60"))
+		if path == "" && interval > 0 {
+			go engine.reloadEvery(time.Duration(interval) * time.Second)
+		}
+	})
+	return defaultEngine
+}
+
+func (e *Engine) reloadEvery(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if err := e.Reload(); err != nil {
+			zap.L().Error("重新加载授权策略失败", zap.Error(err))
+		}
+	}
+}
+
+// Check 使用全局引擎判定 供各模块 service 在修改或删除资源前调用
+func Check(subject *Subject, action Action, resource *Resource) error {
+	return Default().Authorize(subject, action, resource)
+}
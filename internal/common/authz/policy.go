@@ -0,0 +1,143 @@
+package authz
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+type Effect string
+
+const (
+	EffectAllow Effect = "allow"
+	EffectDeny  Effect = "deny"
+)
+
+const (
+	wildcard          = "*"
+	subjectRolePrefix = "role:"
+	subjectUserPrefix = "user:"
+	// 属性值以此为前缀时取主体的同名属性比较 如 "$subject.department"
+	subjectAttrRef = "$subject."
+)
+
+// Policy 一条授权策略 各列表内为"或"关系 列表之间为"且"关系 conditions 需全部满足
+type Policy struct {
+	Name       string         `json:"name"`
+	Effect     Effect         `json:"effect"`
+	Subjects   []string       `json:"subjects"`
+	Actions    []string       `json:"actions"`
+	Resources  []string       `json:"resources"`
+	Conditions []string       `json:"conditions,omitempty"`
+	Attributes map[string]any `json:"attributes,omitempty"`
+}
+
+func (p *Policy) validate() error {
+	if p.Effect != EffectAllow && p.Effect != EffectDeny {
+		return errors.Errorf("策略 %s 的 effect 无效: %s", p.Name, p.Effect)
+	}
+	if len(p.Subjects) == 0 || len(p.Actions) == 0 || len(p.Resources) == 0 {
+		return errors.Errorf("策略 %s 缺少 subjects、actions 或 resources", p.Name)
+	}
+	return nil
+}
+
+func (p *Policy) matches(req *Request) bool {
+	if !matchAny(p.Actions, string(req.Action)) || !matchAny(p.Resources, req.Resource.Type) {
+		return false
+	}
+	if !p.matchSubject(req.Subject) {
+		return false
+	}
+
+	for _, name := range p.Conditions {
+		cond, ok := lookupCondition(name)
+		// 未注册的条件视为不满足
+		if !ok || !cond(req) {
+			return false
+		}
+	}
+
+	for key, want := range p.Attributes {
+		got, ok := req.Resource.Attributes[key]
+		if !ok {
+			return false
+		}
+		if ref, isRef := want.(string); isRef && strings.HasPrefix(ref, subjectAttrRef) {
+			want, ok = req.Subject.Attributes[strings.TrimPrefix(ref, subjectAttrRef)]
+			if !ok {
+				return false
+			}
+		}
+		// JSON 数字解析为 float64 统一按字符串比较
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (p *Policy) matchSubject(subject *Subject) bool {
+	for _, s := range p.Subjects {
+		switch {
+		case s == wildcard:
+			return true
+		case strings.HasPrefix(s, subjectRolePrefix):
+			role := strings.TrimPrefix(s, subjectRolePrefix)
+			for _, r := range subject.Roles {
+				if r == role {
+					return true
+				}
+			}
+		case strings.HasPrefix(s, subjectUserPrefix):
+			if s == subjectUserPrefix+strconv.FormatInt(subject.UserID, 10) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchAny(patterns []string, value string) bool {
+	for _, p := range patterns {
+		if p == wildcard || p == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Condition 条件函数 用于表达无法用静态属性描述的规则
+type Condition func(req *Request) bool
+
+var (
+	conditionsMu sync.RWMutex
+	conditions   = map[string]Condition{
+		// owner 主体是资源的创建者
+		"owner": func(req *Request) bool {
+			return req.Resource.OwnerID != 0 && req.Resource.OwnerID == req.Subject.UserID
+		},
+		// same_tenant 主体与资源属于同一租户
+		"same_tenant": func(req *Request) bool {
+			return req.Resource.TenantID != 0 && req.Resource.TenantID == req.Subject.TenantID
+		},
+	}
+)
+
+// RegisterCondition 注册自定义条件 同名时覆盖 应在 init 中调用
+func RegisterCondition(name string, cond Condition) {
+	conditionsMu.Lock()
+	defer conditionsMu.Unlock()
+	conditions[name] = cond
+}
+
+func lookupCondition(name string) (Condition, bool) {
+	conditionsMu.RLock()
+	defer conditionsMu.RUnlock()
+	cond, ok := conditions[name]
+	return cond, ok
+}
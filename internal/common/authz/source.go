@@ -0,0 +1,72 @@
+package authz
+
+import (
+	"encoding/json"
+	"os"
+	"scaffold/internal/common/orm"
+
+	"github.com/pkg/errors"
+)
+
+// Source 策略来源
+type Source interface {
+	Load() ([]*Policy, error)
+}
+
+type fileSource struct {
+	path string
+}
+
+// NewFileSource 从 JSON 文件加载 格式为 {"policies": [...]}
+func NewFileSource(path string) Source {
+	return &fileSource{path: path}
+}
+
+func (s *fileSource) Load() ([]*Policy, error) {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "读取策略文件 %s 失败", s.path)
+	}
+
+	var file struct {
+		Policies []*Policy `json:"policies"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, errors.WithMessagef(err, "解析策略文件 %s 失败", s.path)
+	}
+	return file.Policies, nil
+}
+
+type psqlSource struct {
+}
+
+// NewPSQLSource 从 authz_policies 表加载
+func NewPSQLSource() Source {
+	return &psqlSource{}
+}
+
+func (s *psqlSource) Load() ([]*Policy, error) {
+	rows, err := orm.AuthzPolicies().AllG()
+	if err != nil {
+		return nil, errors.WithMessage(err, "查询授权策略失败")
+	}
+
+	policies := make([]*Policy, 0, len(rows))
+	for _, row := range rows {
+		p := &Policy{
+			Name:       row.Name,
+			Effect:     Effect(row.Effect),
+			Subjects:   row.Subjects,
+			Actions:    row.Actions,
+			Resources:  row.Resources,
+			Conditions: row.Conditions,
+		}
+		if len(row.Attributes) > 0 {
+			if err := row.Attributes.Unmarshal(&p.Attributes); err != nil {
+				return nil, errors.WithMessagef(err, "解析策略 %s 的 attributes 失败", row.Name)
+			}
+		}
+		policies = append(policies, p)
+	}
+	return policies, nil
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"html/template"
 	"scaffold/internal/common/utils"
+	"sync"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -31,9 +32,11 @@ var (
 	AdminEmail   string
 	globalDialer *gomail.Dialer
 	config       mailerConfig
+	configOnce   sync.Once
 )
 
-func init() {
+// loadConfig 首次创建 Mailer 时读取配置 未使用邮件的程序无需配置邮件相关环境变量
+func loadConfig() {
 	err := godotenv.Load()
 	if err != nil {
 		panic(err)
@@ -55,6 +58,8 @@ func init() {
 }
 
 func NewMailer(templatesMap map[string]*template.Template) Mailer {
+	configOnce.Do(loadConfig)
+
 	return &mailer{
 		dialer:    globalDialer,
 		templates: templatesMap,
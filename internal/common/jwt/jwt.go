@@ -1,6 +1,8 @@
 package jwt
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"github.com/golang-jwt/jwt/v5"
 	"time"
@@ -17,35 +19,113 @@ var (
 	ErrInvalidToken = errors.New("无效的token")
 	// ErrTokenNotValidYet     = errors.New("token尚未生效")
 	// ErrTokenMalformed       = errors.New("token格式错误")
-	// ErrTokenInvalidIssuer   = errors.New("token颁发者无效")
-	// ErrTokenInvalidAudience = errors.New("token接收者无效")
+	ErrTokenInvalidIssuer   = errors.New("token颁发者无效")
+	ErrTokenInvalidAudience = errors.New("token接收者无效")
+	ErrTokenInvalidSubject  = errors.New("token主体无效")
 )
 
-func GenToken[T any](payload *T, secret string, duration time.Duration) (string, error) {
+type options struct {
+	issuer   string
+	audience []string
+	subject  string
+}
+
+// Option 签发时写入对应声明 解析时校验对应声明 未设置的声明不写入也不校验
+type Option func(*options)
+
+func WithIssuer(issuer string) Option {
+	return func(o *options) {
+		o.issuer = issuer
+	}
+}
+
+// WithAudience 签发时写入全部受众 解析时要求令牌受众包含第一个值
+func WithAudience(audience ...string) Option {
+	return func(o *options) {
+		o.audience = audience
+	}
+}
+
+func WithSubject(subject string) Option {
+	return func(o *options) {
+		o.subject = subject
+	}
+}
+
+func newOptions(opts []Option) *options {
+	o := new(options)
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// genTokenID 生成 jti 用于单个令牌的吊销
+func genTokenID() (string, error) {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
+
+// GenToken 使用密钥环中的 active 密钥签发令牌 头部携带 kid
+func GenToken[T any](payload *T, keyring *Keyring, duration time.Duration, opts ...Option) (string, error) {
+	jti, err := genTokenID()
+	if err != nil {
+		return "", err
+	}
+
+	o := newOptions(opts)
 	claims := &MyClaims[T]{
 		PayLoad: payload,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        jti,
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(duration)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			NotBefore: jwt.NewNumericDate(time.Now()),
-			Issuer:    "Lirous-Go-Scaffold",
+			Issuer:    o.issuer,
+			Subject:   o.subject,
 		},
 	}
+	if len(o.audience) > 0 {
+		claims.Audience = o.audience
+	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(secret))
+	key := keyring.Active()
+	token := jwt.NewWithClaims(key.method, claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.signKey)
 }
 
-func ParseToken[T any](tokenString string, secret string) (*MyClaims[T], error) {
-	token, err := jwt.ParseWithClaims(tokenString, &MyClaims[T]{}, func(token *jwt.Token) (interface{}, error) {
-		return []byte(secret), nil
-	})
+// ParseToken 根据头部 kid 从密钥环中选择校验密钥 并按 opts 校验 iss/aud/sub
+func ParseToken[T any](tokenString string, keyring *Keyring, opts ...Option) (*MyClaims[T], error) {
+	o := newOptions(opts)
+
+	var parserOpts []jwt.ParserOption
+	if o.issuer != "" {
+		parserOpts = append(parserOpts, jwt.WithIssuer(o.issuer))
+	}
+	if len(o.audience) > 0 {
+		parserOpts = append(parserOpts, jwt.WithAudience(o.audience[0]))
+	}
+	if o.subject != "" {
+		parserOpts = append(parserOpts, jwt.WithSubject(o.subject))
+	}
+
+	token, err := jwt.ParseWithClaims(tokenString, &MyClaims[T]{}, keyring.keyFunc, parserOpts...)
 
 	if err != nil {
 		// 对于 JWT v5，直接判断错误类型
 		switch {
 		case errors.Is(err, jwt.ErrTokenExpired):
 			return nil, ErrTokenExpired
+		case errors.Is(err, jwt.ErrTokenInvalidIssuer):
+			return nil, ErrTokenInvalidIssuer
+		case errors.Is(err, jwt.ErrTokenInvalidAudience):
+			return nil, ErrTokenInvalidAudience
+		case errors.Is(err, jwt.ErrTokenInvalidSubject):
+			return nil, ErrTokenInvalidSubject
 		default:
 			return nil, ErrInvalidToken
 		}
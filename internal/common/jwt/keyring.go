@@ -0,0 +1,293 @@
+package jwt
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+
+	"scaffold/internal/common/utils"
+)
+
+// KeyState 密钥状态 active 用于签发与校验 retiring 仅用于校验尚未过期的旧令牌
+type KeyState string
+
+const (
+	KeyStateActive   KeyState = "active"
+	KeyStateRetiring KeyState = "retiring"
+)
+
+// Key 带 kid 的签名密钥
+type Key struct {
+	ID        string
+	State     KeyState
+	method    jwt.SigningMethod
+	signKey   any
+	verifyKey any
+}
+
+func (k *Key) Algorithm() string {
+	return k.method.Alg()
+}
+
+// NewHMACKey 对称密钥 不会出现在 JWKS 中
+func NewHMACKey(id string, state KeyState, secret []byte) *Key {
+	return &Key{
+		ID:        id,
+		State:     state,
+		method:    jwt.SigningMethodHS256,
+		signKey:   secret,
+		verifyKey: secret,
+	}
+}
+
+// ParsePEMKey 解析 PEM 格式的私钥或公钥 根据密钥类型确定算法
+// RSA -> RS256 ECDSA P-256 -> ES256 Ed25519 -> EdDSA
+// 只提供公钥时只能用于校验 状态必须为 retiring
+func ParsePEMKey(id string, state KeyState, pemBytes []byte) (*Key, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, errors.Errorf("密钥 %s 不是有效的PEM格式", id)
+	}
+
+	var signKey, verifyKey any
+	switch block.Type {
+	case "PUBLIC KEY":
+		pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, errors.Wrapf(err, "解析公钥 %s 失败", id)
+		}
+		verifyKey = pub
+	case "RSA PRIVATE KEY":
+		priv, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, errors.Wrapf(err, "解析私钥 %s 失败", id)
+		}
+		signKey, verifyKey = priv, &priv.PublicKey
+	case "EC PRIVATE KEY":
+		priv, err := x509.ParseECPrivateKey(block.Bytes)
+		if err != nil {
+			return nil, errors.Wrapf(err, "解析私钥 %s 失败", id)
+		}
+		signKey, verifyKey = priv, &priv.PublicKey
+	case "PRIVATE KEY":
+		priv, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, errors.Wrapf(err, "解析私钥 %s 失败", id)
+		}
+		signKey = priv
+		switch p := priv.(type) {
+		case *rsa.PrivateKey:
+			verifyKey = &p.PublicKey
+		case *ecdsa.PrivateKey:
+			verifyKey = &p.PublicKey
+		case ed25519.PrivateKey:
+			verifyKey = p.Public()
+		}
+	default:
+		return nil, errors.Errorf("密钥 %s 的PEM类型 %s 不受支持", id, block.Type)
+	}
+
+	if signKey == nil && state == KeyStateActive {
+		return nil, errors.Errorf("密钥 %s 只有公钥 不能作为签发密钥", id)
+	}
+
+	var method jwt.SigningMethod
+	switch pub := verifyKey.(type) {
+	case *rsa.PublicKey:
+		method = jwt.SigningMethodRS256
+	case *ecdsa.PublicKey:
+		if pub.Curve != elliptic.P256() {
+			return nil, errors.Errorf("密钥 %s 的椭圆曲线不受支持 仅支持P-256", id)
+		}
+		method = jwt.SigningMethodES256
+	case ed25519.PublicKey:
+		method = jwt.SigningMethodEdDSA
+	default:
+		return nil, errors.Errorf("密钥 %s 的类型不受支持", id)
+	}
+
+	return &Key{
+		ID:        id,
+		State:     state,
+		method:    method,
+		signKey:   signKey,
+		verifyKey: verifyKey,
+	}, nil
+}
+
+// Keyring 签名密钥环 有且只有一个 active 密钥
+// 轮换时新密钥设为 active 旧密钥改为 retiring 待旧令牌全部过期后移除
+type Keyring struct {
+	keys   map[string]*Key
+	order  []string
+	active *Key
+}
+
+func NewKeyring(keys ...*Key) (*Keyring, error) {
+	k := &Keyring{keys: make(map[string]*Key, len(keys))}
+
+	for _, key := range keys {
+		if key.ID == "" {
+			return nil, errors.New("密钥缺少kid")
+		}
+		if _, ok := k.keys[key.ID]; ok {
+			return nil, errors.Errorf("密钥kid重复: %s", key.ID)
+		}
+
+		switch key.State {
+		case KeyStateActive:
+			if k.active != nil {
+				return nil, errors.Errorf("只能有一个active密钥: %s %s", k.active.ID, key.ID)
+			}
+			k.active = key
+		case KeyStateRetiring:
+		default:
+			return nil, errors.Errorf("密钥 %s 的状态无效: %s", key.ID, key.State)
+		}
+
+		k.keys[key.ID] = key
+		k.order = append(k.order, key.ID)
+	}
+
+	if k.active == nil {
+		return nil, errors.New("缺少active密钥")
+	}
+	return k, nil
+}
+
+func (k *Keyring) Active() *Key {
+	return k.active
+}
+
+// keyFunc 按 kid 选择校验密钥 并要求令牌算法与密钥一致 防止算法混淆攻击
+func (k *Keyring) keyFunc(token *jwt.Token) (any, error) {
+	key := k.active
+	if kid, ok := token.Header["kid"].(string); ok && kid != "" {
+		key, ok = k.keys[kid]
+		if !ok {
+			return nil, errors.Errorf("未知的kid: %s", kid)
+		}
+	}
+
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, errors.Errorf("令牌算法 %s 与密钥不匹配", token.Method.Alg())
+	}
+	return key.verifyKey, nil
+}
+
+// JWK 公钥的 JSON Web Key 表示
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS 导出全部非对称公钥 包括 retiring 状态的密钥
+func (k *Keyring) JWKS() *JWKS {
+	jwks := &JWKS{Keys: make([]JWK, 0, len(k.order))}
+
+	for _, kid := range k.order {
+		key := k.keys[kid]
+		jwk := JWK{Kid: key.ID, Use: "sig", Alg: key.method.Alg()}
+
+		switch pub := key.verifyKey.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		case *ecdsa.PublicKey:
+			ecdh, err := pub.ECDH()
+			if err != nil {
+				continue
+			}
+			// 未压缩点格式 0x04 || X || Y
+			point := ecdh.Bytes()[1:]
+			size := len(point) / 2
+			jwk.Kty = "EC"
+			jwk.Crv = pub.Curve.Params().Name
+			jwk.X = base64.RawURLEncoding.EncodeToString(point[:size])
+			jwk.Y = base64.RawURLEncoding.EncodeToString(point[size:])
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+		default:
+			// 对称密钥不公开
+			continue
+		}
+
+		jwks.Keys = append(jwks.Keys, jwk)
+	}
+
+	return jwks
+}
+
+// LoadKeyringFromEnv 从环境变量加载密钥环
+// JWT_KEYS 格式为 kid:state:pem文件路径 多个密钥以逗号分隔
+// 例如 2025-06:active:/keys/2025-06.pem,2025-01:retiring:/keys/2025-01.pub.pem
+// 未配置 JWT_KEYS 时退回使用 JWT_SECRET 的 HS256
+func LoadKeyringFromEnv() (*Keyring, error) {
+	spec := strings.TrimSpace(utils.GetEnvWithDefault("JWT_KEYS", ""))
+	if spec == "" {
+		return NewKeyring(NewHMACKey("default", KeyStateActive, []byte(utils.GetEnv("JWT_SECRET"))))
+	}
+
+	var keys []*Key
+	for _, item := range strings.Split(spec, ",") {
+		parts := strings.SplitN(strings.TrimSpace(item), ":", 3)
+		if len(parts) != 3 {
+			return nil, errors.Errorf("JWT_KEYS 配置格式错误: %s", item)
+		}
+
+		pemBytes, err := os.ReadFile(parts[2])
+		if err != nil {
+			return nil, errors.Wrapf(err, "读取密钥 %s 失败", parts[0])
+		}
+
+		key, err := ParsePEMKey(parts[0], KeyState(parts[1]), pemBytes)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	return NewKeyring(keys...)
+}
+
+var (
+	defaultKeyring     *Keyring
+	defaultKeyringOnce sync.Once
+)
+
+// DefaultKeyring 进程内共享的密钥环 首次调用时从环境变量加载 配置错误时直接panic
+func DefaultKeyring() *Keyring {
+	defaultKeyringOnce.Do(func() {
+		keyring, err := LoadKeyringFromEnv()
+		if err != nil {
+			panic(errors.WithMessage(err, "JWT密钥环加载失败"))
+		}
+		defaultKeyring = keyring
+	})
+	return defaultKeyring
+}
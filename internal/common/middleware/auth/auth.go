@@ -1,34 +1,83 @@
 package auth
 
 import (
+	"scaffold/internal/common/authz"
 	"scaffold/internal/common/reskit/codes"
 	"scaffold/internal/common/reskit/response"
 	"scaffold/internal/common/server"
+	rbacadapters "scaffold/internal/rbac/adapters"
+	rbacservice "scaffold/internal/rbac/service"
 	"scaffold/internal/user/adapters"
 	"scaffold/internal/user/domain"
 	"scaffold/internal/user/service"
 	"strings"
 
 	"github.com/pkg/errors"
+	"go.uber.org/zap"
 
 	"github.com/gin-gonic/gin"
 )
 
-var tokenServer domain.TokenService
+var (
+	tokenServer  domain.TokenService
+	apiKeyServer domain.APIKeyService
+	userRepo     domain.UserRepository
+)
 
 func init() {
 	tokenCache := adapters.NewTokenRedisCache()
-	userRepo := adapters.NewUserPSQLRepository()
-	tokenServer = service.NewTokenService(tokenCache, userRepo)
+	denylist := adapters.NewAccessTokenDenylist()
+	userRepo = adapters.NewUserPSQLRepository()
+	tokenServer = service.NewTokenService(tokenCache, denylist, userRepo, service.NewTokenClaimsProvider())
+
+	// API Key 每次请求实时解析角色 与登录令牌中的角色保持一致
+	claimsProvider := rbacservice.NewTokenClaimsProvider(rbacadapters.NewUserRolePSQLRepository())
+	apiKeyServer = service.NewAPIKeyService(adapters.NewAPIKeyPSQLRepository(), userRepo, claimsProvider)
 }
 
 const (
-	authHeaderKey = "Authorization"
-	bearerPrefix  = "Bearer "
+	authHeaderKey   = "Authorization"
+	apiKeyHeaderKey = "X-API-Key"
+	bearerPrefix    = "Bearer "
+	claimsKey       = "auth_claims"
 )
 
-// 解析 Authorization 头部的 Token
+type options struct {
+	requireEmailVerified bool
+	rejectAPIKey         bool
+	rejectImpersonation  bool
+}
+
+// Option JWTValidate 的可选校验项
+type Option func(*options)
+
+// RequireEmailVerified 拒绝邮箱未验证的用户
+func RequireEmailVerified() Option {
+	return func(o *options) {
+		o.requireEmailVerified = true
+	}
+}
+
+// RejectAPIKey 仅允许登录令牌访问 用于管理凭证等敏感接口
+func RejectAPIKey() Option {
+	return func(o *options) {
+		o.rejectAPIKey = true
+	}
+}
+
+// RejectImpersonation 拒绝管理员模拟登录的令牌 用于修改密码 删除API Key等敏感操作
+func RejectImpersonation() Option {
+	return func(o *options) {
+		o.rejectImpersonation = true
+	}
+}
+
+// 解析请求凭证 X-API-Key 优先 否则读取 Authorization 头部
 func parseTokenFromHeader(c *gin.Context) (string, error) {
+	if apiKey := c.GetHeader(apiKeyHeaderKey); apiKey != "" {
+		return apiKey, nil
+	}
+
 	authHeader := c.GetHeader(authHeaderKey)
 	if authHeader == "" {
 		return "", errors.New("token为空")
@@ -41,7 +90,12 @@ func parseTokenFromHeader(c *gin.Context) (string, error) {
 	return strings.TrimPrefix(authHeader, bearerPrefix), nil
 }
 
-func JWTValidate() gin.HandlerFunc {
+func JWTValidate(opts ...Option) gin.HandlerFunc {
+	o := new(options)
+	for _, opt := range opts {
+		opt(o)
+	}
+
 	return func(c *gin.Context) {
 		// 1. 从请求头解析 Token
 		tokenStr, err := parseTokenFromHeader(c)
@@ -50,27 +104,100 @@ func JWTValidate() gin.HandlerFunc {
 			return
 		}
 
-		// 2. 验证token
-		isExpire, err := tokenServer.ValidateAccessToken(tokenStr)
+		// 2. 验证凭证 JWT 校验签名 有效期与吊销名单 API Key 校验哈希 有效期与用户状态
+		// 禁用用户时会吊销其已签发的全部JWT 因此被禁用的用户在此被拒绝
+		claims, err := verifyCredential(tokenStr, o)
 		if err != nil {
-			if isExpire {
-				response.Error(c, codes.ErrTokenExpired)
-			} else {
-				response.Error(c, codes.ErrTokenInvalid)
-			}
+			response.Error(c, err)
 			return
 		}
 
-		// 3. 解析 Token
-		payload, err := tokenServer.ParseAccessToken(tokenStr)
-		if err != nil {
-			response.Error(c, codes.ErrTokenInvalid)
-			return
+		// 3. 可选校验
+		if claims.IsImpersonated() {
+			if o.rejectImpersonation {
+				response.Error(c, codes.ErrImpersonationNotAllowed)
+				return
+			}
+			// 模拟登录期间的每个请求都记录实际操作人
+			zap.L().Info("模拟登录请求",
+				zap.Int64("actor_id", claims.ActorID),
+				zap.Int64("user_id", claims.UserID),
+				zap.String("method", c.Request.Method),
+				zap.String("path", c.Request.URL.Path))
+		}
+		if o.requireEmailVerified {
+			if err := checkEmailVerified(claims.UserID); err != nil {
+				response.Error(c, err)
+				return
+			}
 		}
 
-		// 3. 将用户 相关信息存入上下文
-		c.Set(server.UserIDKey, payload.UserID)
+		// 4. 将完整声明存入上下文 user_id 与 session_id 单独保留便于直接读取
+		// 模拟登录时 user_id 为被模拟的用户 actor_id 为实际操作的管理员
+		c.Set(claimsKey, claims)
+		c.Set(server.UserIDKey, claims.UserID)
+		c.Set(server.SessionIDKey, claims.SessionID)
+		if claims.IsImpersonated() {
+			c.Set(server.ActorIDKey, claims.ActorID)
+		}
 
 		c.Next()
 	}
 }
+
+func verifyCredential(credential string, o *options) (*domain.AccessTokenClaims, error) {
+	if !domain.IsAPIKey(credential) {
+		return tokenServer.VerifyAccessToken(credential)
+	}
+
+	if o.rejectAPIKey {
+		return nil, codes.ErrAPIKeyNotAllowed
+	}
+	return apiKeyServer.VerifyAPIKey(credential)
+}
+
+// 邮箱验证状态可能在token有效期内变化 因此直接查库
+func checkEmailVerified(userID int64) error {
+	user, err := userRepo.FindByID(userID)
+	if err != nil {
+		return err
+	}
+	if !user.IsEmailVerified() {
+		return codes.ErrEmailNotVerified
+	}
+	return nil
+}
+
+// GetClaims 获取 JWTValidate 写入的完整令牌声明
+func GetClaims(c *gin.Context) (*domain.AccessTokenClaims, error) {
+	value, exist := c.Get(claimsKey)
+	if !exist {
+		return nil, codes.ErrUnauthorized
+	}
+
+	claims, ok := value.(*domain.AccessTokenClaims)
+	if !ok {
+		return nil, codes.ErrUnauthorized
+	}
+	return claims, nil
+}
+
+// GetSubject 以当前令牌声明构造资源授权主体
+func GetSubject(c *gin.Context) (*authz.Subject, error) {
+	claims, err := GetClaims(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// 令牌不携带组织 仅经过租户中间件的请求带有当前组织
+	var tenantID int64
+	if id, err := server.GetTenantID(c); err == nil {
+		tenantID = id
+	}
+
+	return &authz.Subject{
+		UserID:   claims.UserID,
+		Roles:    claims.Roles,
+		TenantID: tenantID,
+	}, nil
+}
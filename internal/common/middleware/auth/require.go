@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/reskit/response"
+	rbacadapters "scaffold/internal/rbac/adapters"
+	rbacdomain "scaffold/internal/rbac/domain"
+	rbacservice "scaffold/internal/rbac/service"
+	userdomain "scaffold/internal/user/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+var permissionChecker rbacdomain.PermissionChecker
+
+func init() {
+	permissionChecker = rbacservice.NewRBACService(
+		rbacadapters.NewRolePSQLRepository(),
+		rbacadapters.NewPermissionPSQLRepository(),
+		rbacadapters.NewUserRolePSQLRepository(),
+		rbacadapters.NewPermissionRedisCache(),
+	)
+}
+
+// Require 要求当前用户同时拥有全部给定权限 必须挂在 JWTValidate 之后
+// 权限按用户实时查询并缓存 角色变更后无需等待令牌过期即可生效
+func Require(permCodes ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims, err := GetClaims(c)
+		if err != nil {
+			response.Error(c, err)
+			return
+		}
+
+		// API Key 只能使用其 scopes 范围内的权限
+		if claims.IsAPIKey() && !claims.HasScope(userdomain.APIKeyScopeAll) {
+			for _, code := range permCodes {
+				if !claims.HasScope(code) {
+					response.Error(c, codes.ErrAPIKeyScopeDenied)
+					return
+				}
+			}
+		}
+
+		ok, err := permissionChecker.HasPermissions(claims.UserID, permCodes...)
+		if err != nil {
+			response.Error(c, err)
+			return
+		}
+		if !ok {
+			response.Error(c, codes.ErrPermissionDenied)
+			return
+		}
+
+		c.Next()
+	}
+}
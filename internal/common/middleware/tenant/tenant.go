@@ -0,0 +1,82 @@
+package tenant
+
+import (
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/reskit/response"
+	"scaffold/internal/common/server"
+	"scaffold/internal/organization/adapters"
+	"scaffold/internal/organization/domain"
+	"scaffold/internal/organization/service"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+var resolver domain.MembershipResolver
+
+func init() {
+	resolver = service.NewMembershipResolver(adapters.NewMemberPSQLRepository(), adapters.NewMembershipRedisCache())
+}
+
+const (
+	// 路径参数优先 其余接口通过请求头指定当前组织
+	pathParamKey = "org_id"
+	headerKey    = "X-Org-ID"
+)
+
+func parseTenantID(c *gin.Context) (int64, error) {
+	raw := c.Param(pathParamKey)
+	if raw == "" {
+		raw = c.GetHeader(headerKey)
+	}
+	if raw == "" {
+		return 0, codes.ErrTenantRequired
+	}
+
+	tenantID, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || tenantID <= 0 {
+		return 0, codes.ErrTenantInvalid
+	}
+	return tenantID, nil
+}
+
+// Resolve 解析当前组织并校验用户是其成员 必须挂在 JWTValidate 之后
+// 组织ID与用户在其中的角色写入上下文 handler 通过 server.GetTenantID 读取后传给仓储过滤
+func Resolve() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		tenantID, err := parseTenantID(c)
+		if err != nil {
+			response.Error(c, err)
+			return
+		}
+
+		userID, err := server.GetUserID(c)
+		if err != nil {
+			response.Error(c, err)
+			return
+		}
+
+		membership, err := resolver.GetMembership(tenantID, userID)
+		if err != nil {
+			response.Error(c, err)
+			return
+		}
+
+		c.Set(server.TenantIDKey, membership.OrganizationID)
+		c.Set(server.TenantRoleKey, string(membership.Role))
+
+		c.Next()
+	}
+}
+
+// RequireRole 要求用户在当前组织中的角色不低于给定角色 必须挂在 Resolve 之后
+func RequireRole(role domain.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !domain.Role(server.GetTenantRole(c)).AtLeast(role) {
+			response.Error(c, codes.ErrOrgPermissionDenied)
+			return
+		}
+
+		c.Next()
+	}
+}
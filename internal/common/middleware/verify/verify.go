@@ -11,3 +11,15 @@ func Verify() gin.HandlerFunc {
 	// 调用 handler 的 Verify 方法
 	return handler.Verify()
 }
+
+// VerifyWhen 仅在 required 返回 true 时要求人机验证
+func VerifyWhen(required func(ctx *gin.Context) bool) gin.HandlerFunc {
+	verify := Verify()
+	return func(ctx *gin.Context) {
+		if !required(ctx) {
+			ctx.Next()
+			return
+		}
+		verify(ctx)
+	}
+}
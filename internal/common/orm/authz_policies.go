@@ -0,0 +1,1070 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// AuthzPolicy is an object representing the database table.
+type AuthzPolicy struct {
+	ID         int64             `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name       string            `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Effect     string            `boil:"effect" json:"effect" toml:"effect" yaml:"effect"`
+	Subjects   types.StringArray `boil:"subjects" json:"subjects" toml:"subjects" yaml:"subjects"`
+	Actions    types.StringArray `boil:"actions" json:"actions" toml:"actions" yaml:"actions"`
+	Resources  types.StringArray `boil:"resources" json:"resources" toml:"resources" yaml:"resources"`
+	Conditions types.StringArray `boil:"conditions" json:"conditions" toml:"conditions" yaml:"conditions"`
+	Attributes types.JSON        `boil:"attributes" json:"attributes" toml:"attributes" yaml:"attributes"`
+	CreatedAt  time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt  time.Time         `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *authzPolicyR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L authzPolicyL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AuthzPolicyColumns = struct {
+	ID         string
+	Name       string
+	Effect     string
+	Subjects   string
+	Actions    string
+	Resources  string
+	Conditions string
+	Attributes string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	ID:         "id",
+	Name:       "name",
+	Effect:     "effect",
+	Subjects:   "subjects",
+	Actions:    "actions",
+	Resources:  "resources",
+	Conditions: "conditions",
+	Attributes: "attributes",
+	CreatedAt:  "created_at",
+	UpdatedAt:  "updated_at",
+}
+
+var AuthzPolicyTableColumns = struct {
+	ID         string
+	Name       string
+	Effect     string
+	Subjects   string
+	Actions    string
+	Resources  string
+	Conditions string
+	Attributes string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	ID:         "authz_policies.id",
+	Name:       "authz_policies.name",
+	Effect:     "authz_policies.effect",
+	Subjects:   "authz_policies.subjects",
+	Actions:    "authz_policies.actions",
+	Resources:  "authz_policies.resources",
+	Conditions: "authz_policies.conditions",
+	Attributes: "authz_policies.attributes",
+	CreatedAt:  "authz_policies.created_at",
+	UpdatedAt:  "authz_policies.updated_at",
+}
+
+// Generated where
+
+type whereHelpertypes_JSON struct{ field string }
+
+func (w whereHelpertypes_JSON) EQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_JSON) NEQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_JSON) LT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_JSON) LTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_JSON) GT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_JSON) GTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var AuthzPolicyWhere = struct {
+	ID         whereHelperint64
+	Name       whereHelperstring
+	Effect     whereHelperstring
+	Subjects   whereHelpertypes_StringArray
+	Actions    whereHelpertypes_StringArray
+	Resources  whereHelpertypes_StringArray
+	Conditions whereHelpertypes_StringArray
+	Attributes whereHelpertypes_JSON
+	CreatedAt  whereHelpertime_Time
+	UpdatedAt  whereHelpertime_Time
+}{
+	ID:         whereHelperint64{field: "\"authz_policies\".\"id\""},
+	Name:       whereHelperstring{field: "\"authz_policies\".\"name\""},
+	Effect:     whereHelperstring{field: "\"authz_policies\".\"effect\""},
+	Subjects:   whereHelpertypes_StringArray{field: "\"authz_policies\".\"subjects\""},
+	Actions:    whereHelpertypes_StringArray{field: "\"authz_policies\".\"actions\""},
+	Resources:  whereHelpertypes_StringArray{field: "\"authz_policies\".\"resources\""},
+	Conditions: whereHelpertypes_StringArray{field: "\"authz_policies\".\"conditions\""},
+	Attributes: whereHelpertypes_JSON{field: "\"authz_policies\".\"attributes\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"authz_policies\".\"created_at\""},
+	UpdatedAt:  whereHelpertime_Time{field: "\"authz_policies\".\"updated_at\""},
+}
+
+// AuthzPolicyRels is where relationship names are stored.
+var AuthzPolicyRels = struct {
+}{}
+
+// authzPolicyR is where relationships are stored.
+type authzPolicyR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*authzPolicyR) NewStruct() *authzPolicyR {
+	return &authzPolicyR{}
+}
+
+// authzPolicyL is where Load methods for each relationship are stored.
+type authzPolicyL struct{}
+
+var (
+	authzPolicyAllColumns            = []string{"id", "name", "effect", "subjects", "actions", "resources", "conditions", "attributes", "created_at", "updated_at"}
+	authzPolicyColumnsWithoutDefault = []string{"name", "subjects", "actions", "resources"}
+	authzPolicyColumnsWithDefault    = []string{"id", "effect", "conditions", "attributes", "created_at", "updated_at"}
+	authzPolicyPrimaryKeyColumns     = []string{"id"}
+	authzPolicyGeneratedColumns      = []string{}
+)
+
+type (
+	// AuthzPolicySlice is an alias for a slice of pointers to AuthzPolicy.
+	// This should almost always be used instead of []AuthzPolicy.
+	AuthzPolicySlice []*AuthzPolicy
+	// AuthzPolicyHook is the signature for custom AuthzPolicy hook methods
+	AuthzPolicyHook func(boil.Executor, *AuthzPolicy) error
+
+	authzPolicyQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	authzPolicyType                 = reflect.TypeOf(&AuthzPolicy{})
+	authzPolicyMapping              = queries.MakeStructMapping(authzPolicyType)
+	authzPolicyPrimaryKeyMapping, _ = queries.BindMapping(authzPolicyType, authzPolicyMapping, authzPolicyPrimaryKeyColumns)
+	authzPolicyInsertCacheMut       sync.RWMutex
+	authzPolicyInsertCache          = make(map[string]insertCache)
+	authzPolicyUpdateCacheMut       sync.RWMutex
+	authzPolicyUpdateCache          = make(map[string]updateCache)
+	authzPolicyUpsertCacheMut       sync.RWMutex
+	authzPolicyUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var authzPolicyAfterSelectMu sync.Mutex
+var authzPolicyAfterSelectHooks []AuthzPolicyHook
+
+var authzPolicyBeforeInsertMu sync.Mutex
+var authzPolicyBeforeInsertHooks []AuthzPolicyHook
+var authzPolicyAfterInsertMu sync.Mutex
+var authzPolicyAfterInsertHooks []AuthzPolicyHook
+
+var authzPolicyBeforeUpdateMu sync.Mutex
+var authzPolicyBeforeUpdateHooks []AuthzPolicyHook
+var authzPolicyAfterUpdateMu sync.Mutex
+var authzPolicyAfterUpdateHooks []AuthzPolicyHook
+
+var authzPolicyBeforeDeleteMu sync.Mutex
+var authzPolicyBeforeDeleteHooks []AuthzPolicyHook
+var authzPolicyAfterDeleteMu sync.Mutex
+var authzPolicyAfterDeleteHooks []AuthzPolicyHook
+
+var authzPolicyBeforeUpsertMu sync.Mutex
+var authzPolicyBeforeUpsertHooks []AuthzPolicyHook
+var authzPolicyAfterUpsertMu sync.Mutex
+var authzPolicyAfterUpsertHooks []AuthzPolicyHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *AuthzPolicy) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range authzPolicyAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *AuthzPolicy) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range authzPolicyBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *AuthzPolicy) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range authzPolicyAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *AuthzPolicy) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range authzPolicyBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *AuthzPolicy) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range authzPolicyAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *AuthzPolicy) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range authzPolicyBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *AuthzPolicy) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range authzPolicyAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *AuthzPolicy) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range authzPolicyBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *AuthzPolicy) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range authzPolicyAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAuthzPolicyHook registers your hook function for all future operations.
+func AddAuthzPolicyHook(hookPoint boil.HookPoint, authzPolicyHook AuthzPolicyHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		authzPolicyAfterSelectMu.Lock()
+		authzPolicyAfterSelectHooks = append(authzPolicyAfterSelectHooks, authzPolicyHook)
+		authzPolicyAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		authzPolicyBeforeInsertMu.Lock()
+		authzPolicyBeforeInsertHooks = append(authzPolicyBeforeInsertHooks, authzPolicyHook)
+		authzPolicyBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		authzPolicyAfterInsertMu.Lock()
+		authzPolicyAfterInsertHooks = append(authzPolicyAfterInsertHooks, authzPolicyHook)
+		authzPolicyAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		authzPolicyBeforeUpdateMu.Lock()
+		authzPolicyBeforeUpdateHooks = append(authzPolicyBeforeUpdateHooks, authzPolicyHook)
+		authzPolicyBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		authzPolicyAfterUpdateMu.Lock()
+		authzPolicyAfterUpdateHooks = append(authzPolicyAfterUpdateHooks, authzPolicyHook)
+		authzPolicyAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		authzPolicyBeforeDeleteMu.Lock()
+		authzPolicyBeforeDeleteHooks = append(authzPolicyBeforeDeleteHooks, authzPolicyHook)
+		authzPolicyBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		authzPolicyAfterDeleteMu.Lock()
+		authzPolicyAfterDeleteHooks = append(authzPolicyAfterDeleteHooks, authzPolicyHook)
+		authzPolicyAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		authzPolicyBeforeUpsertMu.Lock()
+		authzPolicyBeforeUpsertHooks = append(authzPolicyBeforeUpsertHooks, authzPolicyHook)
+		authzPolicyBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		authzPolicyAfterUpsertMu.Lock()
+		authzPolicyAfterUpsertHooks = append(authzPolicyAfterUpsertHooks, authzPolicyHook)
+		authzPolicyAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single authzPolicy record from the query using the global executor.
+func (q authzPolicyQuery) OneG() (*AuthzPolicy, error) {
+	return q.One(boil.GetDB())
+}
+
+// One returns a single authzPolicy record from the query.
+func (q authzPolicyQuery) One(exec boil.Executor) (*AuthzPolicy, error) {
+	o := &AuthzPolicy{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for authz_policies")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all AuthzPolicy records from the query using the global executor.
+func (q authzPolicyQuery) AllG() (AuthzPolicySlice, error) {
+	return q.All(boil.GetDB())
+}
+
+// All returns all AuthzPolicy records from the query.
+func (q authzPolicyQuery) All(exec boil.Executor) (AuthzPolicySlice, error) {
+	var o []*AuthzPolicy
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to AuthzPolicy slice")
+	}
+
+	if len(authzPolicyAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all AuthzPolicy records in the query using the global executor
+func (q authzPolicyQuery) CountG() (int64, error) {
+	return q.Count(boil.GetDB())
+}
+
+// Count returns the count of all AuthzPolicy records in the query.
+func (q authzPolicyQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count authz_policies rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q authzPolicyQuery) ExistsG() (bool, error) {
+	return q.Exists(boil.GetDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q authzPolicyQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if authz_policies exists")
+	}
+
+	return count > 0, nil
+}
+
+// AuthzPolicies retrieves all the records using an executor.
+func AuthzPolicies(mods ...qm.QueryMod) authzPolicyQuery {
+	mods = append(mods, qm.From("\"authz_policies\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"authz_policies\".*"})
+	}
+
+	return authzPolicyQuery{q}
+}
+
+// FindAuthzPolicyG retrieves a single record by ID.
+func FindAuthzPolicyG(iD int64, selectCols ...string) (*AuthzPolicy, error) {
+	return FindAuthzPolicy(boil.GetDB(), iD, selectCols...)
+}
+
+// FindAuthzPolicy retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAuthzPolicy(exec boil.Executor, iD int64, selectCols ...string) (*AuthzPolicy, error) {
+	authzPolicyObj := &AuthzPolicy{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"authz_policies\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, authzPolicyObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from authz_policies")
+	}
+
+	if err = authzPolicyObj.doAfterSelectHooks(exec); err != nil {
+		return authzPolicyObj, err
+	}
+
+	return authzPolicyObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *AuthzPolicy) InsertG(columns boil.Columns) error {
+	return o.Insert(boil.GetDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AuthzPolicy) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no authz_policies provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+	if o.UpdatedAt.IsZero() {
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(authzPolicyColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	authzPolicyInsertCacheMut.RLock()
+	cache, cached := authzPolicyInsertCache[key]
+	authzPolicyInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			authzPolicyAllColumns,
+			authzPolicyColumnsWithDefault,
+			authzPolicyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(authzPolicyType, authzPolicyMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(authzPolicyType, authzPolicyMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"authz_policies\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"authz_policies\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into authz_policies")
+	}
+
+	if !cached {
+		authzPolicyInsertCacheMut.Lock()
+		authzPolicyInsertCache[key] = cache
+		authzPolicyInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// UpdateG a single AuthzPolicy record using the global executor.
+// See Update for more documentation.
+func (o *AuthzPolicy) UpdateG(columns boil.Columns) (int64, error) {
+	return o.Update(boil.GetDB(), columns)
+}
+
+// Update uses an executor to update the AuthzPolicy.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AuthzPolicy) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	currTime := time.Now().In(boil.GetLocation())
+
+	o.UpdatedAt = currTime
+
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	authzPolicyUpdateCacheMut.RLock()
+	cache, cached := authzPolicyUpdateCache[key]
+	authzPolicyUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			authzPolicyAllColumns,
+			authzPolicyPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update authz_policies, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"authz_policies\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, authzPolicyPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(authzPolicyType, authzPolicyMapping, append(wl, authzPolicyPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update authz_policies row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for authz_policies")
+	}
+
+	if !cached {
+		authzPolicyUpdateCacheMut.Lock()
+		authzPolicyUpdateCache[key] = cache
+		authzPolicyUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q authzPolicyQuery) UpdateAllG(cols M) (int64, error) {
+	return q.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q authzPolicyQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for authz_policies")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for authz_policies")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o AuthzPolicySlice) UpdateAllG(cols M) (int64, error) {
+	return o.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AuthzPolicySlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), authzPolicyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"authz_policies\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, authzPolicyPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in authzPolicy slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all authzPolicy")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *AuthzPolicy) UpsertG(updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(boil.GetDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AuthzPolicy) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("orm: no authz_policies provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+	o.UpdatedAt = currTime
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(authzPolicyColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	authzPolicyUpsertCacheMut.RLock()
+	cache, cached := authzPolicyUpsertCache[key]
+	authzPolicyUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			authzPolicyAllColumns,
+			authzPolicyColumnsWithDefault,
+			authzPolicyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			authzPolicyAllColumns,
+			authzPolicyPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert authz_policies, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(authzPolicyAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(authzPolicyPrimaryKeyColumns) == 0 {
+				return errors.New("orm: unable to upsert authz_policies, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(authzPolicyPrimaryKeyColumns))
+			copy(conflict, authzPolicyPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"authz_policies\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(authzPolicyType, authzPolicyMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(authzPolicyType, authzPolicyMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert authz_policies")
+	}
+
+	if !cached {
+		authzPolicyUpsertCacheMut.Lock()
+		authzPolicyUpsertCache[key] = cache
+		authzPolicyUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// DeleteG deletes a single AuthzPolicy record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *AuthzPolicy) DeleteG() (int64, error) {
+	return o.Delete(boil.GetDB())
+}
+
+// Delete deletes a single AuthzPolicy record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AuthzPolicy) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no AuthzPolicy provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), authzPolicyPrimaryKeyMapping)
+	sql := "DELETE FROM \"authz_policies\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from authz_policies")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for authz_policies")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q authzPolicyQuery) DeleteAllG() (int64, error) {
+	return q.DeleteAll(boil.GetDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q authzPolicyQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no authzPolicyQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from authz_policies")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for authz_policies")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o AuthzPolicySlice) DeleteAllG() (int64, error) {
+	return o.DeleteAll(boil.GetDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AuthzPolicySlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(authzPolicyBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), authzPolicyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"authz_policies\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, authzPolicyPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from authzPolicy slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for authz_policies")
+	}
+
+	if len(authzPolicyAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *AuthzPolicy) ReloadG() error {
+	if o == nil {
+		return errors.New("orm: no AuthzPolicy provided for reload")
+	}
+
+	return o.Reload(boil.GetDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AuthzPolicy) Reload(exec boil.Executor) error {
+	ret, err := FindAuthzPolicy(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AuthzPolicySlice) ReloadAllG() error {
+	if o == nil {
+		return errors.New("orm: empty AuthzPolicySlice provided for reload all")
+	}
+
+	return o.ReloadAll(boil.GetDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AuthzPolicySlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AuthzPolicySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), authzPolicyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"authz_policies\".* FROM \"authz_policies\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, authzPolicyPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in AuthzPolicySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AuthzPolicyExistsG checks if the AuthzPolicy row exists.
+func AuthzPolicyExistsG(iD int64) (bool, error) {
+	return AuthzPolicyExists(boil.GetDB(), iD)
+}
+
+// AuthzPolicyExists checks if the AuthzPolicy row exists.
+func AuthzPolicyExists(exec boil.Executor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"authz_policies\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if authz_policies exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the AuthzPolicy row exists.
+func (o *AuthzPolicy) Exists(exec boil.Executor) (bool, error) {
+	return AuthzPolicyExists(exec, o.ID)
+}
@@ -4,7 +4,35 @@
 package orm
 
 var TableNames = struct {
-	Users string
+	APIKeys                 string
+	AuditLogs               string
+	AuthzPolicies           string
+	OrganizationInvitations string
+	OrganizationMembers     string
+	Organizations           string
+	Permissions             string
+	RolePermissions         string
+	Roles                   string
+	UserIdentities          string
+	UserMfa                 string
+	UserRecoveryCodes       string
+	UserRoles               string
+	Users                   string
+	WebauthnCredentials     string
 }{
-	Users: "users",
+	APIKeys:                 "api_keys",
+	AuditLogs:               "audit_logs",
+	AuthzPolicies:           "authz_policies",
+	OrganizationInvitations: "organization_invitations",
+	OrganizationMembers:     "organization_members",
+	Organizations:           "organizations",
+	Permissions:             "permissions",
+	RolePermissions:         "role_permissions",
+	Roles:                   "roles",
+	UserIdentities:          "user_identities",
+	UserMfa:                 "user_mfa",
+	UserRecoveryCodes:       "user_recovery_codes",
+	UserRoles:               "user_roles",
+	Users:                   "users",
+	WebauthnCredentials:     "webauthn_credentials",
 }
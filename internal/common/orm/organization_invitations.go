@@ -0,0 +1,1451 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// OrganizationInvitation is an object representing the database table.
+type OrganizationInvitation struct {
+	ID             int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	OrganizationID int64     `boil:"organization_id" json:"organization_id" toml:"organization_id" yaml:"organization_id"`
+	Email          string    `boil:"email" json:"email" toml:"email" yaml:"email"`
+	Role           string    `boil:"role" json:"role" toml:"role" yaml:"role"`
+	TokenHash      string    `boil:"token_hash" json:"token_hash" toml:"token_hash" yaml:"token_hash"`
+	InviterID      int64     `boil:"inviter_id" json:"inviter_id" toml:"inviter_id" yaml:"inviter_id"`
+	Status         string    `boil:"status" json:"status" toml:"status" yaml:"status"`
+	ExpiresAt      time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	RespondedAt    null.Time `boil:"responded_at" json:"responded_at,omitempty" toml:"responded_at" yaml:"responded_at,omitempty"`
+	CreatedAt      time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *organizationInvitationR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L organizationInvitationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OrganizationInvitationColumns = struct {
+	ID             string
+	OrganizationID string
+	Email          string
+	Role           string
+	TokenHash      string
+	InviterID      string
+	Status         string
+	ExpiresAt      string
+	RespondedAt    string
+	CreatedAt      string
+}{
+	ID:             "id",
+	OrganizationID: "organization_id",
+	Email:          "email",
+	Role:           "role",
+	TokenHash:      "token_hash",
+	InviterID:      "inviter_id",
+	Status:         "status",
+	ExpiresAt:      "expires_at",
+	RespondedAt:    "responded_at",
+	CreatedAt:      "created_at",
+}
+
+var OrganizationInvitationTableColumns = struct {
+	ID             string
+	OrganizationID string
+	Email          string
+	Role           string
+	TokenHash      string
+	InviterID      string
+	Status         string
+	ExpiresAt      string
+	RespondedAt    string
+	CreatedAt      string
+}{
+	ID:             "organization_invitations.id",
+	OrganizationID: "organization_invitations.organization_id",
+	Email:          "organization_invitations.email",
+	Role:           "organization_invitations.role",
+	TokenHash:      "organization_invitations.token_hash",
+	InviterID:      "organization_invitations.inviter_id",
+	Status:         "organization_invitations.status",
+	ExpiresAt:      "organization_invitations.expires_at",
+	RespondedAt:    "organization_invitations.responded_at",
+	CreatedAt:      "organization_invitations.created_at",
+}
+
+// Generated where
+
+var OrganizationInvitationWhere = struct {
+	ID             whereHelperint64
+	OrganizationID whereHelperint64
+	Email          whereHelperstring
+	Role           whereHelperstring
+	TokenHash      whereHelperstring
+	InviterID      whereHelperint64
+	Status         whereHelperstring
+	ExpiresAt      whereHelpertime_Time
+	RespondedAt    whereHelpernull_Time
+	CreatedAt      whereHelpertime_Time
+}{
+	ID:             whereHelperint64{field: "\"organization_invitations\".\"id\""},
+	OrganizationID: whereHelperint64{field: "\"organization_invitations\".\"organization_id\""},
+	Email:          whereHelperstring{field: "\"organization_invitations\".\"email\""},
+	Role:           whereHelperstring{field: "\"organization_invitations\".\"role\""},
+	TokenHash:      whereHelperstring{field: "\"organization_invitations\".\"token_hash\""},
+	InviterID:      whereHelperint64{field: "\"organization_invitations\".\"inviter_id\""},
+	Status:         whereHelperstring{field: "\"organization_invitations\".\"status\""},
+	ExpiresAt:      whereHelpertime_Time{field: "\"organization_invitations\".\"expires_at\""},
+	RespondedAt:    whereHelpernull_Time{field: "\"organization_invitations\".\"responded_at\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"organization_invitations\".\"created_at\""},
+}
+
+// OrganizationInvitationRels is where relationship names are stored.
+var OrganizationInvitationRels = struct {
+	Organization string
+	Inviter      string
+}{
+	Organization: "Organization",
+	Inviter:      "Inviter",
+}
+
+// organizationInvitationR is where relationships are stored.
+type organizationInvitationR struct {
+	Organization *Organization `boil:"Organization" json:"Organization" toml:"Organization" yaml:"Organization"`
+	Inviter      *User         `boil:"Inviter" json:"Inviter" toml:"Inviter" yaml:"Inviter"`
+}
+
+// NewStruct creates a new relationship struct
+func (*organizationInvitationR) NewStruct() *organizationInvitationR {
+	return &organizationInvitationR{}
+}
+
+func (o *OrganizationInvitation) GetOrganization() *Organization {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOrganization()
+}
+
+func (r *organizationInvitationR) GetOrganization() *Organization {
+	if r == nil {
+		return nil
+	}
+
+	return r.Organization
+}
+
+func (o *OrganizationInvitation) GetInviter() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetInviter()
+}
+
+func (r *organizationInvitationR) GetInviter() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.Inviter
+}
+
+// organizationInvitationL is where Load methods for each relationship are stored.
+type organizationInvitationL struct{}
+
+var (
+	organizationInvitationAllColumns            = []string{"id", "organization_id", "email", "role", "token_hash", "inviter_id", "status", "expires_at", "responded_at", "created_at"}
+	organizationInvitationColumnsWithoutDefault = []string{"organization_id", "email", "role", "token_hash", "inviter_id", "expires_at"}
+	organizationInvitationColumnsWithDefault    = []string{"id", "status", "responded_at", "created_at"}
+	organizationInvitationPrimaryKeyColumns     = []string{"id"}
+	organizationInvitationGeneratedColumns      = []string{}
+)
+
+type (
+	// OrganizationInvitationSlice is an alias for a slice of pointers to OrganizationInvitation.
+	// This should almost always be used instead of []OrganizationInvitation.
+	OrganizationInvitationSlice []*OrganizationInvitation
+	// OrganizationInvitationHook is the signature for custom OrganizationInvitation hook methods
+	OrganizationInvitationHook func(boil.Executor, *OrganizationInvitation) error
+
+	organizationInvitationQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	organizationInvitationType                 = reflect.TypeOf(&OrganizationInvitation{})
+	organizationInvitationMapping              = queries.MakeStructMapping(organizationInvitationType)
+	organizationInvitationPrimaryKeyMapping, _ = queries.BindMapping(organizationInvitationType, organizationInvitationMapping, organizationInvitationPrimaryKeyColumns)
+	organizationInvitationInsertCacheMut       sync.RWMutex
+	organizationInvitationInsertCache          = make(map[string]insertCache)
+	organizationInvitationUpdateCacheMut       sync.RWMutex
+	organizationInvitationUpdateCache          = make(map[string]updateCache)
+	organizationInvitationUpsertCacheMut       sync.RWMutex
+	organizationInvitationUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var organizationInvitationAfterSelectMu sync.Mutex
+var organizationInvitationAfterSelectHooks []OrganizationInvitationHook
+
+var organizationInvitationBeforeInsertMu sync.Mutex
+var organizationInvitationBeforeInsertHooks []OrganizationInvitationHook
+var organizationInvitationAfterInsertMu sync.Mutex
+var organizationInvitationAfterInsertHooks []OrganizationInvitationHook
+
+var organizationInvitationBeforeUpdateMu sync.Mutex
+var organizationInvitationBeforeUpdateHooks []OrganizationInvitationHook
+var organizationInvitationAfterUpdateMu sync.Mutex
+var organizationInvitationAfterUpdateHooks []OrganizationInvitationHook
+
+var organizationInvitationBeforeDeleteMu sync.Mutex
+var organizationInvitationBeforeDeleteHooks []OrganizationInvitationHook
+var organizationInvitationAfterDeleteMu sync.Mutex
+var organizationInvitationAfterDeleteHooks []OrganizationInvitationHook
+
+var organizationInvitationBeforeUpsertMu sync.Mutex
+var organizationInvitationBeforeUpsertHooks []OrganizationInvitationHook
+var organizationInvitationAfterUpsertMu sync.Mutex
+var organizationInvitationAfterUpsertHooks []OrganizationInvitationHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *OrganizationInvitation) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range organizationInvitationAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *OrganizationInvitation) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range organizationInvitationBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *OrganizationInvitation) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range organizationInvitationAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *OrganizationInvitation) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range organizationInvitationBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *OrganizationInvitation) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range organizationInvitationAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *OrganizationInvitation) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range organizationInvitationBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *OrganizationInvitation) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range organizationInvitationAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *OrganizationInvitation) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range organizationInvitationBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *OrganizationInvitation) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range organizationInvitationAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddOrganizationInvitationHook registers your hook function for all future operations.
+func AddOrganizationInvitationHook(hookPoint boil.HookPoint, organizationInvitationHook OrganizationInvitationHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		organizationInvitationAfterSelectMu.Lock()
+		organizationInvitationAfterSelectHooks = append(organizationInvitationAfterSelectHooks, organizationInvitationHook)
+		organizationInvitationAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		organizationInvitationBeforeInsertMu.Lock()
+		organizationInvitationBeforeInsertHooks = append(organizationInvitationBeforeInsertHooks, organizationInvitationHook)
+		organizationInvitationBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		organizationInvitationAfterInsertMu.Lock()
+		organizationInvitationAfterInsertHooks = append(organizationInvitationAfterInsertHooks, organizationInvitationHook)
+		organizationInvitationAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		organizationInvitationBeforeUpdateMu.Lock()
+		organizationInvitationBeforeUpdateHooks = append(organizationInvitationBeforeUpdateHooks, organizationInvitationHook)
+		organizationInvitationBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		organizationInvitationAfterUpdateMu.Lock()
+		organizationInvitationAfterUpdateHooks = append(organizationInvitationAfterUpdateHooks, organizationInvitationHook)
+		organizationInvitationAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		organizationInvitationBeforeDeleteMu.Lock()
+		organizationInvitationBeforeDeleteHooks = append(organizationInvitationBeforeDeleteHooks, organizationInvitationHook)
+		organizationInvitationBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		organizationInvitationAfterDeleteMu.Lock()
+		organizationInvitationAfterDeleteHooks = append(organizationInvitationAfterDeleteHooks, organizationInvitationHook)
+		organizationInvitationAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		organizationInvitationBeforeUpsertMu.Lock()
+		organizationInvitationBeforeUpsertHooks = append(organizationInvitationBeforeUpsertHooks, organizationInvitationHook)
+		organizationInvitationBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		organizationInvitationAfterUpsertMu.Lock()
+		organizationInvitationAfterUpsertHooks = append(organizationInvitationAfterUpsertHooks, organizationInvitationHook)
+		organizationInvitationAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single organizationInvitation record from the query using the global executor.
+func (q organizationInvitationQuery) OneG() (*OrganizationInvitation, error) {
+	return q.One(boil.GetDB())
+}
+
+// One returns a single organizationInvitation record from the query.
+func (q organizationInvitationQuery) One(exec boil.Executor) (*OrganizationInvitation, error) {
+	o := &OrganizationInvitation{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for organization_invitations")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all OrganizationInvitation records from the query using the global executor.
+func (q organizationInvitationQuery) AllG() (OrganizationInvitationSlice, error) {
+	return q.All(boil.GetDB())
+}
+
+// All returns all OrganizationInvitation records from the query.
+func (q organizationInvitationQuery) All(exec boil.Executor) (OrganizationInvitationSlice, error) {
+	var o []*OrganizationInvitation
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to OrganizationInvitation slice")
+	}
+
+	if len(organizationInvitationAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all OrganizationInvitation records in the query using the global executor
+func (q organizationInvitationQuery) CountG() (int64, error) {
+	return q.Count(boil.GetDB())
+}
+
+// Count returns the count of all OrganizationInvitation records in the query.
+func (q organizationInvitationQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count organization_invitations rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q organizationInvitationQuery) ExistsG() (bool, error) {
+	return q.Exists(boil.GetDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q organizationInvitationQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if organization_invitations exists")
+	}
+
+	return count > 0, nil
+}
+
+// Organization pointed to by the foreign key.
+func (o *OrganizationInvitation) Organization(mods ...qm.QueryMod) organizationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OrganizationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Organizations(queryMods...)
+}
+
+// Inviter pointed to by the foreign key.
+func (o *OrganizationInvitation) Inviter(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.InviterID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadOrganization allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (organizationInvitationL) LoadOrganization(e boil.Executor, singular bool, maybeOrganizationInvitation interface{}, mods queries.Applicator) error {
+	var slice []*OrganizationInvitation
+	var object *OrganizationInvitation
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrganizationInvitation.(*OrganizationInvitation)
+		if !ok {
+			object = new(OrganizationInvitation)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrganizationInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrganizationInvitation))
+			}
+		}
+	} else {
+		s, ok := maybeOrganizationInvitation.(*[]*OrganizationInvitation)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrganizationInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrganizationInvitation))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &organizationInvitationR{}
+		}
+		args[object.OrganizationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &organizationInvitationR{}
+			}
+
+			args[obj.OrganizationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`organizations`),
+		qm.WhereIn(`organizations.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Organization")
+	}
+
+	var resultSlice []*Organization
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Organization")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for organizations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for organizations")
+	}
+
+	if len(organizationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Organization = foreign
+		if foreign.R == nil {
+			foreign.R = &organizationR{}
+		}
+		foreign.R.OrganizationInvitations = append(foreign.R.OrganizationInvitations, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.OrganizationID == foreign.ID {
+				local.R.Organization = foreign
+				if foreign.R == nil {
+					foreign.R = &organizationR{}
+				}
+				foreign.R.OrganizationInvitations = append(foreign.R.OrganizationInvitations, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadInviter allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (organizationInvitationL) LoadInviter(e boil.Executor, singular bool, maybeOrganizationInvitation interface{}, mods queries.Applicator) error {
+	var slice []*OrganizationInvitation
+	var object *OrganizationInvitation
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrganizationInvitation.(*OrganizationInvitation)
+		if !ok {
+			object = new(OrganizationInvitation)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrganizationInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrganizationInvitation))
+			}
+		}
+	} else {
+		s, ok := maybeOrganizationInvitation.(*[]*OrganizationInvitation)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrganizationInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrganizationInvitation))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &organizationInvitationR{}
+		}
+		args[object.InviterID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &organizationInvitationR{}
+			}
+
+			args[obj.InviterID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Inviter = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.InviterOrganizationInvitations = append(foreign.R.InviterOrganizationInvitations, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.InviterID == foreign.ID {
+				local.R.Inviter = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.InviterOrganizationInvitations = append(foreign.R.InviterOrganizationInvitations, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetOrganizationG of the organizationInvitation to the related item.
+// Sets o.R.Organization to related.
+// Adds o to related.R.OrganizationInvitations.
+// Uses the global database handle.
+func (o *OrganizationInvitation) SetOrganizationG(insert bool, related *Organization) error {
+	return o.SetOrganization(boil.GetDB(), insert, related)
+}
+
+// SetOrganization of the organizationInvitation to the related item.
+// Sets o.R.Organization to related.
+// Adds o to related.R.OrganizationInvitations.
+func (o *OrganizationInvitation) SetOrganization(exec boil.Executor, insert bool, related *Organization) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"organization_invitations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"organization_id"}),
+		strmangle.WhereClause("\"", "\"", 2, organizationInvitationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.OrganizationID = related.ID
+	if o.R == nil {
+		o.R = &organizationInvitationR{
+			Organization: related,
+		}
+	} else {
+		o.R.Organization = related
+	}
+
+	if related.R == nil {
+		related.R = &organizationR{
+			OrganizationInvitations: OrganizationInvitationSlice{o},
+		}
+	} else {
+		related.R.OrganizationInvitations = append(related.R.OrganizationInvitations, o)
+	}
+
+	return nil
+}
+
+// SetInviterG of the organizationInvitation to the related item.
+// Sets o.R.Inviter to related.
+// Adds o to related.R.InviterOrganizationInvitations.
+// Uses the global database handle.
+func (o *OrganizationInvitation) SetInviterG(insert bool, related *User) error {
+	return o.SetInviter(boil.GetDB(), insert, related)
+}
+
+// SetInviter of the organizationInvitation to the related item.
+// Sets o.R.Inviter to related.
+// Adds o to related.R.InviterOrganizationInvitations.
+func (o *OrganizationInvitation) SetInviter(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"organization_invitations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"inviter_id"}),
+		strmangle.WhereClause("\"", "\"", 2, organizationInvitationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.InviterID = related.ID
+	if o.R == nil {
+		o.R = &organizationInvitationR{
+			Inviter: related,
+		}
+	} else {
+		o.R.Inviter = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			InviterOrganizationInvitations: OrganizationInvitationSlice{o},
+		}
+	} else {
+		related.R.InviterOrganizationInvitations = append(related.R.InviterOrganizationInvitations, o)
+	}
+
+	return nil
+}
+
+// OrganizationInvitations retrieves all the records using an executor.
+func OrganizationInvitations(mods ...qm.QueryMod) organizationInvitationQuery {
+	mods = append(mods, qm.From("\"organization_invitations\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"organization_invitations\".*"})
+	}
+
+	return organizationInvitationQuery{q}
+}
+
+// FindOrganizationInvitationG retrieves a single record by ID.
+func FindOrganizationInvitationG(iD int64, selectCols ...string) (*OrganizationInvitation, error) {
+	return FindOrganizationInvitation(boil.GetDB(), iD, selectCols...)
+}
+
+// FindOrganizationInvitation retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOrganizationInvitation(exec boil.Executor, iD int64, selectCols ...string) (*OrganizationInvitation, error) {
+	organizationInvitationObj := &OrganizationInvitation{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"organization_invitations\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, organizationInvitationObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from organization_invitations")
+	}
+
+	if err = organizationInvitationObj.doAfterSelectHooks(exec); err != nil {
+		return organizationInvitationObj, err
+	}
+
+	return organizationInvitationObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *OrganizationInvitation) InsertG(columns boil.Columns) error {
+	return o.Insert(boil.GetDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *OrganizationInvitation) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no organization_invitations provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(organizationInvitationColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	organizationInvitationInsertCacheMut.RLock()
+	cache, cached := organizationInvitationInsertCache[key]
+	organizationInvitationInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			organizationInvitationAllColumns,
+			organizationInvitationColumnsWithDefault,
+			organizationInvitationColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(organizationInvitationType, organizationInvitationMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(organizationInvitationType, organizationInvitationMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"organization_invitations\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"organization_invitations\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into organization_invitations")
+	}
+
+	if !cached {
+		organizationInvitationInsertCacheMut.Lock()
+		organizationInvitationInsertCache[key] = cache
+		organizationInvitationInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// UpdateG a single OrganizationInvitation record using the global executor.
+// See Update for more documentation.
+func (o *OrganizationInvitation) UpdateG(columns boil.Columns) (int64, error) {
+	return o.Update(boil.GetDB(), columns)
+}
+
+// Update uses an executor to update the OrganizationInvitation.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *OrganizationInvitation) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	organizationInvitationUpdateCacheMut.RLock()
+	cache, cached := organizationInvitationUpdateCache[key]
+	organizationInvitationUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			organizationInvitationAllColumns,
+			organizationInvitationPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update organization_invitations, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"organization_invitations\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, organizationInvitationPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(organizationInvitationType, organizationInvitationMapping, append(wl, organizationInvitationPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update organization_invitations row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for organization_invitations")
+	}
+
+	if !cached {
+		organizationInvitationUpdateCacheMut.Lock()
+		organizationInvitationUpdateCache[key] = cache
+		organizationInvitationUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q organizationInvitationQuery) UpdateAllG(cols M) (int64, error) {
+	return q.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q organizationInvitationQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for organization_invitations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for organization_invitations")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o OrganizationInvitationSlice) UpdateAllG(cols M) (int64, error) {
+	return o.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OrganizationInvitationSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), organizationInvitationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"organization_invitations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, organizationInvitationPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in organizationInvitation slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all organizationInvitation")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *OrganizationInvitation) UpsertG(updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(boil.GetDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *OrganizationInvitation) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("orm: no organization_invitations provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(organizationInvitationColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	organizationInvitationUpsertCacheMut.RLock()
+	cache, cached := organizationInvitationUpsertCache[key]
+	organizationInvitationUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			organizationInvitationAllColumns,
+			organizationInvitationColumnsWithDefault,
+			organizationInvitationColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			organizationInvitationAllColumns,
+			organizationInvitationPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert organization_invitations, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(organizationInvitationAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(organizationInvitationPrimaryKeyColumns) == 0 {
+				return errors.New("orm: unable to upsert organization_invitations, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(organizationInvitationPrimaryKeyColumns))
+			copy(conflict, organizationInvitationPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"organization_invitations\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(organizationInvitationType, organizationInvitationMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(organizationInvitationType, organizationInvitationMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert organization_invitations")
+	}
+
+	if !cached {
+		organizationInvitationUpsertCacheMut.Lock()
+		organizationInvitationUpsertCache[key] = cache
+		organizationInvitationUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// DeleteG deletes a single OrganizationInvitation record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *OrganizationInvitation) DeleteG() (int64, error) {
+	return o.Delete(boil.GetDB())
+}
+
+// Delete deletes a single OrganizationInvitation record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *OrganizationInvitation) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no OrganizationInvitation provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), organizationInvitationPrimaryKeyMapping)
+	sql := "DELETE FROM \"organization_invitations\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from organization_invitations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for organization_invitations")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q organizationInvitationQuery) DeleteAllG() (int64, error) {
+	return q.DeleteAll(boil.GetDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q organizationInvitationQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no organizationInvitationQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from organization_invitations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for organization_invitations")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o OrganizationInvitationSlice) DeleteAllG() (int64, error) {
+	return o.DeleteAll(boil.GetDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OrganizationInvitationSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(organizationInvitationBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), organizationInvitationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"organization_invitations\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, organizationInvitationPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from organizationInvitation slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for organization_invitations")
+	}
+
+	if len(organizationInvitationAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *OrganizationInvitation) ReloadG() error {
+	if o == nil {
+		return errors.New("orm: no OrganizationInvitation provided for reload")
+	}
+
+	return o.Reload(boil.GetDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *OrganizationInvitation) Reload(exec boil.Executor) error {
+	ret, err := FindOrganizationInvitation(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OrganizationInvitationSlice) ReloadAllG() error {
+	if o == nil {
+		return errors.New("orm: empty OrganizationInvitationSlice provided for reload all")
+	}
+
+	return o.ReloadAll(boil.GetDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OrganizationInvitationSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OrganizationInvitationSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), organizationInvitationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"organization_invitations\".* FROM \"organization_invitations\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, organizationInvitationPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in OrganizationInvitationSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OrganizationInvitationExistsG checks if the OrganizationInvitation row exists.
+func OrganizationInvitationExistsG(iD int64) (bool, error) {
+	return OrganizationInvitationExists(boil.GetDB(), iD)
+}
+
+// OrganizationInvitationExists checks if the OrganizationInvitation row exists.
+func OrganizationInvitationExists(exec boil.Executor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"organization_invitations\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if organization_invitations exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the OrganizationInvitation row exists.
+func (o *OrganizationInvitation) Exists(exec boil.Executor) (bool, error) {
+	return OrganizationInvitationExists(exec, o.ID)
+}
@@ -0,0 +1,1423 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// OrganizationMember is an object representing the database table.
+type OrganizationMember struct {
+	OrganizationID int64     `boil:"organization_id" json:"organization_id" toml:"organization_id" yaml:"organization_id"`
+	UserID         int64     `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Role           string    `boil:"role" json:"role" toml:"role" yaml:"role"`
+	CreatedAt      time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt      time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *organizationMemberR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L organizationMemberL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OrganizationMemberColumns = struct {
+	OrganizationID string
+	UserID         string
+	Role           string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	OrganizationID: "organization_id",
+	UserID:         "user_id",
+	Role:           "role",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+}
+
+var OrganizationMemberTableColumns = struct {
+	OrganizationID string
+	UserID         string
+	Role           string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	OrganizationID: "organization_members.organization_id",
+	UserID:         "organization_members.user_id",
+	Role:           "organization_members.role",
+	CreatedAt:      "organization_members.created_at",
+	UpdatedAt:      "organization_members.updated_at",
+}
+
+// Generated where
+
+var OrganizationMemberWhere = struct {
+	OrganizationID whereHelperint64
+	UserID         whereHelperint64
+	Role           whereHelperstring
+	CreatedAt      whereHelpertime_Time
+	UpdatedAt      whereHelpertime_Time
+}{
+	OrganizationID: whereHelperint64{field: "\"organization_members\".\"organization_id\""},
+	UserID:         whereHelperint64{field: "\"organization_members\".\"user_id\""},
+	Role:           whereHelperstring{field: "\"organization_members\".\"role\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"organization_members\".\"created_at\""},
+	UpdatedAt:      whereHelpertime_Time{field: "\"organization_members\".\"updated_at\""},
+}
+
+// OrganizationMemberRels is where relationship names are stored.
+var OrganizationMemberRels = struct {
+	Organization string
+	User         string
+}{
+	Organization: "Organization",
+	User:         "User",
+}
+
+// organizationMemberR is where relationships are stored.
+type organizationMemberR struct {
+	Organization *Organization `boil:"Organization" json:"Organization" toml:"Organization" yaml:"Organization"`
+	User         *User         `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*organizationMemberR) NewStruct() *organizationMemberR {
+	return &organizationMemberR{}
+}
+
+func (o *OrganizationMember) GetOrganization() *Organization {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOrganization()
+}
+
+func (r *organizationMemberR) GetOrganization() *Organization {
+	if r == nil {
+		return nil
+	}
+
+	return r.Organization
+}
+
+func (o *OrganizationMember) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *organizationMemberR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// organizationMemberL is where Load methods for each relationship are stored.
+type organizationMemberL struct{}
+
+var (
+	organizationMemberAllColumns            = []string{"organization_id", "user_id", "role", "created_at", "updated_at"}
+	organizationMemberColumnsWithoutDefault = []string{"organization_id", "user_id", "role"}
+	organizationMemberColumnsWithDefault    = []string{"created_at", "updated_at"}
+	organizationMemberPrimaryKeyColumns     = []string{"organization_id", "user_id"}
+	organizationMemberGeneratedColumns      = []string{}
+)
+
+type (
+	// OrganizationMemberSlice is an alias for a slice of pointers to OrganizationMember.
+	// This should almost always be used instead of []OrganizationMember.
+	OrganizationMemberSlice []*OrganizationMember
+	// OrganizationMemberHook is the signature for custom OrganizationMember hook methods
+	OrganizationMemberHook func(boil.Executor, *OrganizationMember) error
+
+	organizationMemberQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	organizationMemberType                 = reflect.TypeOf(&OrganizationMember{})
+	organizationMemberMapping              = queries.MakeStructMapping(organizationMemberType)
+	organizationMemberPrimaryKeyMapping, _ = queries.BindMapping(organizationMemberType, organizationMemberMapping, organizationMemberPrimaryKeyColumns)
+	organizationMemberInsertCacheMut       sync.RWMutex
+	organizationMemberInsertCache          = make(map[string]insertCache)
+	organizationMemberUpdateCacheMut       sync.RWMutex
+	organizationMemberUpdateCache          = make(map[string]updateCache)
+	organizationMemberUpsertCacheMut       sync.RWMutex
+	organizationMemberUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var organizationMemberAfterSelectMu sync.Mutex
+var organizationMemberAfterSelectHooks []OrganizationMemberHook
+
+var organizationMemberBeforeInsertMu sync.Mutex
+var organizationMemberBeforeInsertHooks []OrganizationMemberHook
+var organizationMemberAfterInsertMu sync.Mutex
+var organizationMemberAfterInsertHooks []OrganizationMemberHook
+
+var organizationMemberBeforeUpdateMu sync.Mutex
+var organizationMemberBeforeUpdateHooks []OrganizationMemberHook
+var organizationMemberAfterUpdateMu sync.Mutex
+var organizationMemberAfterUpdateHooks []OrganizationMemberHook
+
+var organizationMemberBeforeDeleteMu sync.Mutex
+var organizationMemberBeforeDeleteHooks []OrganizationMemberHook
+var organizationMemberAfterDeleteMu sync.Mutex
+var organizationMemberAfterDeleteHooks []OrganizationMemberHook
+
+var organizationMemberBeforeUpsertMu sync.Mutex
+var organizationMemberBeforeUpsertHooks []OrganizationMemberHook
+var organizationMemberAfterUpsertMu sync.Mutex
+var organizationMemberAfterUpsertHooks []OrganizationMemberHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *OrganizationMember) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range organizationMemberAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *OrganizationMember) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range organizationMemberBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *OrganizationMember) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range organizationMemberAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *OrganizationMember) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range organizationMemberBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *OrganizationMember) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range organizationMemberAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *OrganizationMember) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range organizationMemberBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *OrganizationMember) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range organizationMemberAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *OrganizationMember) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range organizationMemberBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *OrganizationMember) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range organizationMemberAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddOrganizationMemberHook registers your hook function for all future operations.
+func AddOrganizationMemberHook(hookPoint boil.HookPoint, organizationMemberHook OrganizationMemberHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		organizationMemberAfterSelectMu.Lock()
+		organizationMemberAfterSelectHooks = append(organizationMemberAfterSelectHooks, organizationMemberHook)
+		organizationMemberAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		organizationMemberBeforeInsertMu.Lock()
+		organizationMemberBeforeInsertHooks = append(organizationMemberBeforeInsertHooks, organizationMemberHook)
+		organizationMemberBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		organizationMemberAfterInsertMu.Lock()
+		organizationMemberAfterInsertHooks = append(organizationMemberAfterInsertHooks, organizationMemberHook)
+		organizationMemberAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		organizationMemberBeforeUpdateMu.Lock()
+		organizationMemberBeforeUpdateHooks = append(organizationMemberBeforeUpdateHooks, organizationMemberHook)
+		organizationMemberBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		organizationMemberAfterUpdateMu.Lock()
+		organizationMemberAfterUpdateHooks = append(organizationMemberAfterUpdateHooks, organizationMemberHook)
+		organizationMemberAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		organizationMemberBeforeDeleteMu.Lock()
+		organizationMemberBeforeDeleteHooks = append(organizationMemberBeforeDeleteHooks, organizationMemberHook)
+		organizationMemberBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		organizationMemberAfterDeleteMu.Lock()
+		organizationMemberAfterDeleteHooks = append(organizationMemberAfterDeleteHooks, organizationMemberHook)
+		organizationMemberAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		organizationMemberBeforeUpsertMu.Lock()
+		organizationMemberBeforeUpsertHooks = append(organizationMemberBeforeUpsertHooks, organizationMemberHook)
+		organizationMemberBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		organizationMemberAfterUpsertMu.Lock()
+		organizationMemberAfterUpsertHooks = append(organizationMemberAfterUpsertHooks, organizationMemberHook)
+		organizationMemberAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single organizationMember record from the query using the global executor.
+func (q organizationMemberQuery) OneG() (*OrganizationMember, error) {
+	return q.One(boil.GetDB())
+}
+
+// One returns a single organizationMember record from the query.
+func (q organizationMemberQuery) One(exec boil.Executor) (*OrganizationMember, error) {
+	o := &OrganizationMember{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for organization_members")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all OrganizationMember records from the query using the global executor.
+func (q organizationMemberQuery) AllG() (OrganizationMemberSlice, error) {
+	return q.All(boil.GetDB())
+}
+
+// All returns all OrganizationMember records from the query.
+func (q organizationMemberQuery) All(exec boil.Executor) (OrganizationMemberSlice, error) {
+	var o []*OrganizationMember
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to OrganizationMember slice")
+	}
+
+	if len(organizationMemberAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all OrganizationMember records in the query using the global executor
+func (q organizationMemberQuery) CountG() (int64, error) {
+	return q.Count(boil.GetDB())
+}
+
+// Count returns the count of all OrganizationMember records in the query.
+func (q organizationMemberQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count organization_members rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q organizationMemberQuery) ExistsG() (bool, error) {
+	return q.Exists(boil.GetDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q organizationMemberQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if organization_members exists")
+	}
+
+	return count > 0, nil
+}
+
+// Organization pointed to by the foreign key.
+func (o *OrganizationMember) Organization(mods ...qm.QueryMod) organizationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OrganizationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Organizations(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *OrganizationMember) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadOrganization allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (organizationMemberL) LoadOrganization(e boil.Executor, singular bool, maybeOrganizationMember interface{}, mods queries.Applicator) error {
+	var slice []*OrganizationMember
+	var object *OrganizationMember
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrganizationMember.(*OrganizationMember)
+		if !ok {
+			object = new(OrganizationMember)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrganizationMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrganizationMember))
+			}
+		}
+	} else {
+		s, ok := maybeOrganizationMember.(*[]*OrganizationMember)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrganizationMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrganizationMember))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &organizationMemberR{}
+		}
+		args[object.OrganizationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &organizationMemberR{}
+			}
+
+			args[obj.OrganizationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`organizations`),
+		qm.WhereIn(`organizations.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Organization")
+	}
+
+	var resultSlice []*Organization
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Organization")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for organizations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for organizations")
+	}
+
+	if len(organizationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Organization = foreign
+		if foreign.R == nil {
+			foreign.R = &organizationR{}
+		}
+		foreign.R.OrganizationMembers = append(foreign.R.OrganizationMembers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.OrganizationID == foreign.ID {
+				local.R.Organization = foreign
+				if foreign.R == nil {
+					foreign.R = &organizationR{}
+				}
+				foreign.R.OrganizationMembers = append(foreign.R.OrganizationMembers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (organizationMemberL) LoadUser(e boil.Executor, singular bool, maybeOrganizationMember interface{}, mods queries.Applicator) error {
+	var slice []*OrganizationMember
+	var object *OrganizationMember
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrganizationMember.(*OrganizationMember)
+		if !ok {
+			object = new(OrganizationMember)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrganizationMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrganizationMember))
+			}
+		}
+	} else {
+		s, ok := maybeOrganizationMember.(*[]*OrganizationMember)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrganizationMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrganizationMember))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &organizationMemberR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &organizationMemberR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.OrganizationMembers = append(foreign.R.OrganizationMembers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.OrganizationMembers = append(foreign.R.OrganizationMembers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetOrganizationG of the organizationMember to the related item.
+// Sets o.R.Organization to related.
+// Adds o to related.R.OrganizationMembers.
+// Uses the global database handle.
+func (o *OrganizationMember) SetOrganizationG(insert bool, related *Organization) error {
+	return o.SetOrganization(boil.GetDB(), insert, related)
+}
+
+// SetOrganization of the organizationMember to the related item.
+// Sets o.R.Organization to related.
+// Adds o to related.R.OrganizationMembers.
+func (o *OrganizationMember) SetOrganization(exec boil.Executor, insert bool, related *Organization) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"organization_members\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"organization_id"}),
+		strmangle.WhereClause("\"", "\"", 2, organizationMemberPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.OrganizationID, o.UserID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.OrganizationID = related.ID
+	if o.R == nil {
+		o.R = &organizationMemberR{
+			Organization: related,
+		}
+	} else {
+		o.R.Organization = related
+	}
+
+	if related.R == nil {
+		related.R = &organizationR{
+			OrganizationMembers: OrganizationMemberSlice{o},
+		}
+	} else {
+		related.R.OrganizationMembers = append(related.R.OrganizationMembers, o)
+	}
+
+	return nil
+}
+
+// SetUserG of the organizationMember to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.OrganizationMembers.
+// Uses the global database handle.
+func (o *OrganizationMember) SetUserG(insert bool, related *User) error {
+	return o.SetUser(boil.GetDB(), insert, related)
+}
+
+// SetUser of the organizationMember to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.OrganizationMembers.
+func (o *OrganizationMember) SetUser(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"organization_members\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, organizationMemberPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.OrganizationID, o.UserID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &organizationMemberR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			OrganizationMembers: OrganizationMemberSlice{o},
+		}
+	} else {
+		related.R.OrganizationMembers = append(related.R.OrganizationMembers, o)
+	}
+
+	return nil
+}
+
+// OrganizationMembers retrieves all the records using an executor.
+func OrganizationMembers(mods ...qm.QueryMod) organizationMemberQuery {
+	mods = append(mods, qm.From("\"organization_members\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"organization_members\".*"})
+	}
+
+	return organizationMemberQuery{q}
+}
+
+// FindOrganizationMemberG retrieves a single record by ID.
+func FindOrganizationMemberG(organizationID int64, userID int64, selectCols ...string) (*OrganizationMember, error) {
+	return FindOrganizationMember(boil.GetDB(), organizationID, userID, selectCols...)
+}
+
+// FindOrganizationMember retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOrganizationMember(exec boil.Executor, organizationID int64, userID int64, selectCols ...string) (*OrganizationMember, error) {
+	organizationMemberObj := &OrganizationMember{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"organization_members\" where \"organization_id\"=$1 AND \"user_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, organizationID, userID)
+
+	err := q.Bind(nil, exec, organizationMemberObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from organization_members")
+	}
+
+	if err = organizationMemberObj.doAfterSelectHooks(exec); err != nil {
+		return organizationMemberObj, err
+	}
+
+	return organizationMemberObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *OrganizationMember) InsertG(columns boil.Columns) error {
+	return o.Insert(boil.GetDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *OrganizationMember) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no organization_members provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+	if o.UpdatedAt.IsZero() {
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(organizationMemberColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	organizationMemberInsertCacheMut.RLock()
+	cache, cached := organizationMemberInsertCache[key]
+	organizationMemberInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			organizationMemberAllColumns,
+			organizationMemberColumnsWithDefault,
+			organizationMemberColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(organizationMemberType, organizationMemberMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(organizationMemberType, organizationMemberMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"organization_members\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"organization_members\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into organization_members")
+	}
+
+	if !cached {
+		organizationMemberInsertCacheMut.Lock()
+		organizationMemberInsertCache[key] = cache
+		organizationMemberInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// UpdateG a single OrganizationMember record using the global executor.
+// See Update for more documentation.
+func (o *OrganizationMember) UpdateG(columns boil.Columns) (int64, error) {
+	return o.Update(boil.GetDB(), columns)
+}
+
+// Update uses an executor to update the OrganizationMember.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *OrganizationMember) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	currTime := time.Now().In(boil.GetLocation())
+
+	o.UpdatedAt = currTime
+
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	organizationMemberUpdateCacheMut.RLock()
+	cache, cached := organizationMemberUpdateCache[key]
+	organizationMemberUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			organizationMemberAllColumns,
+			organizationMemberPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update organization_members, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"organization_members\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, organizationMemberPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(organizationMemberType, organizationMemberMapping, append(wl, organizationMemberPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update organization_members row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for organization_members")
+	}
+
+	if !cached {
+		organizationMemberUpdateCacheMut.Lock()
+		organizationMemberUpdateCache[key] = cache
+		organizationMemberUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q organizationMemberQuery) UpdateAllG(cols M) (int64, error) {
+	return q.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q organizationMemberQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for organization_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for organization_members")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o OrganizationMemberSlice) UpdateAllG(cols M) (int64, error) {
+	return o.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OrganizationMemberSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), organizationMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"organization_members\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, organizationMemberPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in organizationMember slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all organizationMember")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *OrganizationMember) UpsertG(updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(boil.GetDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *OrganizationMember) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("orm: no organization_members provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+	o.UpdatedAt = currTime
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(organizationMemberColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	organizationMemberUpsertCacheMut.RLock()
+	cache, cached := organizationMemberUpsertCache[key]
+	organizationMemberUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			organizationMemberAllColumns,
+			organizationMemberColumnsWithDefault,
+			organizationMemberColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			organizationMemberAllColumns,
+			organizationMemberPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert organization_members, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(organizationMemberAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(organizationMemberPrimaryKeyColumns) == 0 {
+				return errors.New("orm: unable to upsert organization_members, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(organizationMemberPrimaryKeyColumns))
+			copy(conflict, organizationMemberPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"organization_members\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(organizationMemberType, organizationMemberMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(organizationMemberType, organizationMemberMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert organization_members")
+	}
+
+	if !cached {
+		organizationMemberUpsertCacheMut.Lock()
+		organizationMemberUpsertCache[key] = cache
+		organizationMemberUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// DeleteG deletes a single OrganizationMember record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *OrganizationMember) DeleteG() (int64, error) {
+	return o.Delete(boil.GetDB())
+}
+
+// Delete deletes a single OrganizationMember record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *OrganizationMember) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no OrganizationMember provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), organizationMemberPrimaryKeyMapping)
+	sql := "DELETE FROM \"organization_members\" WHERE \"organization_id\"=$1 AND \"user_id\"=$2"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from organization_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for organization_members")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q organizationMemberQuery) DeleteAllG() (int64, error) {
+	return q.DeleteAll(boil.GetDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q organizationMemberQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no organizationMemberQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from organization_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for organization_members")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o OrganizationMemberSlice) DeleteAllG() (int64, error) {
+	return o.DeleteAll(boil.GetDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OrganizationMemberSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(organizationMemberBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), organizationMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"organization_members\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, organizationMemberPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from organizationMember slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for organization_members")
+	}
+
+	if len(organizationMemberAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *OrganizationMember) ReloadG() error {
+	if o == nil {
+		return errors.New("orm: no OrganizationMember provided for reload")
+	}
+
+	return o.Reload(boil.GetDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *OrganizationMember) Reload(exec boil.Executor) error {
+	ret, err := FindOrganizationMember(exec, o.OrganizationID, o.UserID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OrganizationMemberSlice) ReloadAllG() error {
+	if o == nil {
+		return errors.New("orm: empty OrganizationMemberSlice provided for reload all")
+	}
+
+	return o.ReloadAll(boil.GetDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OrganizationMemberSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OrganizationMemberSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), organizationMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"organization_members\".* FROM \"organization_members\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, organizationMemberPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in OrganizationMemberSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OrganizationMemberExistsG checks if the OrganizationMember row exists.
+func OrganizationMemberExistsG(organizationID int64, userID int64) (bool, error) {
+	return OrganizationMemberExists(boil.GetDB(), organizationID, userID)
+}
+
+// OrganizationMemberExists checks if the OrganizationMember row exists.
+func OrganizationMemberExists(exec boil.Executor, organizationID int64, userID int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"organization_members\" where \"organization_id\"=$1 AND \"user_id\"=$2 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, organizationID, userID)
+	}
+	row := exec.QueryRow(sql, organizationID, userID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if organization_members exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the OrganizationMember row exists.
+func (o *OrganizationMember) Exists(exec boil.Executor) (bool, error) {
+	return OrganizationMemberExists(exec, o.OrganizationID, o.UserID)
+}
@@ -0,0 +1,1428 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// Organization is an object representing the database table.
+type Organization struct {
+	ID        int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Slug      string    `boil:"slug" json:"slug" toml:"slug" yaml:"slug"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *organizationR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L organizationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OrganizationColumns = struct {
+	ID        string
+	Name      string
+	Slug      string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "id",
+	Name:      "name",
+	Slug:      "slug",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+var OrganizationTableColumns = struct {
+	ID        string
+	Name      string
+	Slug      string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "organizations.id",
+	Name:      "organizations.name",
+	Slug:      "organizations.slug",
+	CreatedAt: "organizations.created_at",
+	UpdatedAt: "organizations.updated_at",
+}
+
+// Generated where
+
+var OrganizationWhere = struct {
+	ID        whereHelperint64
+	Name      whereHelperstring
+	Slug      whereHelperstring
+	CreatedAt whereHelpertime_Time
+	UpdatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperint64{field: "\"organizations\".\"id\""},
+	Name:      whereHelperstring{field: "\"organizations\".\"name\""},
+	Slug:      whereHelperstring{field: "\"organizations\".\"slug\""},
+	CreatedAt: whereHelpertime_Time{field: "\"organizations\".\"created_at\""},
+	UpdatedAt: whereHelpertime_Time{field: "\"organizations\".\"updated_at\""},
+}
+
+// OrganizationRels is where relationship names are stored.
+var OrganizationRels = struct {
+	OrganizationInvitations string
+	OrganizationMembers     string
+}{
+	OrganizationInvitations: "OrganizationInvitations",
+	OrganizationMembers:     "OrganizationMembers",
+}
+
+// organizationR is where relationships are stored.
+type organizationR struct {
+	OrganizationInvitations OrganizationInvitationSlice `boil:"OrganizationInvitations" json:"OrganizationInvitations" toml:"OrganizationInvitations" yaml:"OrganizationInvitations"`
+	OrganizationMembers     OrganizationMemberSlice     `boil:"OrganizationMembers" json:"OrganizationMembers" toml:"OrganizationMembers" yaml:"OrganizationMembers"`
+}
+
+// NewStruct creates a new relationship struct
+func (*organizationR) NewStruct() *organizationR {
+	return &organizationR{}
+}
+
+func (o *Organization) GetOrganizationInvitations() OrganizationInvitationSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOrganizationInvitations()
+}
+
+func (r *organizationR) GetOrganizationInvitations() OrganizationInvitationSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.OrganizationInvitations
+}
+
+func (o *Organization) GetOrganizationMembers() OrganizationMemberSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOrganizationMembers()
+}
+
+func (r *organizationR) GetOrganizationMembers() OrganizationMemberSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.OrganizationMembers
+}
+
+// organizationL is where Load methods for each relationship are stored.
+type organizationL struct{}
+
+var (
+	organizationAllColumns            = []string{"id", "name", "slug", "created_at", "updated_at"}
+	organizationColumnsWithoutDefault = []string{"name", "slug"}
+	organizationColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	organizationPrimaryKeyColumns     = []string{"id"}
+	organizationGeneratedColumns      = []string{}
+)
+
+type (
+	// OrganizationSlice is an alias for a slice of pointers to Organization.
+	// This should almost always be used instead of []Organization.
+	OrganizationSlice []*Organization
+	// OrganizationHook is the signature for custom Organization hook methods
+	OrganizationHook func(boil.Executor, *Organization) error
+
+	organizationQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	organizationType                 = reflect.TypeOf(&Organization{})
+	organizationMapping              = queries.MakeStructMapping(organizationType)
+	organizationPrimaryKeyMapping, _ = queries.BindMapping(organizationType, organizationMapping, organizationPrimaryKeyColumns)
+	organizationInsertCacheMut       sync.RWMutex
+	organizationInsertCache          = make(map[string]insertCache)
+	organizationUpdateCacheMut       sync.RWMutex
+	organizationUpdateCache          = make(map[string]updateCache)
+	organizationUpsertCacheMut       sync.RWMutex
+	organizationUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var organizationAfterSelectMu sync.Mutex
+var organizationAfterSelectHooks []OrganizationHook
+
+var organizationBeforeInsertMu sync.Mutex
+var organizationBeforeInsertHooks []OrganizationHook
+var organizationAfterInsertMu sync.Mutex
+var organizationAfterInsertHooks []OrganizationHook
+
+var organizationBeforeUpdateMu sync.Mutex
+var organizationBeforeUpdateHooks []OrganizationHook
+var organizationAfterUpdateMu sync.Mutex
+var organizationAfterUpdateHooks []OrganizationHook
+
+var organizationBeforeDeleteMu sync.Mutex
+var organizationBeforeDeleteHooks []OrganizationHook
+var organizationAfterDeleteMu sync.Mutex
+var organizationAfterDeleteHooks []OrganizationHook
+
+var organizationBeforeUpsertMu sync.Mutex
+var organizationBeforeUpsertHooks []OrganizationHook
+var organizationAfterUpsertMu sync.Mutex
+var organizationAfterUpsertHooks []OrganizationHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Organization) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range organizationAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Organization) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range organizationBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Organization) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range organizationAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Organization) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range organizationBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Organization) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range organizationAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Organization) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range organizationBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Organization) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range organizationAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Organization) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range organizationBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Organization) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range organizationAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddOrganizationHook registers your hook function for all future operations.
+func AddOrganizationHook(hookPoint boil.HookPoint, organizationHook OrganizationHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		organizationAfterSelectMu.Lock()
+		organizationAfterSelectHooks = append(organizationAfterSelectHooks, organizationHook)
+		organizationAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		organizationBeforeInsertMu.Lock()
+		organizationBeforeInsertHooks = append(organizationBeforeInsertHooks, organizationHook)
+		organizationBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		organizationAfterInsertMu.Lock()
+		organizationAfterInsertHooks = append(organizationAfterInsertHooks, organizationHook)
+		organizationAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		organizationBeforeUpdateMu.Lock()
+		organizationBeforeUpdateHooks = append(organizationBeforeUpdateHooks, organizationHook)
+		organizationBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		organizationAfterUpdateMu.Lock()
+		organizationAfterUpdateHooks = append(organizationAfterUpdateHooks, organizationHook)
+		organizationAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		organizationBeforeDeleteMu.Lock()
+		organizationBeforeDeleteHooks = append(organizationBeforeDeleteHooks, organizationHook)
+		organizationBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		organizationAfterDeleteMu.Lock()
+		organizationAfterDeleteHooks = append(organizationAfterDeleteHooks, organizationHook)
+		organizationAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		organizationBeforeUpsertMu.Lock()
+		organizationBeforeUpsertHooks = append(organizationBeforeUpsertHooks, organizationHook)
+		organizationBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		organizationAfterUpsertMu.Lock()
+		organizationAfterUpsertHooks = append(organizationAfterUpsertHooks, organizationHook)
+		organizationAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single organization record from the query using the global executor.
+func (q organizationQuery) OneG() (*Organization, error) {
+	return q.One(boil.GetDB())
+}
+
+// One returns a single organization record from the query.
+func (q organizationQuery) One(exec boil.Executor) (*Organization, error) {
+	o := &Organization{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for organizations")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all Organization records from the query using the global executor.
+func (q organizationQuery) AllG() (OrganizationSlice, error) {
+	return q.All(boil.GetDB())
+}
+
+// All returns all Organization records from the query.
+func (q organizationQuery) All(exec boil.Executor) (OrganizationSlice, error) {
+	var o []*Organization
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to Organization slice")
+	}
+
+	if len(organizationAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all Organization records in the query using the global executor
+func (q organizationQuery) CountG() (int64, error) {
+	return q.Count(boil.GetDB())
+}
+
+// Count returns the count of all Organization records in the query.
+func (q organizationQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count organizations rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q organizationQuery) ExistsG() (bool, error) {
+	return q.Exists(boil.GetDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q organizationQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if organizations exists")
+	}
+
+	return count > 0, nil
+}
+
+// OrganizationInvitations retrieves all the organization_invitation's OrganizationInvitations with an executor.
+func (o *Organization) OrganizationInvitations(mods ...qm.QueryMod) organizationInvitationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"organization_invitations\".\"organization_id\"=?", o.ID),
+	)
+
+	return OrganizationInvitations(queryMods...)
+}
+
+// OrganizationMembers retrieves all the organization_member's OrganizationMembers with an executor.
+func (o *Organization) OrganizationMembers(mods ...qm.QueryMod) organizationMemberQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"organization_members\".\"organization_id\"=?", o.ID),
+	)
+
+	return OrganizationMembers(queryMods...)
+}
+
+// LoadOrganizationInvitations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (organizationL) LoadOrganizationInvitations(e boil.Executor, singular bool, maybeOrganization interface{}, mods queries.Applicator) error {
+	var slice []*Organization
+	var object *Organization
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrganization.(*Organization)
+		if !ok {
+			object = new(Organization)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrganization))
+			}
+		}
+	} else {
+		s, ok := maybeOrganization.(*[]*Organization)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrganization))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &organizationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &organizationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`organization_invitations`),
+		qm.WhereIn(`organization_invitations.organization_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load organization_invitations")
+	}
+
+	var resultSlice []*OrganizationInvitation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice organization_invitations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on organization_invitations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for organization_invitations")
+	}
+
+	if len(organizationInvitationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OrganizationInvitations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &organizationInvitationR{}
+			}
+			foreign.R.Organization = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OrganizationID {
+				local.R.OrganizationInvitations = append(local.R.OrganizationInvitations, foreign)
+				if foreign.R == nil {
+					foreign.R = &organizationInvitationR{}
+				}
+				foreign.R.Organization = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOrganizationMembers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (organizationL) LoadOrganizationMembers(e boil.Executor, singular bool, maybeOrganization interface{}, mods queries.Applicator) error {
+	var slice []*Organization
+	var object *Organization
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrganization.(*Organization)
+		if !ok {
+			object = new(Organization)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrganization))
+			}
+		}
+	} else {
+		s, ok := maybeOrganization.(*[]*Organization)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrganization)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrganization))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &organizationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &organizationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`organization_members`),
+		qm.WhereIn(`organization_members.organization_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load organization_members")
+	}
+
+	var resultSlice []*OrganizationMember
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice organization_members")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on organization_members")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for organization_members")
+	}
+
+	if len(organizationMemberAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OrganizationMembers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &organizationMemberR{}
+			}
+			foreign.R.Organization = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OrganizationID {
+				local.R.OrganizationMembers = append(local.R.OrganizationMembers, foreign)
+				if foreign.R == nil {
+					foreign.R = &organizationMemberR{}
+				}
+				foreign.R.Organization = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddOrganizationInvitationsG adds the given related objects to the existing relationships
+// of the organization, optionally inserting them as new records.
+// Appends related to o.R.OrganizationInvitations.
+// Sets related.R.Organization appropriately.
+// Uses the global database handle.
+func (o *Organization) AddOrganizationInvitationsG(insert bool, related ...*OrganizationInvitation) error {
+	return o.AddOrganizationInvitations(boil.GetDB(), insert, related...)
+}
+
+// AddOrganizationInvitations adds the given related objects to the existing relationships
+// of the organization, optionally inserting them as new records.
+// Appends related to o.R.OrganizationInvitations.
+// Sets related.R.Organization appropriately.
+func (o *Organization) AddOrganizationInvitations(exec boil.Executor, insert bool, related ...*OrganizationInvitation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OrganizationID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"organization_invitations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"organization_id"}),
+				strmangle.WhereClause("\"", "\"", 2, organizationInvitationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OrganizationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &organizationR{
+			OrganizationInvitations: related,
+		}
+	} else {
+		o.R.OrganizationInvitations = append(o.R.OrganizationInvitations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &organizationInvitationR{
+				Organization: o,
+			}
+		} else {
+			rel.R.Organization = o
+		}
+	}
+	return nil
+}
+
+// AddOrganizationMembersG adds the given related objects to the existing relationships
+// of the organization, optionally inserting them as new records.
+// Appends related to o.R.OrganizationMembers.
+// Sets related.R.Organization appropriately.
+// Uses the global database handle.
+func (o *Organization) AddOrganizationMembersG(insert bool, related ...*OrganizationMember) error {
+	return o.AddOrganizationMembers(boil.GetDB(), insert, related...)
+}
+
+// AddOrganizationMembers adds the given related objects to the existing relationships
+// of the organization, optionally inserting them as new records.
+// Appends related to o.R.OrganizationMembers.
+// Sets related.R.Organization appropriately.
+func (o *Organization) AddOrganizationMembers(exec boil.Executor, insert bool, related ...*OrganizationMember) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OrganizationID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"organization_members\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"organization_id"}),
+				strmangle.WhereClause("\"", "\"", 2, organizationMemberPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.OrganizationID, rel.UserID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OrganizationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &organizationR{
+			OrganizationMembers: related,
+		}
+	} else {
+		o.R.OrganizationMembers = append(o.R.OrganizationMembers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &organizationMemberR{
+				Organization: o,
+			}
+		} else {
+			rel.R.Organization = o
+		}
+	}
+	return nil
+}
+
+// Organizations retrieves all the records using an executor.
+func Organizations(mods ...qm.QueryMod) organizationQuery {
+	mods = append(mods, qm.From("\"organizations\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"organizations\".*"})
+	}
+
+	return organizationQuery{q}
+}
+
+// FindOrganizationG retrieves a single record by ID.
+func FindOrganizationG(iD int64, selectCols ...string) (*Organization, error) {
+	return FindOrganization(boil.GetDB(), iD, selectCols...)
+}
+
+// FindOrganization retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOrganization(exec boil.Executor, iD int64, selectCols ...string) (*Organization, error) {
+	organizationObj := &Organization{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"organizations\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, organizationObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from organizations")
+	}
+
+	if err = organizationObj.doAfterSelectHooks(exec); err != nil {
+		return organizationObj, err
+	}
+
+	return organizationObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *Organization) InsertG(columns boil.Columns) error {
+	return o.Insert(boil.GetDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Organization) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no organizations provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+	if o.UpdatedAt.IsZero() {
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(organizationColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	organizationInsertCacheMut.RLock()
+	cache, cached := organizationInsertCache[key]
+	organizationInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			organizationAllColumns,
+			organizationColumnsWithDefault,
+			organizationColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(organizationType, organizationMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(organizationType, organizationMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"organizations\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"organizations\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into organizations")
+	}
+
+	if !cached {
+		organizationInsertCacheMut.Lock()
+		organizationInsertCache[key] = cache
+		organizationInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// UpdateG a single Organization record using the global executor.
+// See Update for more documentation.
+func (o *Organization) UpdateG(columns boil.Columns) (int64, error) {
+	return o.Update(boil.GetDB(), columns)
+}
+
+// Update uses an executor to update the Organization.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Organization) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	currTime := time.Now().In(boil.GetLocation())
+
+	o.UpdatedAt = currTime
+
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	organizationUpdateCacheMut.RLock()
+	cache, cached := organizationUpdateCache[key]
+	organizationUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			organizationAllColumns,
+			organizationPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update organizations, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"organizations\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, organizationPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(organizationType, organizationMapping, append(wl, organizationPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update organizations row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for organizations")
+	}
+
+	if !cached {
+		organizationUpdateCacheMut.Lock()
+		organizationUpdateCache[key] = cache
+		organizationUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q organizationQuery) UpdateAllG(cols M) (int64, error) {
+	return q.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q organizationQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for organizations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for organizations")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o OrganizationSlice) UpdateAllG(cols M) (int64, error) {
+	return o.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OrganizationSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), organizationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"organizations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, organizationPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in organization slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all organization")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *Organization) UpsertG(updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(boil.GetDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Organization) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("orm: no organizations provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+	o.UpdatedAt = currTime
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(organizationColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	organizationUpsertCacheMut.RLock()
+	cache, cached := organizationUpsertCache[key]
+	organizationUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			organizationAllColumns,
+			organizationColumnsWithDefault,
+			organizationColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			organizationAllColumns,
+			organizationPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert organizations, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(organizationAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(organizationPrimaryKeyColumns) == 0 {
+				return errors.New("orm: unable to upsert organizations, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(organizationPrimaryKeyColumns))
+			copy(conflict, organizationPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"organizations\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(organizationType, organizationMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(organizationType, organizationMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert organizations")
+	}
+
+	if !cached {
+		organizationUpsertCacheMut.Lock()
+		organizationUpsertCache[key] = cache
+		organizationUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// DeleteG deletes a single Organization record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *Organization) DeleteG() (int64, error) {
+	return o.Delete(boil.GetDB())
+}
+
+// Delete deletes a single Organization record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Organization) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no Organization provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), organizationPrimaryKeyMapping)
+	sql := "DELETE FROM \"organizations\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from organizations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for organizations")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q organizationQuery) DeleteAllG() (int64, error) {
+	return q.DeleteAll(boil.GetDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q organizationQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no organizationQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from organizations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for organizations")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o OrganizationSlice) DeleteAllG() (int64, error) {
+	return o.DeleteAll(boil.GetDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OrganizationSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(organizationBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), organizationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"organizations\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, organizationPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from organization slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for organizations")
+	}
+
+	if len(organizationAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *Organization) ReloadG() error {
+	if o == nil {
+		return errors.New("orm: no Organization provided for reload")
+	}
+
+	return o.Reload(boil.GetDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Organization) Reload(exec boil.Executor) error {
+	ret, err := FindOrganization(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OrganizationSlice) ReloadAllG() error {
+	if o == nil {
+		return errors.New("orm: empty OrganizationSlice provided for reload all")
+	}
+
+	return o.ReloadAll(boil.GetDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OrganizationSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OrganizationSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), organizationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"organizations\".* FROM \"organizations\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, organizationPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in OrganizationSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OrganizationExistsG checks if the Organization row exists.
+func OrganizationExistsG(iD int64) (bool, error) {
+	return OrganizationExists(boil.GetDB(), iD)
+}
+
+// OrganizationExists checks if the Organization row exists.
+func OrganizationExists(exec boil.Executor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"organizations\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if organizations exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Organization row exists.
+func (o *Organization) Exists(exec boil.Executor) (bool, error) {
+	return OrganizationExists(exec, o.ID)
+}
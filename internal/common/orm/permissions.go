@@ -0,0 +1,1206 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// Permission is an object representing the database table.
+type Permission struct {
+	ID          int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Code        string    `boil:"code" json:"code" toml:"code" yaml:"code"`
+	Description string    `boil:"description" json:"description" toml:"description" yaml:"description"`
+	CreatedAt   time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *permissionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L permissionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PermissionColumns = struct {
+	ID          string
+	Code        string
+	Description string
+	CreatedAt   string
+}{
+	ID:          "id",
+	Code:        "code",
+	Description: "description",
+	CreatedAt:   "created_at",
+}
+
+var PermissionTableColumns = struct {
+	ID          string
+	Code        string
+	Description string
+	CreatedAt   string
+}{
+	ID:          "permissions.id",
+	Code:        "permissions.code",
+	Description: "permissions.description",
+	CreatedAt:   "permissions.created_at",
+}
+
+// Generated where
+
+var PermissionWhere = struct {
+	ID          whereHelperint64
+	Code        whereHelperstring
+	Description whereHelperstring
+	CreatedAt   whereHelpertime_Time
+}{
+	ID:          whereHelperint64{field: "\"permissions\".\"id\""},
+	Code:        whereHelperstring{field: "\"permissions\".\"code\""},
+	Description: whereHelperstring{field: "\"permissions\".\"description\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"permissions\".\"created_at\""},
+}
+
+// PermissionRels is where relationship names are stored.
+var PermissionRels = struct {
+	RolePermissions string
+}{
+	RolePermissions: "RolePermissions",
+}
+
+// permissionR is where relationships are stored.
+type permissionR struct {
+	RolePermissions RolePermissionSlice `boil:"RolePermissions" json:"RolePermissions" toml:"RolePermissions" yaml:"RolePermissions"`
+}
+
+// NewStruct creates a new relationship struct
+func (*permissionR) NewStruct() *permissionR {
+	return &permissionR{}
+}
+
+func (o *Permission) GetRolePermissions() RolePermissionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRolePermissions()
+}
+
+func (r *permissionR) GetRolePermissions() RolePermissionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.RolePermissions
+}
+
+// permissionL is where Load methods for each relationship are stored.
+type permissionL struct{}
+
+var (
+	permissionAllColumns            = []string{"id", "code", "description", "created_at"}
+	permissionColumnsWithoutDefault = []string{"code"}
+	permissionColumnsWithDefault    = []string{"id", "description", "created_at"}
+	permissionPrimaryKeyColumns     = []string{"id"}
+	permissionGeneratedColumns      = []string{}
+)
+
+type (
+	// PermissionSlice is an alias for a slice of pointers to Permission.
+	// This should almost always be used instead of []Permission.
+	PermissionSlice []*Permission
+	// PermissionHook is the signature for custom Permission hook methods
+	PermissionHook func(boil.Executor, *Permission) error
+
+	permissionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	permissionType                 = reflect.TypeOf(&Permission{})
+	permissionMapping              = queries.MakeStructMapping(permissionType)
+	permissionPrimaryKeyMapping, _ = queries.BindMapping(permissionType, permissionMapping, permissionPrimaryKeyColumns)
+	permissionInsertCacheMut       sync.RWMutex
+	permissionInsertCache          = make(map[string]insertCache)
+	permissionUpdateCacheMut       sync.RWMutex
+	permissionUpdateCache          = make(map[string]updateCache)
+	permissionUpsertCacheMut       sync.RWMutex
+	permissionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var permissionAfterSelectMu sync.Mutex
+var permissionAfterSelectHooks []PermissionHook
+
+var permissionBeforeInsertMu sync.Mutex
+var permissionBeforeInsertHooks []PermissionHook
+var permissionAfterInsertMu sync.Mutex
+var permissionAfterInsertHooks []PermissionHook
+
+var permissionBeforeUpdateMu sync.Mutex
+var permissionBeforeUpdateHooks []PermissionHook
+var permissionAfterUpdateMu sync.Mutex
+var permissionAfterUpdateHooks []PermissionHook
+
+var permissionBeforeDeleteMu sync.Mutex
+var permissionBeforeDeleteHooks []PermissionHook
+var permissionAfterDeleteMu sync.Mutex
+var permissionAfterDeleteHooks []PermissionHook
+
+var permissionBeforeUpsertMu sync.Mutex
+var permissionBeforeUpsertHooks []PermissionHook
+var permissionAfterUpsertMu sync.Mutex
+var permissionAfterUpsertHooks []PermissionHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Permission) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range permissionAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Permission) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range permissionBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Permission) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range permissionAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Permission) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range permissionBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Permission) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range permissionAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Permission) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range permissionBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Permission) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range permissionAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Permission) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range permissionBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Permission) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range permissionAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPermissionHook registers your hook function for all future operations.
+func AddPermissionHook(hookPoint boil.HookPoint, permissionHook PermissionHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		permissionAfterSelectMu.Lock()
+		permissionAfterSelectHooks = append(permissionAfterSelectHooks, permissionHook)
+		permissionAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		permissionBeforeInsertMu.Lock()
+		permissionBeforeInsertHooks = append(permissionBeforeInsertHooks, permissionHook)
+		permissionBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		permissionAfterInsertMu.Lock()
+		permissionAfterInsertHooks = append(permissionAfterInsertHooks, permissionHook)
+		permissionAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		permissionBeforeUpdateMu.Lock()
+		permissionBeforeUpdateHooks = append(permissionBeforeUpdateHooks, permissionHook)
+		permissionBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		permissionAfterUpdateMu.Lock()
+		permissionAfterUpdateHooks = append(permissionAfterUpdateHooks, permissionHook)
+		permissionAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		permissionBeforeDeleteMu.Lock()
+		permissionBeforeDeleteHooks = append(permissionBeforeDeleteHooks, permissionHook)
+		permissionBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		permissionAfterDeleteMu.Lock()
+		permissionAfterDeleteHooks = append(permissionAfterDeleteHooks, permissionHook)
+		permissionAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		permissionBeforeUpsertMu.Lock()
+		permissionBeforeUpsertHooks = append(permissionBeforeUpsertHooks, permissionHook)
+		permissionBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		permissionAfterUpsertMu.Lock()
+		permissionAfterUpsertHooks = append(permissionAfterUpsertHooks, permissionHook)
+		permissionAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single permission record from the query using the global executor.
+func (q permissionQuery) OneG() (*Permission, error) {
+	return q.One(boil.GetDB())
+}
+
+// One returns a single permission record from the query.
+func (q permissionQuery) One(exec boil.Executor) (*Permission, error) {
+	o := &Permission{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for permissions")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all Permission records from the query using the global executor.
+func (q permissionQuery) AllG() (PermissionSlice, error) {
+	return q.All(boil.GetDB())
+}
+
+// All returns all Permission records from the query.
+func (q permissionQuery) All(exec boil.Executor) (PermissionSlice, error) {
+	var o []*Permission
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to Permission slice")
+	}
+
+	if len(permissionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all Permission records in the query using the global executor
+func (q permissionQuery) CountG() (int64, error) {
+	return q.Count(boil.GetDB())
+}
+
+// Count returns the count of all Permission records in the query.
+func (q permissionQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count permissions rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q permissionQuery) ExistsG() (bool, error) {
+	return q.Exists(boil.GetDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q permissionQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if permissions exists")
+	}
+
+	return count > 0, nil
+}
+
+// RolePermissions retrieves all the role_permission's RolePermissions with an executor.
+func (o *Permission) RolePermissions(mods ...qm.QueryMod) rolePermissionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"role_permissions\".\"permission_id\"=?", o.ID),
+	)
+
+	return RolePermissions(queryMods...)
+}
+
+// LoadRolePermissions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (permissionL) LoadRolePermissions(e boil.Executor, singular bool, maybePermission interface{}, mods queries.Applicator) error {
+	var slice []*Permission
+	var object *Permission
+
+	if singular {
+		var ok bool
+		object, ok = maybePermission.(*Permission)
+		if !ok {
+			object = new(Permission)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePermission)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePermission))
+			}
+		}
+	} else {
+		s, ok := maybePermission.(*[]*Permission)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePermission)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePermission))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &permissionR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &permissionR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`role_permissions`),
+		qm.WhereIn(`role_permissions.permission_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load role_permissions")
+	}
+
+	var resultSlice []*RolePermission
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice role_permissions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on role_permissions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for role_permissions")
+	}
+
+	if len(rolePermissionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RolePermissions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &rolePermissionR{}
+			}
+			foreign.R.Permission = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PermissionID {
+				local.R.RolePermissions = append(local.R.RolePermissions, foreign)
+				if foreign.R == nil {
+					foreign.R = &rolePermissionR{}
+				}
+				foreign.R.Permission = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddRolePermissionsG adds the given related objects to the existing relationships
+// of the permission, optionally inserting them as new records.
+// Appends related to o.R.RolePermissions.
+// Sets related.R.Permission appropriately.
+// Uses the global database handle.
+func (o *Permission) AddRolePermissionsG(insert bool, related ...*RolePermission) error {
+	return o.AddRolePermissions(boil.GetDB(), insert, related...)
+}
+
+// AddRolePermissions adds the given related objects to the existing relationships
+// of the permission, optionally inserting them as new records.
+// Appends related to o.R.RolePermissions.
+// Sets related.R.Permission appropriately.
+func (o *Permission) AddRolePermissions(exec boil.Executor, insert bool, related ...*RolePermission) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PermissionID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"role_permissions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"permission_id"}),
+				strmangle.WhereClause("\"", "\"", 2, rolePermissionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.RoleID, rel.PermissionID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PermissionID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &permissionR{
+			RolePermissions: related,
+		}
+	} else {
+		o.R.RolePermissions = append(o.R.RolePermissions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &rolePermissionR{
+				Permission: o,
+			}
+		} else {
+			rel.R.Permission = o
+		}
+	}
+	return nil
+}
+
+// Permissions retrieves all the records using an executor.
+func Permissions(mods ...qm.QueryMod) permissionQuery {
+	mods = append(mods, qm.From("\"permissions\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"permissions\".*"})
+	}
+
+	return permissionQuery{q}
+}
+
+// FindPermissionG retrieves a single record by ID.
+func FindPermissionG(iD int64, selectCols ...string) (*Permission, error) {
+	return FindPermission(boil.GetDB(), iD, selectCols...)
+}
+
+// FindPermission retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPermission(exec boil.Executor, iD int64, selectCols ...string) (*Permission, error) {
+	permissionObj := &Permission{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"permissions\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, permissionObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from permissions")
+	}
+
+	if err = permissionObj.doAfterSelectHooks(exec); err != nil {
+		return permissionObj, err
+	}
+
+	return permissionObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *Permission) InsertG(columns boil.Columns) error {
+	return o.Insert(boil.GetDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Permission) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no permissions provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(permissionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	permissionInsertCacheMut.RLock()
+	cache, cached := permissionInsertCache[key]
+	permissionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			permissionAllColumns,
+			permissionColumnsWithDefault,
+			permissionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(permissionType, permissionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(permissionType, permissionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"permissions\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"permissions\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into permissions")
+	}
+
+	if !cached {
+		permissionInsertCacheMut.Lock()
+		permissionInsertCache[key] = cache
+		permissionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// UpdateG a single Permission record using the global executor.
+// See Update for more documentation.
+func (o *Permission) UpdateG(columns boil.Columns) (int64, error) {
+	return o.Update(boil.GetDB(), columns)
+}
+
+// Update uses an executor to update the Permission.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Permission) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	permissionUpdateCacheMut.RLock()
+	cache, cached := permissionUpdateCache[key]
+	permissionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			permissionAllColumns,
+			permissionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update permissions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"permissions\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, permissionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(permissionType, permissionMapping, append(wl, permissionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update permissions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for permissions")
+	}
+
+	if !cached {
+		permissionUpdateCacheMut.Lock()
+		permissionUpdateCache[key] = cache
+		permissionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q permissionQuery) UpdateAllG(cols M) (int64, error) {
+	return q.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q permissionQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for permissions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for permissions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o PermissionSlice) UpdateAllG(cols M) (int64, error) {
+	return o.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PermissionSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), permissionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"permissions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, permissionPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in permission slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all permission")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *Permission) UpsertG(updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(boil.GetDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Permission) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("orm: no permissions provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(permissionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	permissionUpsertCacheMut.RLock()
+	cache, cached := permissionUpsertCache[key]
+	permissionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			permissionAllColumns,
+			permissionColumnsWithDefault,
+			permissionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			permissionAllColumns,
+			permissionPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert permissions, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(permissionAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(permissionPrimaryKeyColumns) == 0 {
+				return errors.New("orm: unable to upsert permissions, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(permissionPrimaryKeyColumns))
+			copy(conflict, permissionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"permissions\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(permissionType, permissionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(permissionType, permissionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert permissions")
+	}
+
+	if !cached {
+		permissionUpsertCacheMut.Lock()
+		permissionUpsertCache[key] = cache
+		permissionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// DeleteG deletes a single Permission record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *Permission) DeleteG() (int64, error) {
+	return o.Delete(boil.GetDB())
+}
+
+// Delete deletes a single Permission record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Permission) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no Permission provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), permissionPrimaryKeyMapping)
+	sql := "DELETE FROM \"permissions\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from permissions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for permissions")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q permissionQuery) DeleteAllG() (int64, error) {
+	return q.DeleteAll(boil.GetDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q permissionQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no permissionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from permissions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for permissions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o PermissionSlice) DeleteAllG() (int64, error) {
+	return o.DeleteAll(boil.GetDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PermissionSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(permissionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), permissionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"permissions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, permissionPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from permission slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for permissions")
+	}
+
+	if len(permissionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *Permission) ReloadG() error {
+	if o == nil {
+		return errors.New("orm: no Permission provided for reload")
+	}
+
+	return o.Reload(boil.GetDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Permission) Reload(exec boil.Executor) error {
+	ret, err := FindPermission(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PermissionSlice) ReloadAllG() error {
+	if o == nil {
+		return errors.New("orm: empty PermissionSlice provided for reload all")
+	}
+
+	return o.ReloadAll(boil.GetDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PermissionSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PermissionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), permissionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"permissions\".* FROM \"permissions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, permissionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in PermissionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PermissionExistsG checks if the Permission row exists.
+func PermissionExistsG(iD int64) (bool, error) {
+	return PermissionExists(boil.GetDB(), iD)
+}
+
+// PermissionExists checks if the Permission row exists.
+func PermissionExists(exec boil.Executor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"permissions\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if permissions exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Permission row exists.
+func (o *Permission) Exists(exec boil.Executor) (bool, error) {
+	return PermissionExists(exec, o.ID)
+}
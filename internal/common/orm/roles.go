@@ -0,0 +1,1428 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// Role is an object representing the database table.
+type Role struct {
+	ID          int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name        string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Description string    `boil:"description" json:"description" toml:"description" yaml:"description"`
+	CreatedAt   time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *roleR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L roleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var RoleColumns = struct {
+	ID          string
+	Name        string
+	Description string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "id",
+	Name:        "name",
+	Description: "description",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+}
+
+var RoleTableColumns = struct {
+	ID          string
+	Name        string
+	Description string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "roles.id",
+	Name:        "roles.name",
+	Description: "roles.description",
+	CreatedAt:   "roles.created_at",
+	UpdatedAt:   "roles.updated_at",
+}
+
+// Generated where
+
+var RoleWhere = struct {
+	ID          whereHelperint64
+	Name        whereHelperstring
+	Description whereHelperstring
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpertime_Time
+}{
+	ID:          whereHelperint64{field: "\"roles\".\"id\""},
+	Name:        whereHelperstring{field: "\"roles\".\"name\""},
+	Description: whereHelperstring{field: "\"roles\".\"description\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"roles\".\"created_at\""},
+	UpdatedAt:   whereHelpertime_Time{field: "\"roles\".\"updated_at\""},
+}
+
+// RoleRels is where relationship names are stored.
+var RoleRels = struct {
+	RolePermissions string
+	UserRoles       string
+}{
+	RolePermissions: "RolePermissions",
+	UserRoles:       "UserRoles",
+}
+
+// roleR is where relationships are stored.
+type roleR struct {
+	RolePermissions RolePermissionSlice `boil:"RolePermissions" json:"RolePermissions" toml:"RolePermissions" yaml:"RolePermissions"`
+	UserRoles       UserRoleSlice       `boil:"UserRoles" json:"UserRoles" toml:"UserRoles" yaml:"UserRoles"`
+}
+
+// NewStruct creates a new relationship struct
+func (*roleR) NewStruct() *roleR {
+	return &roleR{}
+}
+
+func (o *Role) GetRolePermissions() RolePermissionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRolePermissions()
+}
+
+func (r *roleR) GetRolePermissions() RolePermissionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.RolePermissions
+}
+
+func (o *Role) GetUserRoles() UserRoleSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUserRoles()
+}
+
+func (r *roleR) GetUserRoles() UserRoleSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserRoles
+}
+
+// roleL is where Load methods for each relationship are stored.
+type roleL struct{}
+
+var (
+	roleAllColumns            = []string{"id", "name", "description", "created_at", "updated_at"}
+	roleColumnsWithoutDefault = []string{"name"}
+	roleColumnsWithDefault    = []string{"id", "description", "created_at", "updated_at"}
+	rolePrimaryKeyColumns     = []string{"id"}
+	roleGeneratedColumns      = []string{}
+)
+
+type (
+	// RoleSlice is an alias for a slice of pointers to Role.
+	// This should almost always be used instead of []Role.
+	RoleSlice []*Role
+	// RoleHook is the signature for custom Role hook methods
+	RoleHook func(boil.Executor, *Role) error
+
+	roleQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	roleType                 = reflect.TypeOf(&Role{})
+	roleMapping              = queries.MakeStructMapping(roleType)
+	rolePrimaryKeyMapping, _ = queries.BindMapping(roleType, roleMapping, rolePrimaryKeyColumns)
+	roleInsertCacheMut       sync.RWMutex
+	roleInsertCache          = make(map[string]insertCache)
+	roleUpdateCacheMut       sync.RWMutex
+	roleUpdateCache          = make(map[string]updateCache)
+	roleUpsertCacheMut       sync.RWMutex
+	roleUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var roleAfterSelectMu sync.Mutex
+var roleAfterSelectHooks []RoleHook
+
+var roleBeforeInsertMu sync.Mutex
+var roleBeforeInsertHooks []RoleHook
+var roleAfterInsertMu sync.Mutex
+var roleAfterInsertHooks []RoleHook
+
+var roleBeforeUpdateMu sync.Mutex
+var roleBeforeUpdateHooks []RoleHook
+var roleAfterUpdateMu sync.Mutex
+var roleAfterUpdateHooks []RoleHook
+
+var roleBeforeDeleteMu sync.Mutex
+var roleBeforeDeleteHooks []RoleHook
+var roleAfterDeleteMu sync.Mutex
+var roleAfterDeleteHooks []RoleHook
+
+var roleBeforeUpsertMu sync.Mutex
+var roleBeforeUpsertHooks []RoleHook
+var roleAfterUpsertMu sync.Mutex
+var roleAfterUpsertHooks []RoleHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Role) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range roleAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Role) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range roleBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Role) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range roleAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Role) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range roleBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Role) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range roleAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Role) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range roleBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Role) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range roleAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Role) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range roleBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Role) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range roleAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddRoleHook registers your hook function for all future operations.
+func AddRoleHook(hookPoint boil.HookPoint, roleHook RoleHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		roleAfterSelectMu.Lock()
+		roleAfterSelectHooks = append(roleAfterSelectHooks, roleHook)
+		roleAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		roleBeforeInsertMu.Lock()
+		roleBeforeInsertHooks = append(roleBeforeInsertHooks, roleHook)
+		roleBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		roleAfterInsertMu.Lock()
+		roleAfterInsertHooks = append(roleAfterInsertHooks, roleHook)
+		roleAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		roleBeforeUpdateMu.Lock()
+		roleBeforeUpdateHooks = append(roleBeforeUpdateHooks, roleHook)
+		roleBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		roleAfterUpdateMu.Lock()
+		roleAfterUpdateHooks = append(roleAfterUpdateHooks, roleHook)
+		roleAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		roleBeforeDeleteMu.Lock()
+		roleBeforeDeleteHooks = append(roleBeforeDeleteHooks, roleHook)
+		roleBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		roleAfterDeleteMu.Lock()
+		roleAfterDeleteHooks = append(roleAfterDeleteHooks, roleHook)
+		roleAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		roleBeforeUpsertMu.Lock()
+		roleBeforeUpsertHooks = append(roleBeforeUpsertHooks, roleHook)
+		roleBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		roleAfterUpsertMu.Lock()
+		roleAfterUpsertHooks = append(roleAfterUpsertHooks, roleHook)
+		roleAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single role record from the query using the global executor.
+func (q roleQuery) OneG() (*Role, error) {
+	return q.One(boil.GetDB())
+}
+
+// One returns a single role record from the query.
+func (q roleQuery) One(exec boil.Executor) (*Role, error) {
+	o := &Role{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for roles")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all Role records from the query using the global executor.
+func (q roleQuery) AllG() (RoleSlice, error) {
+	return q.All(boil.GetDB())
+}
+
+// All returns all Role records from the query.
+func (q roleQuery) All(exec boil.Executor) (RoleSlice, error) {
+	var o []*Role
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to Role slice")
+	}
+
+	if len(roleAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all Role records in the query using the global executor
+func (q roleQuery) CountG() (int64, error) {
+	return q.Count(boil.GetDB())
+}
+
+// Count returns the count of all Role records in the query.
+func (q roleQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count roles rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q roleQuery) ExistsG() (bool, error) {
+	return q.Exists(boil.GetDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q roleQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if roles exists")
+	}
+
+	return count > 0, nil
+}
+
+// RolePermissions retrieves all the role_permission's RolePermissions with an executor.
+func (o *Role) RolePermissions(mods ...qm.QueryMod) rolePermissionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"role_permissions\".\"role_id\"=?", o.ID),
+	)
+
+	return RolePermissions(queryMods...)
+}
+
+// UserRoles retrieves all the user_role's UserRoles with an executor.
+func (o *Role) UserRoles(mods ...qm.QueryMod) userRoleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_roles\".\"role_id\"=?", o.ID),
+	)
+
+	return UserRoles(queryMods...)
+}
+
+// LoadRolePermissions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (roleL) LoadRolePermissions(e boil.Executor, singular bool, maybeRole interface{}, mods queries.Applicator) error {
+	var slice []*Role
+	var object *Role
+
+	if singular {
+		var ok bool
+		object, ok = maybeRole.(*Role)
+		if !ok {
+			object = new(Role)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRole))
+			}
+		}
+	} else {
+		s, ok := maybeRole.(*[]*Role)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRole))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &roleR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &roleR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`role_permissions`),
+		qm.WhereIn(`role_permissions.role_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load role_permissions")
+	}
+
+	var resultSlice []*RolePermission
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice role_permissions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on role_permissions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for role_permissions")
+	}
+
+	if len(rolePermissionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RolePermissions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &rolePermissionR{}
+			}
+			foreign.R.Role = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.RoleID {
+				local.R.RolePermissions = append(local.R.RolePermissions, foreign)
+				if foreign.R == nil {
+					foreign.R = &rolePermissionR{}
+				}
+				foreign.R.Role = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserRoles allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (roleL) LoadUserRoles(e boil.Executor, singular bool, maybeRole interface{}, mods queries.Applicator) error {
+	var slice []*Role
+	var object *Role
+
+	if singular {
+		var ok bool
+		object, ok = maybeRole.(*Role)
+		if !ok {
+			object = new(Role)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRole))
+			}
+		}
+	} else {
+		s, ok := maybeRole.(*[]*Role)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRole))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &roleR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &roleR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_roles`),
+		qm.WhereIn(`user_roles.role_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_roles")
+	}
+
+	var resultSlice []*UserRole
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_roles")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_roles")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_roles")
+	}
+
+	if len(userRoleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserRoles = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userRoleR{}
+			}
+			foreign.R.Role = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.RoleID {
+				local.R.UserRoles = append(local.R.UserRoles, foreign)
+				if foreign.R == nil {
+					foreign.R = &userRoleR{}
+				}
+				foreign.R.Role = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddRolePermissionsG adds the given related objects to the existing relationships
+// of the role, optionally inserting them as new records.
+// Appends related to o.R.RolePermissions.
+// Sets related.R.Role appropriately.
+// Uses the global database handle.
+func (o *Role) AddRolePermissionsG(insert bool, related ...*RolePermission) error {
+	return o.AddRolePermissions(boil.GetDB(), insert, related...)
+}
+
+// AddRolePermissions adds the given related objects to the existing relationships
+// of the role, optionally inserting them as new records.
+// Appends related to o.R.RolePermissions.
+// Sets related.R.Role appropriately.
+func (o *Role) AddRolePermissions(exec boil.Executor, insert bool, related ...*RolePermission) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RoleID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"role_permissions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"role_id"}),
+				strmangle.WhereClause("\"", "\"", 2, rolePermissionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.RoleID, rel.PermissionID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RoleID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &roleR{
+			RolePermissions: related,
+		}
+	} else {
+		o.R.RolePermissions = append(o.R.RolePermissions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &rolePermissionR{
+				Role: o,
+			}
+		} else {
+			rel.R.Role = o
+		}
+	}
+	return nil
+}
+
+// AddUserRolesG adds the given related objects to the existing relationships
+// of the role, optionally inserting them as new records.
+// Appends related to o.R.UserRoles.
+// Sets related.R.Role appropriately.
+// Uses the global database handle.
+func (o *Role) AddUserRolesG(insert bool, related ...*UserRole) error {
+	return o.AddUserRoles(boil.GetDB(), insert, related...)
+}
+
+// AddUserRoles adds the given related objects to the existing relationships
+// of the role, optionally inserting them as new records.
+// Appends related to o.R.UserRoles.
+// Sets related.R.Role appropriately.
+func (o *Role) AddUserRoles(exec boil.Executor, insert bool, related ...*UserRole) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RoleID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_roles\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"role_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userRolePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.RoleID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RoleID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &roleR{
+			UserRoles: related,
+		}
+	} else {
+		o.R.UserRoles = append(o.R.UserRoles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userRoleR{
+				Role: o,
+			}
+		} else {
+			rel.R.Role = o
+		}
+	}
+	return nil
+}
+
+// Roles retrieves all the records using an executor.
+func Roles(mods ...qm.QueryMod) roleQuery {
+	mods = append(mods, qm.From("\"roles\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"roles\".*"})
+	}
+
+	return roleQuery{q}
+}
+
+// FindRoleG retrieves a single record by ID.
+func FindRoleG(iD int64, selectCols ...string) (*Role, error) {
+	return FindRole(boil.GetDB(), iD, selectCols...)
+}
+
+// FindRole retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindRole(exec boil.Executor, iD int64, selectCols ...string) (*Role, error) {
+	roleObj := &Role{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"roles\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, roleObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from roles")
+	}
+
+	if err = roleObj.doAfterSelectHooks(exec); err != nil {
+		return roleObj, err
+	}
+
+	return roleObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *Role) InsertG(columns boil.Columns) error {
+	return o.Insert(boil.GetDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Role) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no roles provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+	if o.UpdatedAt.IsZero() {
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(roleColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	roleInsertCacheMut.RLock()
+	cache, cached := roleInsertCache[key]
+	roleInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			roleAllColumns,
+			roleColumnsWithDefault,
+			roleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(roleType, roleMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(roleType, roleMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"roles\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"roles\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into roles")
+	}
+
+	if !cached {
+		roleInsertCacheMut.Lock()
+		roleInsertCache[key] = cache
+		roleInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// UpdateG a single Role record using the global executor.
+// See Update for more documentation.
+func (o *Role) UpdateG(columns boil.Columns) (int64, error) {
+	return o.Update(boil.GetDB(), columns)
+}
+
+// Update uses an executor to update the Role.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Role) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	currTime := time.Now().In(boil.GetLocation())
+
+	o.UpdatedAt = currTime
+
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	roleUpdateCacheMut.RLock()
+	cache, cached := roleUpdateCache[key]
+	roleUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			roleAllColumns,
+			rolePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update roles, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"roles\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, rolePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(roleType, roleMapping, append(wl, rolePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update roles row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for roles")
+	}
+
+	if !cached {
+		roleUpdateCacheMut.Lock()
+		roleUpdateCache[key] = cache
+		roleUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q roleQuery) UpdateAllG(cols M) (int64, error) {
+	return q.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q roleQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for roles")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for roles")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o RoleSlice) UpdateAllG(cols M) (int64, error) {
+	return o.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o RoleSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rolePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"roles\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, rolePrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in role slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all role")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *Role) UpsertG(updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(boil.GetDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Role) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("orm: no roles provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+	o.UpdatedAt = currTime
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(roleColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	roleUpsertCacheMut.RLock()
+	cache, cached := roleUpsertCache[key]
+	roleUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			roleAllColumns,
+			roleColumnsWithDefault,
+			roleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			roleAllColumns,
+			rolePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert roles, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(roleAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(rolePrimaryKeyColumns) == 0 {
+				return errors.New("orm: unable to upsert roles, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(rolePrimaryKeyColumns))
+			copy(conflict, rolePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"roles\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(roleType, roleMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(roleType, roleMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert roles")
+	}
+
+	if !cached {
+		roleUpsertCacheMut.Lock()
+		roleUpsertCache[key] = cache
+		roleUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// DeleteG deletes a single Role record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *Role) DeleteG() (int64, error) {
+	return o.Delete(boil.GetDB())
+}
+
+// Delete deletes a single Role record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Role) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no Role provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), rolePrimaryKeyMapping)
+	sql := "DELETE FROM \"roles\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from roles")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for roles")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q roleQuery) DeleteAllG() (int64, error) {
+	return q.DeleteAll(boil.GetDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q roleQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no roleQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from roles")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for roles")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o RoleSlice) DeleteAllG() (int64, error) {
+	return o.DeleteAll(boil.GetDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o RoleSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(roleBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rolePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"roles\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, rolePrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from role slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for roles")
+	}
+
+	if len(roleAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *Role) ReloadG() error {
+	if o == nil {
+		return errors.New("orm: no Role provided for reload")
+	}
+
+	return o.Reload(boil.GetDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Role) Reload(exec boil.Executor) error {
+	ret, err := FindRole(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RoleSlice) ReloadAllG() error {
+	if o == nil {
+		return errors.New("orm: empty RoleSlice provided for reload all")
+	}
+
+	return o.ReloadAll(boil.GetDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RoleSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := RoleSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rolePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"roles\".* FROM \"roles\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, rolePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in RoleSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// RoleExistsG checks if the Role row exists.
+func RoleExistsG(iD int64) (bool, error) {
+	return RoleExists(boil.GetDB(), iD)
+}
+
+// RoleExists checks if the Role row exists.
+func RoleExists(exec boil.Executor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"roles\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if roles exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Role row exists.
+func (o *Role) Exists(exec boil.Executor) (bool, error) {
+	return RoleExists(exec, o.ID)
+}
@@ -0,0 +1,1220 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// UserMfa is an object representing the database table.
+type UserMfa struct {
+	UserID     int64     `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	TotpSecret string    `boil:"totp_secret" json:"totp_secret" toml:"totp_secret" yaml:"totp_secret"`
+	EnabledAt  null.Time `boil:"enabled_at" json:"enabled_at,omitempty" toml:"enabled_at" yaml:"enabled_at,omitempty"`
+	CreatedAt  time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt  time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *userMfaR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L userMfaL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var UserMfaColumns = struct {
+	UserID     string
+	TotpSecret string
+	EnabledAt  string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	UserID:     "user_id",
+	TotpSecret: "totp_secret",
+	EnabledAt:  "enabled_at",
+	CreatedAt:  "created_at",
+	UpdatedAt:  "updated_at",
+}
+
+var UserMfaTableColumns = struct {
+	UserID     string
+	TotpSecret string
+	EnabledAt  string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	UserID:     "user_mfa.user_id",
+	TotpSecret: "user_mfa.totp_secret",
+	EnabledAt:  "user_mfa.enabled_at",
+	CreatedAt:  "user_mfa.created_at",
+	UpdatedAt:  "user_mfa.updated_at",
+}
+
+// Generated where
+
+var UserMfaWhere = struct {
+	UserID     whereHelperint64
+	TotpSecret whereHelperstring
+	EnabledAt  whereHelpernull_Time
+	CreatedAt  whereHelpertime_Time
+	UpdatedAt  whereHelpertime_Time
+}{
+	UserID:     whereHelperint64{field: "\"user_mfa\".\"user_id\""},
+	TotpSecret: whereHelperstring{field: "\"user_mfa\".\"totp_secret\""},
+	EnabledAt:  whereHelpernull_Time{field: "\"user_mfa\".\"enabled_at\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"user_mfa\".\"created_at\""},
+	UpdatedAt:  whereHelpertime_Time{field: "\"user_mfa\".\"updated_at\""},
+}
+
+// UserMfaRels is where relationship names are stored.
+var UserMfaRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// userMfaR is where relationships are stored.
+type userMfaR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*userMfaR) NewStruct() *userMfaR {
+	return &userMfaR{}
+}
+
+func (o *UserMfa) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *userMfaR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// userMfaL is where Load methods for each relationship are stored.
+type userMfaL struct{}
+
+var (
+	userMfaAllColumns            = []string{"user_id", "totp_secret", "enabled_at", "created_at", "updated_at"}
+	userMfaColumnsWithoutDefault = []string{"user_id", "totp_secret"}
+	userMfaColumnsWithDefault    = []string{"enabled_at", "created_at", "updated_at"}
+	userMfaPrimaryKeyColumns     = []string{"user_id"}
+	userMfaGeneratedColumns      = []string{}
+)
+
+type (
+	// UserMfaSlice is an alias for a slice of pointers to UserMfa.
+	// This should almost always be used instead of []UserMfa.
+	UserMfaSlice []*UserMfa
+	// UserMfaHook is the signature for custom UserMfa hook methods
+	UserMfaHook func(boil.Executor, *UserMfa) error
+
+	userMfaQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	userMfaType                 = reflect.TypeOf(&UserMfa{})
+	userMfaMapping              = queries.MakeStructMapping(userMfaType)
+	userMfaPrimaryKeyMapping, _ = queries.BindMapping(userMfaType, userMfaMapping, userMfaPrimaryKeyColumns)
+	userMfaInsertCacheMut       sync.RWMutex
+	userMfaInsertCache          = make(map[string]insertCache)
+	userMfaUpdateCacheMut       sync.RWMutex
+	userMfaUpdateCache          = make(map[string]updateCache)
+	userMfaUpsertCacheMut       sync.RWMutex
+	userMfaUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var userMfaAfterSelectMu sync.Mutex
+var userMfaAfterSelectHooks []UserMfaHook
+
+var userMfaBeforeInsertMu sync.Mutex
+var userMfaBeforeInsertHooks []UserMfaHook
+var userMfaAfterInsertMu sync.Mutex
+var userMfaAfterInsertHooks []UserMfaHook
+
+var userMfaBeforeUpdateMu sync.Mutex
+var userMfaBeforeUpdateHooks []UserMfaHook
+var userMfaAfterUpdateMu sync.Mutex
+var userMfaAfterUpdateHooks []UserMfaHook
+
+var userMfaBeforeDeleteMu sync.Mutex
+var userMfaBeforeDeleteHooks []UserMfaHook
+var userMfaAfterDeleteMu sync.Mutex
+var userMfaAfterDeleteHooks []UserMfaHook
+
+var userMfaBeforeUpsertMu sync.Mutex
+var userMfaBeforeUpsertHooks []UserMfaHook
+var userMfaAfterUpsertMu sync.Mutex
+var userMfaAfterUpsertHooks []UserMfaHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *UserMfa) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range userMfaAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *UserMfa) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range userMfaBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *UserMfa) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range userMfaAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *UserMfa) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range userMfaBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *UserMfa) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range userMfaAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *UserMfa) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range userMfaBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *UserMfa) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range userMfaAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *UserMfa) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range userMfaBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *UserMfa) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range userMfaAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddUserMfaHook registers your hook function for all future operations.
+func AddUserMfaHook(hookPoint boil.HookPoint, userMfaHook UserMfaHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		userMfaAfterSelectMu.Lock()
+		userMfaAfterSelectHooks = append(userMfaAfterSelectHooks, userMfaHook)
+		userMfaAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		userMfaBeforeInsertMu.Lock()
+		userMfaBeforeInsertHooks = append(userMfaBeforeInsertHooks, userMfaHook)
+		userMfaBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		userMfaAfterInsertMu.Lock()
+		userMfaAfterInsertHooks = append(userMfaAfterInsertHooks, userMfaHook)
+		userMfaAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		userMfaBeforeUpdateMu.Lock()
+		userMfaBeforeUpdateHooks = append(userMfaBeforeUpdateHooks, userMfaHook)
+		userMfaBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		userMfaAfterUpdateMu.Lock()
+		userMfaAfterUpdateHooks = append(userMfaAfterUpdateHooks, userMfaHook)
+		userMfaAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		userMfaBeforeDeleteMu.Lock()
+		userMfaBeforeDeleteHooks = append(userMfaBeforeDeleteHooks, userMfaHook)
+		userMfaBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		userMfaAfterDeleteMu.Lock()
+		userMfaAfterDeleteHooks = append(userMfaAfterDeleteHooks, userMfaHook)
+		userMfaAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		userMfaBeforeUpsertMu.Lock()
+		userMfaBeforeUpsertHooks = append(userMfaBeforeUpsertHooks, userMfaHook)
+		userMfaBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		userMfaAfterUpsertMu.Lock()
+		userMfaAfterUpsertHooks = append(userMfaAfterUpsertHooks, userMfaHook)
+		userMfaAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single userMfa record from the query using the global executor.
+func (q userMfaQuery) OneG() (*UserMfa, error) {
+	return q.One(boil.GetDB())
+}
+
+// One returns a single userMfa record from the query.
+func (q userMfaQuery) One(exec boil.Executor) (*UserMfa, error) {
+	o := &UserMfa{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for user_mfa")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all UserMfa records from the query using the global executor.
+func (q userMfaQuery) AllG() (UserMfaSlice, error) {
+	return q.All(boil.GetDB())
+}
+
+// All returns all UserMfa records from the query.
+func (q userMfaQuery) All(exec boil.Executor) (UserMfaSlice, error) {
+	var o []*UserMfa
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to UserMfa slice")
+	}
+
+	if len(userMfaAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all UserMfa records in the query using the global executor
+func (q userMfaQuery) CountG() (int64, error) {
+	return q.Count(boil.GetDB())
+}
+
+// Count returns the count of all UserMfa records in the query.
+func (q userMfaQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count user_mfa rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q userMfaQuery) ExistsG() (bool, error) {
+	return q.Exists(boil.GetDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q userMfaQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if user_mfa exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *UserMfa) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userMfaL) LoadUser(e boil.Executor, singular bool, maybeUserMfa interface{}, mods queries.Applicator) error {
+	var slice []*UserMfa
+	var object *UserMfa
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserMfa.(*UserMfa)
+		if !ok {
+			object = new(UserMfa)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserMfa)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserMfa))
+			}
+		}
+	} else {
+		s, ok := maybeUserMfa.(*[]*UserMfa)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserMfa)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserMfa))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userMfaR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userMfaR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.UserMfa = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.UserMfa = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUserG of the userMfa to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.UserMfa.
+// Uses the global database handle.
+func (o *UserMfa) SetUserG(insert bool, related *User) error {
+	return o.SetUser(boil.GetDB(), insert, related)
+}
+
+// SetUser of the userMfa to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.UserMfa.
+func (o *UserMfa) SetUser(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"user_mfa\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userMfaPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &userMfaR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			UserMfa: o,
+		}
+	} else {
+		related.R.UserMfa = o
+	}
+
+	return nil
+}
+
+// UserMfas retrieves all the records using an executor.
+func UserMfas(mods ...qm.QueryMod) userMfaQuery {
+	mods = append(mods, qm.From("\"user_mfa\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"user_mfa\".*"})
+	}
+
+	return userMfaQuery{q}
+}
+
+// FindUserMfaG retrieves a single record by ID.
+func FindUserMfaG(userID int64, selectCols ...string) (*UserMfa, error) {
+	return FindUserMfa(boil.GetDB(), userID, selectCols...)
+}
+
+// FindUserMfa retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindUserMfa(exec boil.Executor, userID int64, selectCols ...string) (*UserMfa, error) {
+	userMfaObj := &UserMfa{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"user_mfa\" where \"user_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, userID)
+
+	err := q.Bind(nil, exec, userMfaObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from user_mfa")
+	}
+
+	if err = userMfaObj.doAfterSelectHooks(exec); err != nil {
+		return userMfaObj, err
+	}
+
+	return userMfaObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *UserMfa) InsertG(columns boil.Columns) error {
+	return o.Insert(boil.GetDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *UserMfa) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no user_mfa provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+	if o.UpdatedAt.IsZero() {
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userMfaColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	userMfaInsertCacheMut.RLock()
+	cache, cached := userMfaInsertCache[key]
+	userMfaInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			userMfaAllColumns,
+			userMfaColumnsWithDefault,
+			userMfaColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(userMfaType, userMfaMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(userMfaType, userMfaMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"user_mfa\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"user_mfa\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into user_mfa")
+	}
+
+	if !cached {
+		userMfaInsertCacheMut.Lock()
+		userMfaInsertCache[key] = cache
+		userMfaInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// UpdateG a single UserMfa record using the global executor.
+// See Update for more documentation.
+func (o *UserMfa) UpdateG(columns boil.Columns) (int64, error) {
+	return o.Update(boil.GetDB(), columns)
+}
+
+// Update uses an executor to update the UserMfa.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *UserMfa) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	currTime := time.Now().In(boil.GetLocation())
+
+	o.UpdatedAt = currTime
+
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	userMfaUpdateCacheMut.RLock()
+	cache, cached := userMfaUpdateCache[key]
+	userMfaUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			userMfaAllColumns,
+			userMfaPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update user_mfa, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"user_mfa\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, userMfaPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(userMfaType, userMfaMapping, append(wl, userMfaPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update user_mfa row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for user_mfa")
+	}
+
+	if !cached {
+		userMfaUpdateCacheMut.Lock()
+		userMfaUpdateCache[key] = cache
+		userMfaUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q userMfaQuery) UpdateAllG(cols M) (int64, error) {
+	return q.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q userMfaQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for user_mfa")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for user_mfa")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o UserMfaSlice) UpdateAllG(cols M) (int64, error) {
+	return o.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o UserMfaSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userMfaPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"user_mfa\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, userMfaPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in userMfa slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all userMfa")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *UserMfa) UpsertG(updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(boil.GetDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *UserMfa) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("orm: no user_mfa provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+	o.UpdatedAt = currTime
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userMfaColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	userMfaUpsertCacheMut.RLock()
+	cache, cached := userMfaUpsertCache[key]
+	userMfaUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			userMfaAllColumns,
+			userMfaColumnsWithDefault,
+			userMfaColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			userMfaAllColumns,
+			userMfaPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert user_mfa, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(userMfaAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(userMfaPrimaryKeyColumns) == 0 {
+				return errors.New("orm: unable to upsert user_mfa, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(userMfaPrimaryKeyColumns))
+			copy(conflict, userMfaPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"user_mfa\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(userMfaType, userMfaMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(userMfaType, userMfaMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert user_mfa")
+	}
+
+	if !cached {
+		userMfaUpsertCacheMut.Lock()
+		userMfaUpsertCache[key] = cache
+		userMfaUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// DeleteG deletes a single UserMfa record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *UserMfa) DeleteG() (int64, error) {
+	return o.Delete(boil.GetDB())
+}
+
+// Delete deletes a single UserMfa record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *UserMfa) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no UserMfa provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), userMfaPrimaryKeyMapping)
+	sql := "DELETE FROM \"user_mfa\" WHERE \"user_id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from user_mfa")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for user_mfa")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q userMfaQuery) DeleteAllG() (int64, error) {
+	return q.DeleteAll(boil.GetDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q userMfaQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no userMfaQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from user_mfa")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for user_mfa")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o UserMfaSlice) DeleteAllG() (int64, error) {
+	return o.DeleteAll(boil.GetDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o UserMfaSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(userMfaBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userMfaPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"user_mfa\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, userMfaPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from userMfa slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for user_mfa")
+	}
+
+	if len(userMfaAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *UserMfa) ReloadG() error {
+	if o == nil {
+		return errors.New("orm: no UserMfa provided for reload")
+	}
+
+	return o.Reload(boil.GetDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *UserMfa) Reload(exec boil.Executor) error {
+	ret, err := FindUserMfa(exec, o.UserID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *UserMfaSlice) ReloadAllG() error {
+	if o == nil {
+		return errors.New("orm: empty UserMfaSlice provided for reload all")
+	}
+
+	return o.ReloadAll(boil.GetDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *UserMfaSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := UserMfaSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userMfaPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"user_mfa\".* FROM \"user_mfa\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, userMfaPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in UserMfaSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// UserMfaExistsG checks if the UserMfa row exists.
+func UserMfaExistsG(userID int64) (bool, error) {
+	return UserMfaExists(boil.GetDB(), userID)
+}
+
+// UserMfaExists checks if the UserMfa row exists.
+func UserMfaExists(exec boil.Executor, userID int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"user_mfa\" where \"user_id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, userID)
+	}
+	row := exec.QueryRow(sql, userID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if user_mfa exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the UserMfa row exists.
+func (o *UserMfa) Exists(exec boil.Executor) (bool, error) {
+	return UserMfaExists(exec, o.UserID)
+}
@@ -23,232 +23,334 @@ import (
 
 // User is an object representing the database table.
 type User struct {
-	ID           int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Nickname     string      `boil:"nickname" json:"nickname" toml:"nickname" yaml:"nickname"`
-	Email        string      `boil:"email" json:"email" toml:"email" yaml:"email"`
-	GithubID     null.String `boil:"github_id" json:"github_id,omitempty" toml:"github_id" yaml:"github_id,omitempty"`
-	PasswordHash null.String `boil:"password_hash" json:"password_hash,omitempty" toml:"password_hash" yaml:"password_hash,omitempty"`
-	CreatedAt    time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt    time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	LastLoginAt  time.Time   `boil:"last_login_at" json:"last_login_at" toml:"last_login_at" yaml:"last_login_at"`
+	ID                  int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Nickname            string      `boil:"nickname" json:"nickname" toml:"nickname" yaml:"nickname"`
+	Avatar              null.String `boil:"avatar" json:"avatar,omitempty" toml:"avatar" yaml:"avatar,omitempty"`
+	Email               null.String `boil:"email" json:"email,omitempty" toml:"email" yaml:"email,omitempty"`
+	PasswordHash        null.String `boil:"password_hash" json:"password_hash,omitempty" toml:"password_hash" yaml:"password_hash,omitempty"`
+	EmailVerifiedAt     null.Time   `boil:"email_verified_at" json:"email_verified_at,omitempty" toml:"email_verified_at" yaml:"email_verified_at,omitempty"`
+	Phone               null.String `boil:"phone" json:"phone,omitempty" toml:"phone" yaml:"phone,omitempty"`
+	PhoneVerifiedAt     null.Time   `boil:"phone_verified_at" json:"phone_verified_at,omitempty" toml:"phone_verified_at" yaml:"phone_verified_at,omitempty"`
+	CreatedAt           time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt           time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	LastLoginAt         time.Time   `boil:"last_login_at" json:"last_login_at" toml:"last_login_at" yaml:"last_login_at"`
+	DisabledAt          null.Time   `boil:"disabled_at" json:"disabled_at,omitempty" toml:"disabled_at" yaml:"disabled_at,omitempty"`
+	DeletionScheduledAt null.Time   `boil:"deletion_scheduled_at" json:"deletion_scheduled_at,omitempty" toml:"deletion_scheduled_at" yaml:"deletion_scheduled_at,omitempty"`
+	DeletedAt           null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
 
 	R *userR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var UserColumns = struct {
-	ID           string
-	Nickname     string
-	Email        string
-	GithubID     string
-	PasswordHash string
-	CreatedAt    string
-	UpdatedAt    string
-	LastLoginAt  string
+	ID                  string
+	Nickname            string
+	Avatar              string
+	Email               string
+	PasswordHash        string
+	EmailVerifiedAt     string
+	Phone               string
+	PhoneVerifiedAt     string
+	CreatedAt           string
+	UpdatedAt           string
+	LastLoginAt         string
+	DisabledAt          string
+	DeletionScheduledAt string
+	DeletedAt           string
 }{
-	ID:           "id",
-	Nickname:     "nickname",
-	Email:        "email",
-	GithubID:     "github_id",
-	PasswordHash: "password_hash",
-	CreatedAt:    "created_at",
-	UpdatedAt:    "updated_at",
-	LastLoginAt:  "last_login_at",
+	ID:                  "id",
+	Nickname:            "nickname",
+	Avatar:              "avatar",
+	Email:               "email",
+	PasswordHash:        "password_hash",
+	EmailVerifiedAt:     "email_verified_at",
+	Phone:               "phone",
+	PhoneVerifiedAt:     "phone_verified_at",
+	CreatedAt:           "created_at",
+	UpdatedAt:           "updated_at",
+	LastLoginAt:         "last_login_at",
+	DisabledAt:          "disabled_at",
+	DeletionScheduledAt: "deletion_scheduled_at",
+	DeletedAt:           "deleted_at",
 }
 
 var UserTableColumns = struct {
-	ID           string
-	Nickname     string
-	Email        string
-	GithubID     string
-	PasswordHash string
-	CreatedAt    string
-	UpdatedAt    string
-	LastLoginAt  string
+	ID                  string
+	Nickname            string
+	Avatar              string
+	Email               string
+	PasswordHash        string
+	EmailVerifiedAt     string
+	Phone               string
+	PhoneVerifiedAt     string
+	CreatedAt           string
+	UpdatedAt           string
+	LastLoginAt         string
+	DisabledAt          string
+	DeletionScheduledAt string
+	DeletedAt           string
 }{
-	ID:           "users.id",
-	Nickname:     "users.nickname",
-	Email:        "users.email",
-	GithubID:     "users.github_id",
-	PasswordHash: "users.password_hash",
-	CreatedAt:    "users.created_at",
-	UpdatedAt:    "users.updated_at",
-	LastLoginAt:  "users.last_login_at",
+	ID:                  "users.id",
+	Nickname:            "users.nickname",
+	Avatar:              "users.avatar",
+	Email:               "users.email",
+	PasswordHash:        "users.password_hash",
+	EmailVerifiedAt:     "users.email_verified_at",
+	Phone:               "users.phone",
+	PhoneVerifiedAt:     "users.phone_verified_at",
+	CreatedAt:           "users.created_at",
+	UpdatedAt:           "users.updated_at",
+	LastLoginAt:         "users.last_login_at",
+	DisabledAt:          "users.disabled_at",
+	DeletionScheduledAt: "users.deletion_scheduled_at",
+	DeletedAt:           "users.deleted_at",
 }
 
 // Generated where
 
-type whereHelperint64 struct{ field string }
-
-func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+var UserWhere = struct {
+	ID                  whereHelperint64
+	Nickname            whereHelperstring
+	Avatar              whereHelpernull_String
+	Email               whereHelpernull_String
+	PasswordHash        whereHelpernull_String
+	EmailVerifiedAt     whereHelpernull_Time
+	Phone               whereHelpernull_String
+	PhoneVerifiedAt     whereHelpernull_Time
+	CreatedAt           whereHelpertime_Time
+	UpdatedAt           whereHelpertime_Time
+	LastLoginAt         whereHelpertime_Time
+	DisabledAt          whereHelpernull_Time
+	DeletionScheduledAt whereHelpernull_Time
+	DeletedAt           whereHelpernull_Time
+}{
+	ID:                  whereHelperint64{field: "\"users\".\"id\""},
+	Nickname:            whereHelperstring{field: "\"users\".\"nickname\""},
+	Avatar:              whereHelpernull_String{field: "\"users\".\"avatar\""},
+	Email:               whereHelpernull_String{field: "\"users\".\"email\""},
+	PasswordHash:        whereHelpernull_String{field: "\"users\".\"password_hash\""},
+	EmailVerifiedAt:     whereHelpernull_Time{field: "\"users\".\"email_verified_at\""},
+	Phone:               whereHelpernull_String{field: "\"users\".\"phone\""},
+	PhoneVerifiedAt:     whereHelpernull_Time{field: "\"users\".\"phone_verified_at\""},
+	CreatedAt:           whereHelpertime_Time{field: "\"users\".\"created_at\""},
+	UpdatedAt:           whereHelpertime_Time{field: "\"users\".\"updated_at\""},
+	LastLoginAt:         whereHelpertime_Time{field: "\"users\".\"last_login_at\""},
+	DisabledAt:          whereHelpernull_Time{field: "\"users\".\"disabled_at\""},
+	DeletionScheduledAt: whereHelpernull_Time{field: "\"users\".\"deletion_scheduled_at\""},
+	DeletedAt:           whereHelpernull_Time{field: "\"users\".\"deleted_at\""},
 }
-func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+
+// UserRels is where relationship names are stored.
+var UserRels = struct {
+	UserMfa                        string
+	APIKeys                        string
+	ActorAuditLogs                 string
+	TargetUserAuditLogs            string
+	InviterOrganizationInvitations string
+	OrganizationMembers            string
+	UserIdentities                 string
+	UserRecoveryCodes              string
+	UserRoles                      string
+	WebauthnCredentials            string
+}{
+	UserMfa:                        "UserMfa",
+	APIKeys:                        "APIKeys",
+	ActorAuditLogs:                 "ActorAuditLogs",
+	TargetUserAuditLogs:            "TargetUserAuditLogs",
+	InviterOrganizationInvitations: "InviterOrganizationInvitations",
+	OrganizationMembers:            "OrganizationMembers",
+	UserIdentities:                 "UserIdentities",
+	UserRecoveryCodes:              "UserRecoveryCodes",
+	UserRoles:                      "UserRoles",
+	WebauthnCredentials:            "WebauthnCredentials",
 }
 
-type whereHelperstring struct{ field string }
+// userR is where relationships are stored.
+type userR struct {
+	UserMfa                        *UserMfa                    `boil:"UserMfa" json:"UserMfa" toml:"UserMfa" yaml:"UserMfa"`
+	APIKeys                        APIKeySlice                 `boil:"APIKeys" json:"APIKeys" toml:"APIKeys" yaml:"APIKeys"`
+	ActorAuditLogs                 AuditLogSlice               `boil:"ActorAuditLogs" json:"ActorAuditLogs" toml:"ActorAuditLogs" yaml:"ActorAuditLogs"`
+	TargetUserAuditLogs            AuditLogSlice               `boil:"TargetUserAuditLogs" json:"TargetUserAuditLogs" toml:"TargetUserAuditLogs" yaml:"TargetUserAuditLogs"`
+	InviterOrganizationInvitations OrganizationInvitationSlice `boil:"InviterOrganizationInvitations" json:"InviterOrganizationInvitations" toml:"InviterOrganizationInvitations" yaml:"InviterOrganizationInvitations"`
+	OrganizationMembers            OrganizationMemberSlice     `boil:"OrganizationMembers" json:"OrganizationMembers" toml:"OrganizationMembers" yaml:"OrganizationMembers"`
+	UserIdentities                 UserIdentitySlice           `boil:"UserIdentities" json:"UserIdentities" toml:"UserIdentities" yaml:"UserIdentities"`
+	UserRecoveryCodes              UserRecoveryCodeSlice       `boil:"UserRecoveryCodes" json:"UserRecoveryCodes" toml:"UserRecoveryCodes" yaml:"UserRecoveryCodes"`
+	UserRoles                      UserRoleSlice               `boil:"UserRoles" json:"UserRoles" toml:"UserRoles" yaml:"UserRoles"`
+	WebauthnCredentials            WebauthnCredentialSlice     `boil:"WebauthnCredentials" json:"WebauthnCredentials" toml:"WebauthnCredentials" yaml:"WebauthnCredentials"`
+}
 
-func (w whereHelperstring) EQ(x string) qm.QueryMod      { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod      { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod      { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) LIKE(x string) qm.QueryMod    { return qm.Where(w.field+" LIKE ?", x) }
-func (w whereHelperstring) NLIKE(x string) qm.QueryMod   { return qm.Where(w.field+" NOT LIKE ?", x) }
-func (w whereHelperstring) ILIKE(x string) qm.QueryMod   { return qm.Where(w.field+" ILIKE ?", x) }
-func (w whereHelperstring) NILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT ILIKE ?", x) }
-func (w whereHelperstring) SIMILAR(x string) qm.QueryMod { return qm.Where(w.field+" SIMILAR TO ?", x) }
-func (w whereHelperstring) NSIMILAR(x string) qm.QueryMod {
-	return qm.Where(w.field+" NOT SIMILAR TO ?", x)
+// NewStruct creates a new relationship struct
+func (*userR) NewStruct() *userR {
+	return &userR{}
 }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
+
+func (o *User) GetUserMfa() *UserMfa {
+	if o == nil {
+		return nil
 	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+
+	return o.R.GetUserMfa()
 }
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
+
+func (r *userR) GetUserMfa() *UserMfa {
+	if r == nil {
+		return nil
 	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+
+	return r.UserMfa
 }
 
-type whereHelpernull_String struct{ field string }
+func (o *User) GetAPIKeys() APIKeySlice {
+	if o == nil {
+		return nil
+	}
 
-func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
+	return o.R.GetAPIKeys()
 }
-func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" LIKE ?", x)
-}
-func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" NOT LIKE ?", x)
+
+func (r *userR) GetAPIKeys() APIKeySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.APIKeys
 }
-func (w whereHelpernull_String) ILIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" ILIKE ?", x)
+
+func (o *User) GetActorAuditLogs() AuditLogSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetActorAuditLogs()
 }
-func (w whereHelpernull_String) NILIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" NOT ILIKE ?", x)
+
+func (r *userR) GetActorAuditLogs() AuditLogSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ActorAuditLogs
 }
-func (w whereHelpernull_String) SIMILAR(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" SIMILAR TO ?", x)
+
+func (o *User) GetTargetUserAuditLogs() AuditLogSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTargetUserAuditLogs()
 }
-func (w whereHelpernull_String) NSIMILAR(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" NOT SIMILAR TO ?", x)
+
+func (r *userR) GetTargetUserAuditLogs() AuditLogSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.TargetUserAuditLogs
 }
-func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
+
+func (o *User) GetInviterOrganizationInvitations() OrganizationInvitationSlice {
+	if o == nil {
+		return nil
 	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+
+	return o.R.GetInviterOrganizationInvitations()
 }
-func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
+
+func (r *userR) GetInviterOrganizationInvitations() OrganizationInvitationSlice {
+	if r == nil {
+		return nil
 	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+
+	return r.InviterOrganizationInvitations
 }
 
-func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (o *User) GetOrganizationMembers() OrganizationMemberSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOrganizationMembers()
+}
 
-type whereHelpertime_Time struct{ field string }
+func (r *userR) GetOrganizationMembers() OrganizationMemberSlice {
+	if r == nil {
+		return nil
+	}
 
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
+	return r.OrganizationMembers
 }
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+
+func (o *User) GetUserIdentities() UserIdentitySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUserIdentities()
 }
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
+
+func (r *userR) GetUserIdentities() UserIdentitySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserIdentities
 }
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
+
+func (o *User) GetUserRecoveryCodes() UserRecoveryCodeSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUserRecoveryCodes()
 }
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
+
+func (r *userR) GetUserRecoveryCodes() UserRecoveryCodeSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserRecoveryCodes
 }
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
+
+func (o *User) GetUserRoles() UserRoleSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUserRoles()
 }
 
-var UserWhere = struct {
-	ID           whereHelperint64
-	Nickname     whereHelperstring
-	Email        whereHelperstring
-	GithubID     whereHelpernull_String
-	PasswordHash whereHelpernull_String
-	CreatedAt    whereHelpertime_Time
-	UpdatedAt    whereHelpertime_Time
-	LastLoginAt  whereHelpertime_Time
-}{
-	ID:           whereHelperint64{field: "\"users\".\"id\""},
-	Nickname:     whereHelperstring{field: "\"users\".\"nickname\""},
-	Email:        whereHelperstring{field: "\"users\".\"email\""},
-	GithubID:     whereHelpernull_String{field: "\"users\".\"github_id\""},
-	PasswordHash: whereHelpernull_String{field: "\"users\".\"password_hash\""},
-	CreatedAt:    whereHelpertime_Time{field: "\"users\".\"created_at\""},
-	UpdatedAt:    whereHelpertime_Time{field: "\"users\".\"updated_at\""},
-	LastLoginAt:  whereHelpertime_Time{field: "\"users\".\"last_login_at\""},
+func (r *userR) GetUserRoles() UserRoleSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserRoles
 }
 
-// UserRels is where relationship names are stored.
-var UserRels = struct {
-}{}
+func (o *User) GetWebauthnCredentials() WebauthnCredentialSlice {
+	if o == nil {
+		return nil
+	}
 
-// userR is where relationships are stored.
-type userR struct {
+	return o.R.GetWebauthnCredentials()
 }
 
-// NewStruct creates a new relationship struct
-func (*userR) NewStruct() *userR {
-	return &userR{}
+func (r *userR) GetWebauthnCredentials() WebauthnCredentialSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.WebauthnCredentials
 }
 
 // userL is where Load methods for each relationship are stored.
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"id", "nickname", "email", "github_id", "password_hash", "created_at", "updated_at", "last_login_at"}
-	userColumnsWithoutDefault = []string{"nickname", "email", "last_login_at"}
-	userColumnsWithDefault    = []string{"id", "github_id", "password_hash", "created_at", "updated_at"}
+	userAllColumns            = []string{"id", "nickname", "avatar", "email", "password_hash", "email_verified_at", "phone", "phone_verified_at", "created_at", "updated_at", "last_login_at", "disabled_at", "deletion_scheduled_at", "deleted_at"}
+	userColumnsWithoutDefault = []string{"nickname", "last_login_at"}
+	userColumnsWithDefault    = []string{"id", "avatar", "email", "password_hash", "email_verified_at", "phone", "phone_verified_at", "created_at", "updated_at", "disabled_at", "deletion_scheduled_at", "deleted_at"}
 	userPrimaryKeyColumns     = []string{"id"}
 	userGeneratedColumns      = []string{}
 )
@@ -338,213 +440,2182 @@ func (o *User) doAfterInsertHooks(exec boil.Executor) (err error) {
 			return err
 		}
 	}
-
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *User) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range userBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *User) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range userAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *User) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range userBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *User) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range userAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *User) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range userBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *User) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range userAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddUserHook registers your hook function for all future operations.
+func AddUserHook(hookPoint boil.HookPoint, userHook UserHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		userAfterSelectMu.Lock()
+		userAfterSelectHooks = append(userAfterSelectHooks, userHook)
+		userAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		userBeforeInsertMu.Lock()
+		userBeforeInsertHooks = append(userBeforeInsertHooks, userHook)
+		userBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		userAfterInsertMu.Lock()
+		userAfterInsertHooks = append(userAfterInsertHooks, userHook)
+		userAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		userBeforeUpdateMu.Lock()
+		userBeforeUpdateHooks = append(userBeforeUpdateHooks, userHook)
+		userBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		userAfterUpdateMu.Lock()
+		userAfterUpdateHooks = append(userAfterUpdateHooks, userHook)
+		userAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		userBeforeDeleteMu.Lock()
+		userBeforeDeleteHooks = append(userBeforeDeleteHooks, userHook)
+		userBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		userAfterDeleteMu.Lock()
+		userAfterDeleteHooks = append(userAfterDeleteHooks, userHook)
+		userAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		userBeforeUpsertMu.Lock()
+		userBeforeUpsertHooks = append(userBeforeUpsertHooks, userHook)
+		userBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		userAfterUpsertMu.Lock()
+		userAfterUpsertHooks = append(userAfterUpsertHooks, userHook)
+		userAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single user record from the query using the global executor.
+func (q userQuery) OneG() (*User, error) {
+	return q.One(boil.GetDB())
+}
+
+// One returns a single user record from the query.
+func (q userQuery) One(exec boil.Executor) (*User, error) {
+	o := &User{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for users")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all User records from the query using the global executor.
+func (q userQuery) AllG() (UserSlice, error) {
+	return q.All(boil.GetDB())
+}
+
+// All returns all User records from the query.
+func (q userQuery) All(exec boil.Executor) (UserSlice, error) {
+	var o []*User
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to User slice")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all User records in the query using the global executor
+func (q userQuery) CountG() (int64, error) {
+	return q.Count(boil.GetDB())
+}
+
+// Count returns the count of all User records in the query.
+func (q userQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count users rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q userQuery) ExistsG() (bool, error) {
+	return q.Exists(boil.GetDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q userQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if users exists")
+	}
+
+	return count > 0, nil
+}
+
+// UserMfa pointed to by the foreign key.
+func (o *User) UserMfa(mods ...qm.QueryMod) userMfaQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"user_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return UserMfas(queryMods...)
+}
+
+// APIKeys retrieves all the api_key's APIKeys with an executor.
+func (o *User) APIKeys(mods ...qm.QueryMod) apiKeyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"api_keys\".\"user_id\"=?", o.ID),
+	)
+
+	return APIKeys(queryMods...)
+}
+
+// ActorAuditLogs retrieves all the audit_log's AuditLogs with an executor via actor_id column.
+func (o *User) ActorAuditLogs(mods ...qm.QueryMod) auditLogQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"audit_logs\".\"actor_id\"=?", o.ID),
+	)
+
+	return AuditLogs(queryMods...)
+}
+
+// TargetUserAuditLogs retrieves all the audit_log's AuditLogs with an executor via target_user_id column.
+func (o *User) TargetUserAuditLogs(mods ...qm.QueryMod) auditLogQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"audit_logs\".\"target_user_id\"=?", o.ID),
+	)
+
+	return AuditLogs(queryMods...)
+}
+
+// InviterOrganizationInvitations retrieves all the organization_invitation's OrganizationInvitations with an executor via inviter_id column.
+func (o *User) InviterOrganizationInvitations(mods ...qm.QueryMod) organizationInvitationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"organization_invitations\".\"inviter_id\"=?", o.ID),
+	)
+
+	return OrganizationInvitations(queryMods...)
+}
+
+// OrganizationMembers retrieves all the organization_member's OrganizationMembers with an executor.
+func (o *User) OrganizationMembers(mods ...qm.QueryMod) organizationMemberQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"organization_members\".\"user_id\"=?", o.ID),
+	)
+
+	return OrganizationMembers(queryMods...)
+}
+
+// UserIdentities retrieves all the user_identity's UserIdentities with an executor.
+func (o *User) UserIdentities(mods ...qm.QueryMod) userIdentityQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_identities\".\"user_id\"=?", o.ID),
+	)
+
+	return UserIdentities(queryMods...)
+}
+
+// UserRecoveryCodes retrieves all the user_recovery_code's UserRecoveryCodes with an executor.
+func (o *User) UserRecoveryCodes(mods ...qm.QueryMod) userRecoveryCodeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_recovery_codes\".\"user_id\"=?", o.ID),
+	)
+
+	return UserRecoveryCodes(queryMods...)
+}
+
+// UserRoles retrieves all the user_role's UserRoles with an executor.
+func (o *User) UserRoles(mods ...qm.QueryMod) userRoleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_roles\".\"user_id\"=?", o.ID),
+	)
+
+	return UserRoles(queryMods...)
+}
+
+// WebauthnCredentials retrieves all the webauthn_credential's WebauthnCredentials with an executor.
+func (o *User) WebauthnCredentials(mods ...qm.QueryMod) webauthnCredentialQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"webauthn_credentials\".\"user_id\"=?", o.ID),
+	)
+
+	return WebauthnCredentials(queryMods...)
+}
+
+// LoadUserMfa allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (userL) LoadUserMfa(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_mfa`),
+		qm.WhereIn(`user_mfa.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load UserMfa")
+	}
+
+	var resultSlice []*UserMfa
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice UserMfa")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user_mfa")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_mfa")
+	}
+
+	if len(userMfaAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.UserMfa = foreign
+		if foreign.R == nil {
+			foreign.R = &userMfaR{}
+		}
+		foreign.R.User = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.UserID {
+				local.R.UserMfa = foreign
+				if foreign.R == nil {
+					foreign.R = &userMfaR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAPIKeys allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAPIKeys(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`api_keys`),
+		qm.WhereIn(`api_keys.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load api_keys")
+	}
+
+	var resultSlice []*APIKey
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice api_keys")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on api_keys")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for api_keys")
+	}
+
+	if len(apiKeyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.APIKeys = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &apiKeyR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.APIKeys = append(local.R.APIKeys, foreign)
+				if foreign.R == nil {
+					foreign.R = &apiKeyR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadActorAuditLogs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadActorAuditLogs(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`audit_logs`),
+		qm.WhereIn(`audit_logs.actor_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load audit_logs")
+	}
+
+	var resultSlice []*AuditLog
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice audit_logs")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on audit_logs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for audit_logs")
+	}
+
+	if len(auditLogAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ActorAuditLogs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &auditLogR{}
+			}
+			foreign.R.Actor = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ActorID {
+				local.R.ActorAuditLogs = append(local.R.ActorAuditLogs, foreign)
+				if foreign.R == nil {
+					foreign.R = &auditLogR{}
+				}
+				foreign.R.Actor = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTargetUserAuditLogs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadTargetUserAuditLogs(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`audit_logs`),
+		qm.WhereIn(`audit_logs.target_user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load audit_logs")
+	}
+
+	var resultSlice []*AuditLog
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice audit_logs")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on audit_logs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for audit_logs")
+	}
+
+	if len(auditLogAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TargetUserAuditLogs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &auditLogR{}
+			}
+			foreign.R.TargetUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.TargetUserID) {
+				local.R.TargetUserAuditLogs = append(local.R.TargetUserAuditLogs, foreign)
+				if foreign.R == nil {
+					foreign.R = &auditLogR{}
+				}
+				foreign.R.TargetUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadInviterOrganizationInvitations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadInviterOrganizationInvitations(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`organization_invitations`),
+		qm.WhereIn(`organization_invitations.inviter_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load organization_invitations")
+	}
+
+	var resultSlice []*OrganizationInvitation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice organization_invitations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on organization_invitations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for organization_invitations")
+	}
+
+	if len(organizationInvitationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.InviterOrganizationInvitations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &organizationInvitationR{}
+			}
+			foreign.R.Inviter = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.InviterID {
+				local.R.InviterOrganizationInvitations = append(local.R.InviterOrganizationInvitations, foreign)
+				if foreign.R == nil {
+					foreign.R = &organizationInvitationR{}
+				}
+				foreign.R.Inviter = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOrganizationMembers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOrganizationMembers(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`organization_members`),
+		qm.WhereIn(`organization_members.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load organization_members")
+	}
+
+	var resultSlice []*OrganizationMember
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice organization_members")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on organization_members")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for organization_members")
+	}
+
+	if len(organizationMemberAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OrganizationMembers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &organizationMemberR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.OrganizationMembers = append(local.R.OrganizationMembers, foreign)
+				if foreign.R == nil {
+					foreign.R = &organizationMemberR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserIdentities allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserIdentities(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_identities`),
+		qm.WhereIn(`user_identities.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_identities")
+	}
+
+	var resultSlice []*UserIdentity
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_identities")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_identities")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_identities")
+	}
+
+	if len(userIdentityAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserIdentities = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userIdentityR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserIdentities = append(local.R.UserIdentities, foreign)
+				if foreign.R == nil {
+					foreign.R = &userIdentityR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserRecoveryCodes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserRecoveryCodes(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_recovery_codes`),
+		qm.WhereIn(`user_recovery_codes.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_recovery_codes")
+	}
+
+	var resultSlice []*UserRecoveryCode
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_recovery_codes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_recovery_codes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_recovery_codes")
+	}
+
+	if len(userRecoveryCodeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserRecoveryCodes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userRecoveryCodeR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserRecoveryCodes = append(local.R.UserRecoveryCodes, foreign)
+				if foreign.R == nil {
+					foreign.R = &userRecoveryCodeR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserRoles allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserRoles(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_roles`),
+		qm.WhereIn(`user_roles.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_roles")
+	}
+
+	var resultSlice []*UserRole
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_roles")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_roles")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_roles")
+	}
+
+	if len(userRoleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserRoles = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userRoleR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserRoles = append(local.R.UserRoles, foreign)
+				if foreign.R == nil {
+					foreign.R = &userRoleR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWebauthnCredentials allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadWebauthnCredentials(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`webauthn_credentials`),
+		qm.WhereIn(`webauthn_credentials.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load webauthn_credentials")
+	}
+
+	var resultSlice []*WebauthnCredential
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice webauthn_credentials")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on webauthn_credentials")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for webauthn_credentials")
+	}
+
+	if len(webauthnCredentialAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WebauthnCredentials = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &webauthnCredentialR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.WebauthnCredentials = append(local.R.WebauthnCredentials, foreign)
+				if foreign.R == nil {
+					foreign.R = &webauthnCredentialR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUserMfaG of the user to the related item.
+// Sets o.R.UserMfa to related.
+// Adds o to related.R.User.
+// Uses the global database handle.
+func (o *User) SetUserMfaG(insert bool, related *UserMfa) error {
+	return o.SetUserMfa(boil.GetDB(), insert, related)
+}
+
+// SetUserMfa of the user to the related item.
+// Sets o.R.UserMfa to related.
+// Adds o to related.R.User.
+func (o *User) SetUserMfa(exec boil.Executor, insert bool, related *UserMfa) error {
+	var err error
+
+	if insert {
+		related.UserID = o.ID
+
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"user_mfa\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+			strmangle.WhereClause("\"", "\"", 2, userMfaPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.UserID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+		if _, err = exec.Exec(updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.UserID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			UserMfa: related,
+		}
+	} else {
+		o.R.UserMfa = related
+	}
+
+	if related.R == nil {
+		related.R = &userMfaR{
+			User: o,
+		}
+	} else {
+		related.R.User = o
+	}
+	return nil
+}
+
+// AddAPIKeysG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.APIKeys.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
+func (o *User) AddAPIKeysG(insert bool, related ...*APIKey) error {
+	return o.AddAPIKeys(boil.GetDB(), insert, related...)
+}
+
+// AddAPIKeys adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.APIKeys.
+// Sets related.R.User appropriately.
+func (o *User) AddAPIKeys(exec boil.Executor, insert bool, related ...*APIKey) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"api_keys\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, apiKeyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			APIKeys: related,
+		}
+	} else {
+		o.R.APIKeys = append(o.R.APIKeys, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &apiKeyR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddActorAuditLogsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ActorAuditLogs.
+// Sets related.R.Actor appropriately.
+// Uses the global database handle.
+func (o *User) AddActorAuditLogsG(insert bool, related ...*AuditLog) error {
+	return o.AddActorAuditLogs(boil.GetDB(), insert, related...)
+}
+
+// AddActorAuditLogs adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ActorAuditLogs.
+// Sets related.R.Actor appropriately.
+func (o *User) AddActorAuditLogs(exec boil.Executor, insert bool, related ...*AuditLog) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ActorID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"audit_logs\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"actor_id"}),
+				strmangle.WhereClause("\"", "\"", 2, auditLogPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ActorID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ActorAuditLogs: related,
+		}
+	} else {
+		o.R.ActorAuditLogs = append(o.R.ActorAuditLogs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &auditLogR{
+				Actor: o,
+			}
+		} else {
+			rel.R.Actor = o
+		}
+	}
+	return nil
+}
+
+// AddTargetUserAuditLogsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.TargetUserAuditLogs.
+// Sets related.R.TargetUser appropriately.
+// Uses the global database handle.
+func (o *User) AddTargetUserAuditLogsG(insert bool, related ...*AuditLog) error {
+	return o.AddTargetUserAuditLogs(boil.GetDB(), insert, related...)
+}
+
+// AddTargetUserAuditLogs adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.TargetUserAuditLogs.
+// Sets related.R.TargetUser appropriately.
+func (o *User) AddTargetUserAuditLogs(exec boil.Executor, insert bool, related ...*AuditLog) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.TargetUserID, o.ID)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"audit_logs\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"target_user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, auditLogPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.TargetUserID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			TargetUserAuditLogs: related,
+		}
+	} else {
+		o.R.TargetUserAuditLogs = append(o.R.TargetUserAuditLogs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &auditLogR{
+				TargetUser: o,
+			}
+		} else {
+			rel.R.TargetUser = o
+		}
+	}
 	return nil
 }
 
-// doBeforeUpdateHooks executes all "before Update" hooks.
-func (o *User) doBeforeUpdateHooks(exec boil.Executor) (err error) {
-	for _, hook := range userBeforeUpdateHooks {
-		if err := hook(exec, o); err != nil {
-			return err
-		}
+// SetTargetUserAuditLogsG removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.TargetUser's TargetUserAuditLogs accordingly.
+// Replaces o.R.TargetUserAuditLogs with related.
+// Sets related.R.TargetUser's TargetUserAuditLogs accordingly.
+// Uses the global database handle.
+func (o *User) SetTargetUserAuditLogsG(insert bool, related ...*AuditLog) error {
+	return o.SetTargetUserAuditLogs(boil.GetDB(), insert, related...)
+}
+
+// SetTargetUserAuditLogs removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.TargetUser's TargetUserAuditLogs accordingly.
+// Replaces o.R.TargetUserAuditLogs with related.
+// Sets related.R.TargetUser's TargetUserAuditLogs accordingly.
+func (o *User) SetTargetUserAuditLogs(exec boil.Executor, insert bool, related ...*AuditLog) error {
+	query := "update \"audit_logs\" set \"target_user_id\" = null where \"target_user_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	return nil
-}
+	if o.R != nil {
+		for _, rel := range o.R.TargetUserAuditLogs {
+			queries.SetScanner(&rel.TargetUserID, nil)
+			if rel.R == nil {
+				continue
+			}
 
-// doAfterUpdateHooks executes all "after Update" hooks.
-func (o *User) doAfterUpdateHooks(exec boil.Executor) (err error) {
-	for _, hook := range userAfterUpdateHooks {
-		if err := hook(exec, o); err != nil {
-			return err
+			rel.R.TargetUser = nil
 		}
+		o.R.TargetUserAuditLogs = nil
 	}
 
-	return nil
+	return o.AddTargetUserAuditLogs(exec, insert, related...)
 }
 
-// doBeforeDeleteHooks executes all "before Delete" hooks.
-func (o *User) doBeforeDeleteHooks(exec boil.Executor) (err error) {
-	for _, hook := range userBeforeDeleteHooks {
-		if err := hook(exec, o); err != nil {
+// RemoveTargetUserAuditLogsG relationships from objects passed in.
+// Removes related items from R.TargetUserAuditLogs (uses pointer comparison, removal does not keep order)
+// Sets related.R.TargetUser.
+// Uses the global database handle.
+func (o *User) RemoveTargetUserAuditLogsG(related ...*AuditLog) error {
+	return o.RemoveTargetUserAuditLogs(boil.GetDB(), related...)
+}
+
+// RemoveTargetUserAuditLogs relationships from objects passed in.
+// Removes related items from R.TargetUserAuditLogs (uses pointer comparison, removal does not keep order)
+// Sets related.R.TargetUser.
+func (o *User) RemoveTargetUserAuditLogs(exec boil.Executor, related ...*AuditLog) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.TargetUserID, nil)
+		if rel.R != nil {
+			rel.R.TargetUser = nil
+		}
+		if _, err = rel.Update(exec, boil.Whitelist("target_user_id")); err != nil {
 			return err
 		}
 	}
+	if o.R == nil {
+		return nil
+	}
 
-	return nil
-}
+	for _, rel := range related {
+		for i, ri := range o.R.TargetUserAuditLogs {
+			if rel != ri {
+				continue
+			}
 
-// doAfterDeleteHooks executes all "after Delete" hooks.
-func (o *User) doAfterDeleteHooks(exec boil.Executor) (err error) {
-	for _, hook := range userAfterDeleteHooks {
-		if err := hook(exec, o); err != nil {
-			return err
+			ln := len(o.R.TargetUserAuditLogs)
+			if ln > 1 && i < ln-1 {
+				o.R.TargetUserAuditLogs[i] = o.R.TargetUserAuditLogs[ln-1]
+			}
+			o.R.TargetUserAuditLogs = o.R.TargetUserAuditLogs[:ln-1]
+			break
 		}
 	}
 
 	return nil
 }
 
-// doBeforeUpsertHooks executes all "before Upsert" hooks.
-func (o *User) doBeforeUpsertHooks(exec boil.Executor) (err error) {
-	for _, hook := range userBeforeUpsertHooks {
-		if err := hook(exec, o); err != nil {
-			return err
+// AddInviterOrganizationInvitationsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.InviterOrganizationInvitations.
+// Sets related.R.Inviter appropriately.
+// Uses the global database handle.
+func (o *User) AddInviterOrganizationInvitationsG(insert bool, related ...*OrganizationInvitation) error {
+	return o.AddInviterOrganizationInvitations(boil.GetDB(), insert, related...)
+}
+
+// AddInviterOrganizationInvitations adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.InviterOrganizationInvitations.
+// Sets related.R.Inviter appropriately.
+func (o *User) AddInviterOrganizationInvitations(exec boil.Executor, insert bool, related ...*OrganizationInvitation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.InviterID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"organization_invitations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"inviter_id"}),
+				strmangle.WhereClause("\"", "\"", 2, organizationInvitationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.InviterID = o.ID
 		}
 	}
 
+	if o.R == nil {
+		o.R = &userR{
+			InviterOrganizationInvitations: related,
+		}
+	} else {
+		o.R.InviterOrganizationInvitations = append(o.R.InviterOrganizationInvitations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &organizationInvitationR{
+				Inviter: o,
+			}
+		} else {
+			rel.R.Inviter = o
+		}
+	}
 	return nil
 }
 
-// doAfterUpsertHooks executes all "after Upsert" hooks.
-func (o *User) doAfterUpsertHooks(exec boil.Executor) (err error) {
-	for _, hook := range userAfterUpsertHooks {
-		if err := hook(exec, o); err != nil {
-			return err
+// AddOrganizationMembersG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.OrganizationMembers.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
+func (o *User) AddOrganizationMembersG(insert bool, related ...*OrganizationMember) error {
+	return o.AddOrganizationMembers(boil.GetDB(), insert, related...)
+}
+
+// AddOrganizationMembers adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.OrganizationMembers.
+// Sets related.R.User appropriately.
+func (o *User) AddOrganizationMembers(exec boil.Executor, insert bool, related ...*OrganizationMember) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"organization_members\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, organizationMemberPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.OrganizationID, rel.UserID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
 		}
 	}
 
-	return nil
-}
+	if o.R == nil {
+		o.R = &userR{
+			OrganizationMembers: related,
+		}
+	} else {
+		o.R.OrganizationMembers = append(o.R.OrganizationMembers, related...)
+	}
 
-// AddUserHook registers your hook function for all future operations.
-func AddUserHook(hookPoint boil.HookPoint, userHook UserHook) {
-	switch hookPoint {
-	case boil.AfterSelectHook:
-		userAfterSelectMu.Lock()
-		userAfterSelectHooks = append(userAfterSelectHooks, userHook)
-		userAfterSelectMu.Unlock()
-	case boil.BeforeInsertHook:
-		userBeforeInsertMu.Lock()
-		userBeforeInsertHooks = append(userBeforeInsertHooks, userHook)
-		userBeforeInsertMu.Unlock()
-	case boil.AfterInsertHook:
-		userAfterInsertMu.Lock()
-		userAfterInsertHooks = append(userAfterInsertHooks, userHook)
-		userAfterInsertMu.Unlock()
-	case boil.BeforeUpdateHook:
-		userBeforeUpdateMu.Lock()
-		userBeforeUpdateHooks = append(userBeforeUpdateHooks, userHook)
-		userBeforeUpdateMu.Unlock()
-	case boil.AfterUpdateHook:
-		userAfterUpdateMu.Lock()
-		userAfterUpdateHooks = append(userAfterUpdateHooks, userHook)
-		userAfterUpdateMu.Unlock()
-	case boil.BeforeDeleteHook:
-		userBeforeDeleteMu.Lock()
-		userBeforeDeleteHooks = append(userBeforeDeleteHooks, userHook)
-		userBeforeDeleteMu.Unlock()
-	case boil.AfterDeleteHook:
-		userAfterDeleteMu.Lock()
-		userAfterDeleteHooks = append(userAfterDeleteHooks, userHook)
-		userAfterDeleteMu.Unlock()
-	case boil.BeforeUpsertHook:
-		userBeforeUpsertMu.Lock()
-		userBeforeUpsertHooks = append(userBeforeUpsertHooks, userHook)
-		userBeforeUpsertMu.Unlock()
-	case boil.AfterUpsertHook:
-		userAfterUpsertMu.Lock()
-		userAfterUpsertHooks = append(userAfterUpsertHooks, userHook)
-		userAfterUpsertMu.Unlock()
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &organizationMemberR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
 	}
+	return nil
 }
 
-// OneG returns a single user record from the query using the global executor.
-func (q userQuery) OneG() (*User, error) {
-	return q.One(boil.GetDB())
+// AddUserIdentitiesG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserIdentities.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
+func (o *User) AddUserIdentitiesG(insert bool, related ...*UserIdentity) error {
+	return o.AddUserIdentities(boil.GetDB(), insert, related...)
 }
 
-// One returns a single user record from the query.
-func (q userQuery) One(exec boil.Executor) (*User, error) {
-	o := &User{}
-
-	queries.SetLimit(q.Query, 1)
+// AddUserIdentities adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserIdentities.
+// Sets related.R.User appropriately.
+func (o *User) AddUserIdentities(exec boil.Executor, insert bool, related ...*UserIdentity) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_identities\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userIdentityPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	err := q.Bind(nil, exec, o)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, sql.ErrNoRows
+			rel.UserID = o.ID
 		}
-		return nil, errors.Wrap(err, "orm: failed to execute a one query for users")
 	}
 
-	if err := o.doAfterSelectHooks(exec); err != nil {
-		return o, err
+	if o.R == nil {
+		o.R = &userR{
+			UserIdentities: related,
+		}
+	} else {
+		o.R.UserIdentities = append(o.R.UserIdentities, related...)
 	}
 
-	return o, nil
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userIdentityR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
 }
 
-// AllG returns all User records from the query using the global executor.
-func (q userQuery) AllG() (UserSlice, error) {
-	return q.All(boil.GetDB())
+// AddUserRecoveryCodesG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserRecoveryCodes.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
+func (o *User) AddUserRecoveryCodesG(insert bool, related ...*UserRecoveryCode) error {
+	return o.AddUserRecoveryCodes(boil.GetDB(), insert, related...)
 }
 
-// All returns all User records from the query.
-func (q userQuery) All(exec boil.Executor) (UserSlice, error) {
-	var o []*User
+// AddUserRecoveryCodes adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserRecoveryCodes.
+// Sets related.R.User appropriately.
+func (o *User) AddUserRecoveryCodes(exec boil.Executor, insert bool, related ...*UserRecoveryCode) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_recovery_codes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userRecoveryCodePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	err := q.Bind(nil, exec, &o)
-	if err != nil {
-		return nil, errors.Wrap(err, "orm: failed to assign all query results to User slice")
+			rel.UserID = o.ID
+		}
 	}
 
-	if len(userAfterSelectHooks) != 0 {
-		for _, obj := range o {
-			if err := obj.doAfterSelectHooks(exec); err != nil {
-				return o, err
-			}
+	if o.R == nil {
+		o.R = &userR{
+			UserRecoveryCodes: related,
 		}
+	} else {
+		o.R.UserRecoveryCodes = append(o.R.UserRecoveryCodes, related...)
 	}
 
-	return o, nil
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userRecoveryCodeR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
 }
 
-// CountG returns the count of all User records in the query using the global executor
-func (q userQuery) CountG() (int64, error) {
-	return q.Count(boil.GetDB())
+// AddUserRolesG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserRoles.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
+func (o *User) AddUserRolesG(insert bool, related ...*UserRole) error {
+	return o.AddUserRoles(boil.GetDB(), insert, related...)
 }
 
-// Count returns the count of all User records in the query.
-func (q userQuery) Count(exec boil.Executor) (int64, error) {
-	var count int64
+// AddUserRoles adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserRoles.
+// Sets related.R.User appropriately.
+func (o *User) AddUserRoles(exec boil.Executor, insert bool, related ...*UserRole) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_roles\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userRolePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.RoleID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	queries.SetSelect(q.Query, nil)
-	queries.SetCount(q.Query)
+			rel.UserID = o.ID
+		}
+	}
 
-	err := q.Query.QueryRow(exec).Scan(&count)
-	if err != nil {
-		return 0, errors.Wrap(err, "orm: failed to count users rows")
+	if o.R == nil {
+		o.R = &userR{
+			UserRoles: related,
+		}
+	} else {
+		o.R.UserRoles = append(o.R.UserRoles, related...)
 	}
 
-	return count, nil
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userRoleR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
 }
 
-// ExistsG checks if the row exists in the table using the global executor.
-func (q userQuery) ExistsG() (bool, error) {
-	return q.Exists(boil.GetDB())
+// AddWebauthnCredentialsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.WebauthnCredentials.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
+func (o *User) AddWebauthnCredentialsG(insert bool, related ...*WebauthnCredential) error {
+	return o.AddWebauthnCredentials(boil.GetDB(), insert, related...)
 }
 
-// Exists checks if the row exists in the table.
-func (q userQuery) Exists(exec boil.Executor) (bool, error) {
-	var count int64
+// AddWebauthnCredentials adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.WebauthnCredentials.
+// Sets related.R.User appropriately.
+func (o *User) AddWebauthnCredentials(exec boil.Executor, insert bool, related ...*WebauthnCredential) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"webauthn_credentials\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, webauthnCredentialPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	queries.SetSelect(q.Query, nil)
-	queries.SetCount(q.Query)
-	queries.SetLimit(q.Query, 1)
+			rel.UserID = o.ID
+		}
+	}
 
-	err := q.Query.QueryRow(exec).Scan(&count)
-	if err != nil {
-		return false, errors.Wrap(err, "orm: failed to check if users exists")
+	if o.R == nil {
+		o.R = &userR{
+			WebauthnCredentials: related,
+		}
+	} else {
+		o.R.WebauthnCredentials = append(o.R.WebauthnCredentials, related...)
 	}
 
-	return count > 0, nil
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &webauthnCredentialR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
 }
 
 // Users retrieves all the records using an executor.
 func Users(mods ...qm.QueryMod) userQuery {
-	mods = append(mods, qm.From("\"users\""))
+	mods = append(mods, qm.From("\"users\""), qmhelper.WhereIsNull("\"users\".\"deleted_at\""))
 	q := NewQuery(mods...)
 	if len(queries.GetSelect(q)) == 0 {
 		queries.SetSelect(q, []string{"\"users\".*"})
@@ -568,7 +2639,7 @@ func FindUser(exec boil.Executor, iD int64, selectCols ...string) (*User, error)
 		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
 	}
 	query := fmt.Sprintf(
-		"select %s from \"users\" where \"id\"=$1", sel,
+		"select %s from \"users\" where \"id\"=$1 and \"deleted_at\" is null", sel,
 	)
 
 	q := queries.Raw(query, iD)
@@ -959,13 +3030,13 @@ func (o *User) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns
 
 // DeleteG deletes a single User record.
 // DeleteG will match against the primary key column to find the record to delete.
-func (o *User) DeleteG() (int64, error) {
-	return o.Delete(boil.GetDB())
+func (o *User) DeleteG(hardDelete bool) (int64, error) {
+	return o.Delete(boil.GetDB(), hardDelete)
 }
 
 // Delete deletes a single User record with an executor.
 // Delete will match against the primary key column to find the record to delete.
-func (o *User) Delete(exec boil.Executor) (int64, error) {
+func (o *User) Delete(exec boil.Executor, hardDelete bool) (int64, error) {
 	if o == nil {
 		return 0, errors.New("orm: no User provided for delete")
 	}
@@ -974,8 +3045,26 @@ func (o *User) Delete(exec boil.Executor) (int64, error) {
 		return 0, err
 	}
 
-	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), userPrimaryKeyMapping)
-	sql := "DELETE FROM \"users\" WHERE \"id\"=$1"
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), userPrimaryKeyMapping)
+		sql = "DELETE FROM \"users\" WHERE \"id\"=$1"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"users\" SET %s WHERE \"id\"=$2",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		valueMapping, err := queries.BindMapping(userType, userMapping, append(wl, userPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
 
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, sql)
@@ -998,17 +3087,22 @@ func (o *User) Delete(exec boil.Executor) (int64, error) {
 	return rowsAff, nil
 }
 
-func (q userQuery) DeleteAllG() (int64, error) {
-	return q.DeleteAll(boil.GetDB())
+func (q userQuery) DeleteAllG(hardDelete bool) (int64, error) {
+	return q.DeleteAll(boil.GetDB(), hardDelete)
 }
 
 // DeleteAll deletes all matching rows.
-func (q userQuery) DeleteAll(exec boil.Executor) (int64, error) {
+func (q userQuery) DeleteAll(exec boil.Executor, hardDelete bool) (int64, error) {
 	if q.Query == nil {
 		return 0, errors.New("orm: no userQuery provided for delete all")
 	}
 
-	queries.SetDelete(q.Query)
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
 
 	result, err := q.Query.Exec(exec)
 	if err != nil {
@@ -1024,12 +3118,12 @@ func (q userQuery) DeleteAll(exec boil.Executor) (int64, error) {
 }
 
 // DeleteAllG deletes all rows in the slice.
-func (o UserSlice) DeleteAllG() (int64, error) {
-	return o.DeleteAll(boil.GetDB())
+func (o UserSlice) DeleteAllG(hardDelete bool) (int64, error) {
+	return o.DeleteAll(boil.GetDB(), hardDelete)
 }
 
 // DeleteAll deletes all rows in the slice, using an executor.
-func (o UserSlice) DeleteAll(exec boil.Executor) (int64, error) {
+func (o UserSlice) DeleteAll(exec boil.Executor, hardDelete bool) (int64, error) {
 	if len(o) == 0 {
 		return 0, nil
 	}
@@ -1042,15 +3136,32 @@ func (o UserSlice) DeleteAll(exec boil.Executor) (int64, error) {
 		}
 	}
 
-	var args []interface{}
-	for _, obj := range o {
-		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userPrimaryKeyMapping)
-		args = append(args, pkeyArgs...)
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM \"users\" WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, userPrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+		}
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"users\" SET %s WHERE "+
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 2, userPrimaryKeyColumns, len(o)),
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		args = append([]interface{}{currTime}, args...)
 	}
 
-	sql := "DELETE FROM \"users\" WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, userPrimaryKeyColumns, len(o))
-
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, sql)
 		fmt.Fprintln(boil.DebugWriter, args)
@@ -1122,7 +3233,8 @@ func (o *UserSlice) ReloadAll(exec boil.Executor) error {
 	}
 
 	sql := "SELECT \"users\".* FROM \"users\" WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, userPrimaryKeyColumns, len(*o))
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, userPrimaryKeyColumns, len(*o)) +
+		"and \"deleted_at\" is null"
 
 	q := queries.Raw(sql, args...)
 
@@ -1144,7 +3256,7 @@ func UserExistsG(iD int64) (bool, error) {
 // UserExists checks if the User row exists.
 func UserExists(exec boil.Executor, iD int64) (bool, error) {
 	var exists bool
-	sql := "select exists(select 1 from \"users\" where \"id\"=$1 limit 1)"
+	sql := "select exists(select 1 from \"users\" where \"id\"=$1 and \"deleted_at\" is null limit 1)"
 
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, sql)
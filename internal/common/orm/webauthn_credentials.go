@@ -0,0 +1,1287 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// WebauthnCredential is an object representing the database table.
+type WebauthnCredential struct {
+	ID              int64             `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID          int64             `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Name            string            `boil:"name" json:"name" toml:"name" yaml:"name"`
+	CredentialID    []byte            `boil:"credential_id" json:"credential_id" toml:"credential_id" yaml:"credential_id"`
+	PublicKey       []byte            `boil:"public_key" json:"public_key" toml:"public_key" yaml:"public_key"`
+	AttestationType string            `boil:"attestation_type" json:"attestation_type" toml:"attestation_type" yaml:"attestation_type"`
+	Transports      types.StringArray `boil:"transports" json:"transports" toml:"transports" yaml:"transports"`
+	Aaguid          []byte            `boil:"aaguid" json:"aaguid" toml:"aaguid" yaml:"aaguid"`
+	SignCount       int64             `boil:"sign_count" json:"sign_count" toml:"sign_count" yaml:"sign_count"`
+	BackupEligible  bool              `boil:"backup_eligible" json:"backup_eligible" toml:"backup_eligible" yaml:"backup_eligible"`
+	BackupState     bool              `boil:"backup_state" json:"backup_state" toml:"backup_state" yaml:"backup_state"`
+	LastUsedAt      null.Time         `boil:"last_used_at" json:"last_used_at,omitempty" toml:"last_used_at" yaml:"last_used_at,omitempty"`
+	CreatedAt       time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *webauthnCredentialR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L webauthnCredentialL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WebauthnCredentialColumns = struct {
+	ID              string
+	UserID          string
+	Name            string
+	CredentialID    string
+	PublicKey       string
+	AttestationType string
+	Transports      string
+	Aaguid          string
+	SignCount       string
+	BackupEligible  string
+	BackupState     string
+	LastUsedAt      string
+	CreatedAt       string
+}{
+	ID:              "id",
+	UserID:          "user_id",
+	Name:            "name",
+	CredentialID:    "credential_id",
+	PublicKey:       "public_key",
+	AttestationType: "attestation_type",
+	Transports:      "transports",
+	Aaguid:          "aaguid",
+	SignCount:       "sign_count",
+	BackupEligible:  "backup_eligible",
+	BackupState:     "backup_state",
+	LastUsedAt:      "last_used_at",
+	CreatedAt:       "created_at",
+}
+
+var WebauthnCredentialTableColumns = struct {
+	ID              string
+	UserID          string
+	Name            string
+	CredentialID    string
+	PublicKey       string
+	AttestationType string
+	Transports      string
+	Aaguid          string
+	SignCount       string
+	BackupEligible  string
+	BackupState     string
+	LastUsedAt      string
+	CreatedAt       string
+}{
+	ID:              "webauthn_credentials.id",
+	UserID:          "webauthn_credentials.user_id",
+	Name:            "webauthn_credentials.name",
+	CredentialID:    "webauthn_credentials.credential_id",
+	PublicKey:       "webauthn_credentials.public_key",
+	AttestationType: "webauthn_credentials.attestation_type",
+	Transports:      "webauthn_credentials.transports",
+	Aaguid:          "webauthn_credentials.aaguid",
+	SignCount:       "webauthn_credentials.sign_count",
+	BackupEligible:  "webauthn_credentials.backup_eligible",
+	BackupState:     "webauthn_credentials.backup_state",
+	LastUsedAt:      "webauthn_credentials.last_used_at",
+	CreatedAt:       "webauthn_credentials.created_at",
+}
+
+// Generated where
+
+type whereHelper__byte struct{ field string }
+
+func (w whereHelper__byte) EQ(x []byte) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelper__byte) NEQ(x []byte) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelper__byte) LT(x []byte) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelper__byte) LTE(x []byte) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelper__byte) GT(x []byte) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelper__byte) GTE(x []byte) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
+type whereHelperbool struct{ field string }
+
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
+var WebauthnCredentialWhere = struct {
+	ID              whereHelperint64
+	UserID          whereHelperint64
+	Name            whereHelperstring
+	CredentialID    whereHelper__byte
+	PublicKey       whereHelper__byte
+	AttestationType whereHelperstring
+	Transports      whereHelpertypes_StringArray
+	Aaguid          whereHelper__byte
+	SignCount       whereHelperint64
+	BackupEligible  whereHelperbool
+	BackupState     whereHelperbool
+	LastUsedAt      whereHelpernull_Time
+	CreatedAt       whereHelpertime_Time
+}{
+	ID:              whereHelperint64{field: "\"webauthn_credentials\".\"id\""},
+	UserID:          whereHelperint64{field: "\"webauthn_credentials\".\"user_id\""},
+	Name:            whereHelperstring{field: "\"webauthn_credentials\".\"name\""},
+	CredentialID:    whereHelper__byte{field: "\"webauthn_credentials\".\"credential_id\""},
+	PublicKey:       whereHelper__byte{field: "\"webauthn_credentials\".\"public_key\""},
+	AttestationType: whereHelperstring{field: "\"webauthn_credentials\".\"attestation_type\""},
+	Transports:      whereHelpertypes_StringArray{field: "\"webauthn_credentials\".\"transports\""},
+	Aaguid:          whereHelper__byte{field: "\"webauthn_credentials\".\"aaguid\""},
+	SignCount:       whereHelperint64{field: "\"webauthn_credentials\".\"sign_count\""},
+	BackupEligible:  whereHelperbool{field: "\"webauthn_credentials\".\"backup_eligible\""},
+	BackupState:     whereHelperbool{field: "\"webauthn_credentials\".\"backup_state\""},
+	LastUsedAt:      whereHelpernull_Time{field: "\"webauthn_credentials\".\"last_used_at\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"webauthn_credentials\".\"created_at\""},
+}
+
+// WebauthnCredentialRels is where relationship names are stored.
+var WebauthnCredentialRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// webauthnCredentialR is where relationships are stored.
+type webauthnCredentialR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*webauthnCredentialR) NewStruct() *webauthnCredentialR {
+	return &webauthnCredentialR{}
+}
+
+func (o *WebauthnCredential) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *webauthnCredentialR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// webauthnCredentialL is where Load methods for each relationship are stored.
+type webauthnCredentialL struct{}
+
+var (
+	webauthnCredentialAllColumns            = []string{"id", "user_id", "name", "credential_id", "public_key", "attestation_type", "transports", "aaguid", "sign_count", "backup_eligible", "backup_state", "last_used_at", "created_at"}
+	webauthnCredentialColumnsWithoutDefault = []string{"user_id", "credential_id", "public_key", "aaguid"}
+	webauthnCredentialColumnsWithDefault    = []string{"id", "name", "attestation_type", "transports", "sign_count", "backup_eligible", "backup_state", "last_used_at", "created_at"}
+	webauthnCredentialPrimaryKeyColumns     = []string{"id"}
+	webauthnCredentialGeneratedColumns      = []string{}
+)
+
+type (
+	// WebauthnCredentialSlice is an alias for a slice of pointers to WebauthnCredential.
+	// This should almost always be used instead of []WebauthnCredential.
+	WebauthnCredentialSlice []*WebauthnCredential
+	// WebauthnCredentialHook is the signature for custom WebauthnCredential hook methods
+	WebauthnCredentialHook func(boil.Executor, *WebauthnCredential) error
+
+	webauthnCredentialQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	webauthnCredentialType                 = reflect.TypeOf(&WebauthnCredential{})
+	webauthnCredentialMapping              = queries.MakeStructMapping(webauthnCredentialType)
+	webauthnCredentialPrimaryKeyMapping, _ = queries.BindMapping(webauthnCredentialType, webauthnCredentialMapping, webauthnCredentialPrimaryKeyColumns)
+	webauthnCredentialInsertCacheMut       sync.RWMutex
+	webauthnCredentialInsertCache          = make(map[string]insertCache)
+	webauthnCredentialUpdateCacheMut       sync.RWMutex
+	webauthnCredentialUpdateCache          = make(map[string]updateCache)
+	webauthnCredentialUpsertCacheMut       sync.RWMutex
+	webauthnCredentialUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var webauthnCredentialAfterSelectMu sync.Mutex
+var webauthnCredentialAfterSelectHooks []WebauthnCredentialHook
+
+var webauthnCredentialBeforeInsertMu sync.Mutex
+var webauthnCredentialBeforeInsertHooks []WebauthnCredentialHook
+var webauthnCredentialAfterInsertMu sync.Mutex
+var webauthnCredentialAfterInsertHooks []WebauthnCredentialHook
+
+var webauthnCredentialBeforeUpdateMu sync.Mutex
+var webauthnCredentialBeforeUpdateHooks []WebauthnCredentialHook
+var webauthnCredentialAfterUpdateMu sync.Mutex
+var webauthnCredentialAfterUpdateHooks []WebauthnCredentialHook
+
+var webauthnCredentialBeforeDeleteMu sync.Mutex
+var webauthnCredentialBeforeDeleteHooks []WebauthnCredentialHook
+var webauthnCredentialAfterDeleteMu sync.Mutex
+var webauthnCredentialAfterDeleteHooks []WebauthnCredentialHook
+
+var webauthnCredentialBeforeUpsertMu sync.Mutex
+var webauthnCredentialBeforeUpsertHooks []WebauthnCredentialHook
+var webauthnCredentialAfterUpsertMu sync.Mutex
+var webauthnCredentialAfterUpsertHooks []WebauthnCredentialHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WebauthnCredential) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range webauthnCredentialAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WebauthnCredential) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range webauthnCredentialBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WebauthnCredential) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range webauthnCredentialAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WebauthnCredential) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range webauthnCredentialBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WebauthnCredential) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range webauthnCredentialAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WebauthnCredential) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range webauthnCredentialBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WebauthnCredential) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range webauthnCredentialAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WebauthnCredential) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range webauthnCredentialBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WebauthnCredential) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range webauthnCredentialAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWebauthnCredentialHook registers your hook function for all future operations.
+func AddWebauthnCredentialHook(hookPoint boil.HookPoint, webauthnCredentialHook WebauthnCredentialHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		webauthnCredentialAfterSelectMu.Lock()
+		webauthnCredentialAfterSelectHooks = append(webauthnCredentialAfterSelectHooks, webauthnCredentialHook)
+		webauthnCredentialAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		webauthnCredentialBeforeInsertMu.Lock()
+		webauthnCredentialBeforeInsertHooks = append(webauthnCredentialBeforeInsertHooks, webauthnCredentialHook)
+		webauthnCredentialBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		webauthnCredentialAfterInsertMu.Lock()
+		webauthnCredentialAfterInsertHooks = append(webauthnCredentialAfterInsertHooks, webauthnCredentialHook)
+		webauthnCredentialAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		webauthnCredentialBeforeUpdateMu.Lock()
+		webauthnCredentialBeforeUpdateHooks = append(webauthnCredentialBeforeUpdateHooks, webauthnCredentialHook)
+		webauthnCredentialBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		webauthnCredentialAfterUpdateMu.Lock()
+		webauthnCredentialAfterUpdateHooks = append(webauthnCredentialAfterUpdateHooks, webauthnCredentialHook)
+		webauthnCredentialAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		webauthnCredentialBeforeDeleteMu.Lock()
+		webauthnCredentialBeforeDeleteHooks = append(webauthnCredentialBeforeDeleteHooks, webauthnCredentialHook)
+		webauthnCredentialBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		webauthnCredentialAfterDeleteMu.Lock()
+		webauthnCredentialAfterDeleteHooks = append(webauthnCredentialAfterDeleteHooks, webauthnCredentialHook)
+		webauthnCredentialAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		webauthnCredentialBeforeUpsertMu.Lock()
+		webauthnCredentialBeforeUpsertHooks = append(webauthnCredentialBeforeUpsertHooks, webauthnCredentialHook)
+		webauthnCredentialBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		webauthnCredentialAfterUpsertMu.Lock()
+		webauthnCredentialAfterUpsertHooks = append(webauthnCredentialAfterUpsertHooks, webauthnCredentialHook)
+		webauthnCredentialAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single webauthnCredential record from the query using the global executor.
+func (q webauthnCredentialQuery) OneG() (*WebauthnCredential, error) {
+	return q.One(boil.GetDB())
+}
+
+// One returns a single webauthnCredential record from the query.
+func (q webauthnCredentialQuery) One(exec boil.Executor) (*WebauthnCredential, error) {
+	o := &WebauthnCredential{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for webauthn_credentials")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all WebauthnCredential records from the query using the global executor.
+func (q webauthnCredentialQuery) AllG() (WebauthnCredentialSlice, error) {
+	return q.All(boil.GetDB())
+}
+
+// All returns all WebauthnCredential records from the query.
+func (q webauthnCredentialQuery) All(exec boil.Executor) (WebauthnCredentialSlice, error) {
+	var o []*WebauthnCredential
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to WebauthnCredential slice")
+	}
+
+	if len(webauthnCredentialAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all WebauthnCredential records in the query using the global executor
+func (q webauthnCredentialQuery) CountG() (int64, error) {
+	return q.Count(boil.GetDB())
+}
+
+// Count returns the count of all WebauthnCredential records in the query.
+func (q webauthnCredentialQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count webauthn_credentials rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q webauthnCredentialQuery) ExistsG() (bool, error) {
+	return q.Exists(boil.GetDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q webauthnCredentialQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if webauthn_credentials exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *WebauthnCredential) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (webauthnCredentialL) LoadUser(e boil.Executor, singular bool, maybeWebauthnCredential interface{}, mods queries.Applicator) error {
+	var slice []*WebauthnCredential
+	var object *WebauthnCredential
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebauthnCredential.(*WebauthnCredential)
+		if !ok {
+			object = new(WebauthnCredential)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebauthnCredential)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebauthnCredential))
+			}
+		}
+	} else {
+		s, ok := maybeWebauthnCredential.(*[]*WebauthnCredential)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebauthnCredential)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebauthnCredential))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &webauthnCredentialR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &webauthnCredentialR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.WebauthnCredentials = append(foreign.R.WebauthnCredentials, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.WebauthnCredentials = append(foreign.R.WebauthnCredentials, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUserG of the webauthnCredential to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.WebauthnCredentials.
+// Uses the global database handle.
+func (o *WebauthnCredential) SetUserG(insert bool, related *User) error {
+	return o.SetUser(boil.GetDB(), insert, related)
+}
+
+// SetUser of the webauthnCredential to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.WebauthnCredentials.
+func (o *WebauthnCredential) SetUser(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"webauthn_credentials\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, webauthnCredentialPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &webauthnCredentialR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			WebauthnCredentials: WebauthnCredentialSlice{o},
+		}
+	} else {
+		related.R.WebauthnCredentials = append(related.R.WebauthnCredentials, o)
+	}
+
+	return nil
+}
+
+// WebauthnCredentials retrieves all the records using an executor.
+func WebauthnCredentials(mods ...qm.QueryMod) webauthnCredentialQuery {
+	mods = append(mods, qm.From("\"webauthn_credentials\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"webauthn_credentials\".*"})
+	}
+
+	return webauthnCredentialQuery{q}
+}
+
+// FindWebauthnCredentialG retrieves a single record by ID.
+func FindWebauthnCredentialG(iD int64, selectCols ...string) (*WebauthnCredential, error) {
+	return FindWebauthnCredential(boil.GetDB(), iD, selectCols...)
+}
+
+// FindWebauthnCredential retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWebauthnCredential(exec boil.Executor, iD int64, selectCols ...string) (*WebauthnCredential, error) {
+	webauthnCredentialObj := &WebauthnCredential{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"webauthn_credentials\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, webauthnCredentialObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from webauthn_credentials")
+	}
+
+	if err = webauthnCredentialObj.doAfterSelectHooks(exec); err != nil {
+		return webauthnCredentialObj, err
+	}
+
+	return webauthnCredentialObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *WebauthnCredential) InsertG(columns boil.Columns) error {
+	return o.Insert(boil.GetDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WebauthnCredential) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no webauthn_credentials provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webauthnCredentialColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	webauthnCredentialInsertCacheMut.RLock()
+	cache, cached := webauthnCredentialInsertCache[key]
+	webauthnCredentialInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			webauthnCredentialAllColumns,
+			webauthnCredentialColumnsWithDefault,
+			webauthnCredentialColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(webauthnCredentialType, webauthnCredentialMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(webauthnCredentialType, webauthnCredentialMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"webauthn_credentials\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"webauthn_credentials\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into webauthn_credentials")
+	}
+
+	if !cached {
+		webauthnCredentialInsertCacheMut.Lock()
+		webauthnCredentialInsertCache[key] = cache
+		webauthnCredentialInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// UpdateG a single WebauthnCredential record using the global executor.
+// See Update for more documentation.
+func (o *WebauthnCredential) UpdateG(columns boil.Columns) (int64, error) {
+	return o.Update(boil.GetDB(), columns)
+}
+
+// Update uses an executor to update the WebauthnCredential.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WebauthnCredential) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	webauthnCredentialUpdateCacheMut.RLock()
+	cache, cached := webauthnCredentialUpdateCache[key]
+	webauthnCredentialUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			webauthnCredentialAllColumns,
+			webauthnCredentialPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update webauthn_credentials, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"webauthn_credentials\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, webauthnCredentialPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(webauthnCredentialType, webauthnCredentialMapping, append(wl, webauthnCredentialPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update webauthn_credentials row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for webauthn_credentials")
+	}
+
+	if !cached {
+		webauthnCredentialUpdateCacheMut.Lock()
+		webauthnCredentialUpdateCache[key] = cache
+		webauthnCredentialUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q webauthnCredentialQuery) UpdateAllG(cols M) (int64, error) {
+	return q.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q webauthnCredentialQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for webauthn_credentials")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for webauthn_credentials")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o WebauthnCredentialSlice) UpdateAllG(cols M) (int64, error) {
+	return o.UpdateAll(boil.GetDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WebauthnCredentialSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webauthnCredentialPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"webauthn_credentials\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, webauthnCredentialPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in webauthnCredential slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all webauthnCredential")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *WebauthnCredential) UpsertG(updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(boil.GetDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WebauthnCredential) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("orm: no webauthn_credentials provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webauthnCredentialColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	webauthnCredentialUpsertCacheMut.RLock()
+	cache, cached := webauthnCredentialUpsertCache[key]
+	webauthnCredentialUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			webauthnCredentialAllColumns,
+			webauthnCredentialColumnsWithDefault,
+			webauthnCredentialColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			webauthnCredentialAllColumns,
+			webauthnCredentialPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert webauthn_credentials, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(webauthnCredentialAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(webauthnCredentialPrimaryKeyColumns) == 0 {
+				return errors.New("orm: unable to upsert webauthn_credentials, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(webauthnCredentialPrimaryKeyColumns))
+			copy(conflict, webauthnCredentialPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"webauthn_credentials\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(webauthnCredentialType, webauthnCredentialMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(webauthnCredentialType, webauthnCredentialMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert webauthn_credentials")
+	}
+
+	if !cached {
+		webauthnCredentialUpsertCacheMut.Lock()
+		webauthnCredentialUpsertCache[key] = cache
+		webauthnCredentialUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// DeleteG deletes a single WebauthnCredential record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *WebauthnCredential) DeleteG() (int64, error) {
+	return o.Delete(boil.GetDB())
+}
+
+// Delete deletes a single WebauthnCredential record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WebauthnCredential) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no WebauthnCredential provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), webauthnCredentialPrimaryKeyMapping)
+	sql := "DELETE FROM \"webauthn_credentials\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from webauthn_credentials")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for webauthn_credentials")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q webauthnCredentialQuery) DeleteAllG() (int64, error) {
+	return q.DeleteAll(boil.GetDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q webauthnCredentialQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no webauthnCredentialQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from webauthn_credentials")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for webauthn_credentials")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o WebauthnCredentialSlice) DeleteAllG() (int64, error) {
+	return o.DeleteAll(boil.GetDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WebauthnCredentialSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(webauthnCredentialBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webauthnCredentialPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"webauthn_credentials\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, webauthnCredentialPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from webauthnCredential slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for webauthn_credentials")
+	}
+
+	if len(webauthnCredentialAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *WebauthnCredential) ReloadG() error {
+	if o == nil {
+		return errors.New("orm: no WebauthnCredential provided for reload")
+	}
+
+	return o.Reload(boil.GetDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WebauthnCredential) Reload(exec boil.Executor) error {
+	ret, err := FindWebauthnCredential(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebauthnCredentialSlice) ReloadAllG() error {
+	if o == nil {
+		return errors.New("orm: empty WebauthnCredentialSlice provided for reload all")
+	}
+
+	return o.ReloadAll(boil.GetDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebauthnCredentialSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WebauthnCredentialSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webauthnCredentialPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"webauthn_credentials\".* FROM \"webauthn_credentials\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, webauthnCredentialPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in WebauthnCredentialSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WebauthnCredentialExistsG checks if the WebauthnCredential row exists.
+func WebauthnCredentialExistsG(iD int64) (bool, error) {
+	return WebauthnCredentialExists(boil.GetDB(), iD)
+}
+
+// WebauthnCredentialExists checks if the WebauthnCredential row exists.
+func WebauthnCredentialExists(exec boil.Executor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"webauthn_credentials\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if webauthn_credentials exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the WebauthnCredential row exists.
+func (o *WebauthnCredential) Exists(exec boil.Executor) (bool, error) {
+	return WebauthnCredentialExists(exec, o.ID)
+}
@@ -17,4 +17,8 @@ const (
 	// 图库模块 (1400-1599)
 	ImgErrorStart = 1400
 	ImgErrorEnd   = 1599
+
+	// 权限模块 (1600-1799)
+	RBACErrorStart = 1600
+	RBACErrorEnd   = 1799
 )
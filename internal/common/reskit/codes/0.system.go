@@ -3,4 +3,5 @@ package codes
 // 系统级错误码 (0-999)
 var (
 	ErrAPIForbidden = ErrCode{Msg: "当前接口禁止访问", Type: ErrorTypeForbidden, Code: 1}
+	ErrAccessDenied = ErrCode{Msg: "无权操作该资源", Type: ErrorTypeForbidden, Code: 2}
 )
@@ -0,0 +1,28 @@
+package codes
+
+// 组织模块错误码 (1800-1999)
+var (
+	// 组织相关错误 (1800-1819)
+	ErrOrgNotFound    = ErrCode{Msg: "组织不存在", Type: ErrorTypeNotFound, Code: 1800}
+	ErrOrgSlugExists  = ErrCode{Msg: "组织标识已被使用", Type: ErrorTypeAlreadyExists, Code: 1801}
+	ErrOrgSlugInvalid = ErrCode{Msg: "组织标识只能包含小写字母 数字与连字符", Type: ErrorTypeValidation, Code: 1802}
+
+	// 成员相关错误 (1820-1839)
+	ErrNotOrgMember        = ErrCode{Msg: "不是该组织的成员", Type: ErrorTypeForbidden, Code: 1820}
+	ErrOrgMemberNotFound   = ErrCode{Msg: "组织成员不存在", Type: ErrorTypeNotFound, Code: 1821}
+	ErrOrgRoleInvalid      = ErrCode{Msg: "无效的组织角色", Type: ErrorTypeValidation, Code: 1822}
+	ErrOrgPermissionDenied = ErrCode{Msg: "组织内权限不足", Type: ErrorTypeForbidden, Code: 1823}
+	ErrOrgLastOwner        = ErrCode{Msg: "组织至少需要保留一名所有者", Type: ErrorTypeConflict, Code: 1824}
+	ErrAlreadyOrgMember    = ErrCode{Msg: "已是该组织的成员", Type: ErrorTypeAlreadyExists, Code: 1825}
+
+	// 邀请相关错误 (1840-1859)
+	ErrInvitationNotFound      = ErrCode{Msg: "邀请不存在或已失效", Type: ErrorTypeNotFound, Code: 1840}
+	ErrInvitationEmailMismatch = ErrCode{Msg: "邀请的邮箱与当前账号不一致", Type: ErrorTypeForbidden, Code: 1841}
+
+	// 租户相关错误 (1860-1879)
+	ErrTenantRequired = ErrCode{Msg: "缺少组织标识", Type: ErrorTypeValidation, Code: 1860}
+	ErrTenantInvalid  = ErrCode{Msg: "无效的组织标识", Type: ErrorTypeValidation, Code: 1861}
+
+	// 缓存相关错误 (1880-1899)
+	ErrMembershipCacheMiss = ErrCode{Msg: "成员关系缓存未命中", Type: ErrorTypeCacheMiss, Code: 1880}
+)
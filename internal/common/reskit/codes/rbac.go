@@ -0,0 +1,16 @@
+package codes
+
+// 权限模块错误码 (1600-1799)
+var (
+	// 角色相关错误 (1600-1619)
+	ErrRoleNotFound      = ErrCode{Msg: "角色不存在", Type: ErrorTypeNotFound, Code: 1600}
+	ErrRoleAlreadyExists = ErrCode{Msg: "角色名已存在", Type: ErrorTypeAlreadyExists, Code: 1601}
+
+	// 权限相关错误 (1620-1639)
+	ErrPermissionNotFound      = ErrCode{Msg: "权限不存在", Type: ErrorTypeNotFound, Code: 1620}
+	ErrPermissionAlreadyExists = ErrCode{Msg: "权限码已存在", Type: ErrorTypeAlreadyExists, Code: 1621}
+	ErrPermissionDenied        = ErrCode{Msg: "没有访问权限", Type: ErrorTypeForbidden, Code: 1622}
+
+	// 缓存相关错误 (1640-1659)
+	ErrPermissionCacheMiss = ErrCode{Msg: "权限缓存未命中", Type: ErrorTypeCacheMiss, Code: 1640}
+)
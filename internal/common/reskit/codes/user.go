@@ -7,10 +7,11 @@ import (
 // 用户模块错误码 (1000-1199)
 var (
 	// 基础认证错误 (1000-1019)
-	ErrUnauthorized      = ErrCode{Msg: "未授权访问", Type: ErrorTypeUnauthorized, Code: 1001}
-	ErrUserNotFound      = ErrCode{Msg: "用户不存在", Type: ErrorTypeNotFound, Code: 1002}
-	ErrUserAlreadyExists = ErrCode{Msg: "用户已存在", Type: ErrorTypeAlreadyExists, Code: 1003}
-	ErrUserIDInvalid     = ErrCode{Msg: "用户已存在", Type: ErrorTypeExternal, Code: 1004}
+	ErrUnauthorized       = ErrCode{Msg: "未授权访问", Type: ErrorTypeUnauthorized, Code: 1001}
+	ErrUserNotFound       = ErrCode{Msg: "用户不存在", Type: ErrorTypeNotFound, Code: 1002}
+	ErrUserAlreadyExists  = ErrCode{Msg: "用户已存在", Type: ErrorTypeAlreadyExists, Code: 1003}
+	ErrUserIDInvalid      = ErrCode{Msg: "用户已存在", Type: ErrorTypeExternal, Code: 1004}
+	ErrInvalidCredentials = ErrCode{Msg: "邮箱或密码错误", Type: ErrorTypeUnauthorized, Code: 1005}
 
 	// 用户信息冲突错误 (1020-1039)
 	ErrEmailAlreadyExists    = ErrCode{Msg: "邮箱已被使用", Type: ErrorTypeAlreadyExists, Code: 1020}
@@ -21,12 +22,23 @@ var (
 	ErrOAuthInvalidProvider = ErrCode{Msg: "不支持的OAuth提供商", Type: ErrorTypeValidation, Code: 1041}
 	ErrOAuthUserInfoMissing = ErrCode{Msg: "OAuth用户信息缺失", Type: ErrorTypeValidation, Code: 1042}
 
+	ErrIdentityNotFound        = ErrCode{Msg: "登录身份不存在", Type: ErrorTypeNotFound, Code: 1043}
+	ErrIdentityAlreadyLinked   = ErrCode{Msg: "该第三方账号已绑定其他用户", Type: ErrorTypeConflict, Code: 1044}
+	ErrIdentityProviderLinked  = ErrCode{Msg: "已绑定该提供商的账号", Type: ErrorTypeConflict, Code: 1045}
+	ErrIdentityLastLoginMethod = ErrCode{Msg: "不能移除最后一种登录方式", Type: ErrorTypeForbidden, Code: 1046}
+	ErrOAuthStateInvalid       = ErrCode{Msg: "OAuth state无效或已过期", Type: ErrorTypeValidation, Code: 1047}
+	ErrOAuthEmailUnverified    = ErrCode{Msg: "该邮箱已注册但未验证 请先登录原账号后再绑定", Type: ErrorTypeConflict, Code: 1048}
+
 	// Token相关错误 (1060-1079)
 	ErrTokenGenerationFailed = ErrCode{Msg: "Token生成失败", Type: ErrorTypeInternal, Code: 1060}
 	ErrTokenInvalid          = ErrCode{Msg: "Token无效", Type: ErrorTypeUnauthorized, Code: 1061}
 	ErrTokenFormatInvalid    = ErrCode{Msg: "Token格式无效", Type: ErrorTypeValidation, Code: 1062}
 	ErrTokenExpired          = ErrCode{Msg: "Token已过期", Type: ErrorTypeUnauthorized, Code: 1063}
 
+	ErrSessionNotFound    = ErrCode{Msg: "会话不存在", Type: ErrorTypeNotFound, Code: 1064}
+	ErrRefreshTokenReused = ErrCode{Msg: "登录凭证已失效 请重新登录", Type: ErrorTypeUnauthorized, Code: 1065}
+	ErrTokenRevoked       = ErrCode{Msg: "Token已被吊销", Type: ErrorTypeUnauthorized, Code: 1066}
+
 	ErrRefreshTokenMissingInHeader = ErrCode{Msg: "请求头中缺少RefreshToken参数", Type: ErrorTypeValidation, Code: 1070}
 	ErrRefreshTokenNotFound        = ErrCode{
 		Msg: "登录凭证过期", Type: ErrorTypeUnauthorized, Code: 1071,
@@ -37,4 +49,77 @@ var (
 	// 外部服务错误 (1080-1099)
 	ErrGitHubAPIError = ErrCode{Msg: "GitHub API调用失败", Type: ErrorTypeExternal, Code: 1080}
 	ErrGoogleAPIError = ErrCode{Msg: "Google API调用失败", Type: ErrorTypeExternal, Code: 1081}
+	ErrOIDCAPIError   = ErrCode{Msg: "OIDC API调用失败", Type: ErrorTypeExternal, Code: 1082}
+
+	// 邮箱验证相关错误 (1100-1109)
+	ErrEmailNotVerified        = ErrCode{Msg: "邮箱未验证", Type: ErrorTypeForbidden, Code: 1100}
+	ErrEmailVerifyTokenInvalid = ErrCode{Msg: "邮箱验证链接无效或已过期", Type: ErrorTypeValidation, Code: 1101}
+	ErrEmailAlreadyVerified    = ErrCode{Msg: "邮箱已验证", Type: ErrorTypeConflict, Code: 1102}
+	ErrEmailVerifyTooFrequent  = ErrCode{Msg: "验证邮件发送过于频繁", Type: ErrorTypeRateLimit, Code: 1103}
+	ErrEmailSendFailed         = ErrCode{Msg: "邮件发送失败", Type: ErrorTypeExternal, Code: 1104}
+	ErrEmailNotBound           = ErrCode{Msg: "未绑定邮箱", Type: ErrorTypeValidation, Code: 1105}
+
+	// 密码相关错误 (1110-1119)
+	ErrPasswordResetTokenInvalid = ErrCode{Msg: "密码重置链接无效或已过期", Type: ErrorTypeValidation, Code: 1110}
+	ErrPasswordIncorrect         = ErrCode{Msg: "当前密码错误", Type: ErrorTypeValidation, Code: 1111}
+
+	// 两步验证相关错误 (1120-1129)
+	ErrMFANotEnabled      = ErrCode{Msg: "未开启两步验证", Type: ErrorTypeConflict, Code: 1120}
+	ErrMFAAlreadyEnabled  = ErrCode{Msg: "已开启两步验证", Type: ErrorTypeConflict, Code: 1121}
+	ErrMFANotEnrolled     = ErrCode{Msg: "请先获取两步验证绑定信息", Type: ErrorTypeValidation, Code: 1122}
+	ErrMFACodeInvalid     = ErrCode{Msg: "两步验证码错误", Type: ErrorTypeValidation, Code: 1123}
+	ErrMFATokenInvalid    = ErrCode{Msg: "两步验证已过期 请重新登录", Type: ErrorTypeUnauthorized, Code: 1124}
+	ErrMFATooManyAttempts = ErrCode{Msg: "两步验证失败次数过多 请重新登录", Type: ErrorTypeRateLimit, Code: 1125}
+	ErrMFALocked          = ErrCode{Msg: "两步验证失败次数过多 请稍后再试", Type: ErrorTypeRateLimit, Code: 1126}
+
+	// 通行密钥相关错误 (1130-1139)
+	ErrPasskeyNotFound          = ErrCode{Msg: "通行密钥不存在", Type: ErrorTypeNotFound, Code: 1130}
+	ErrPasskeyCeremonyInvalid   = ErrCode{Msg: "通行密钥验证已过期 请重新发起", Type: ErrorTypeValidation, Code: 1131}
+	ErrPasskeyVerifyFailed      = ErrCode{Msg: "通行密钥验证失败", Type: ErrorTypeUnauthorized, Code: 1132}
+	ErrPasskeyAlreadyRegistered = ErrCode{Msg: "该通行密钥已注册", Type: ErrorTypeConflict, Code: 1133}
+	ErrPasskeyLimitExceeded     = ErrCode{Msg: "通行密钥数量已达上限", Type: ErrorTypeValidation, Code: 1134}
+	ErrPasskeyCloneDetected     = ErrCode{Msg: "通行密钥签名计数异常 请联系管理员", Type: ErrorTypeUnauthorized, Code: 1135}
+
+	// 无密码登录相关错误 (1140-1149)
+	ErrMagicLinkInvalid         = ErrCode{Msg: "登录链接无效或已过期", Type: ErrorTypeValidation, Code: 1140}
+	ErrEmailOTPInvalid          = ErrCode{Msg: "邮箱验证码错误", Type: ErrorTypeValidation, Code: 1141}
+	ErrMagicLinkTooManyAttempts = ErrCode{Msg: "验证码错误次数过多 请重新发送", Type: ErrorTypeRateLimit, Code: 1142}
+	ErrMagicLinkTooFrequent     = ErrCode{Msg: "登录邮件发送过于频繁", Type: ErrorTypeRateLimit, Code: 1143}
+
+	// 短信验证码相关错误 (1150-1159)
+	ErrSmsCodeInvalid         = ErrCode{Msg: "短信验证码错误或已过期", Type: ErrorTypeValidation, Code: 1150}
+	ErrSmsCodeTooManyAttempts = ErrCode{Msg: "验证码错误次数过多 请重新发送", Type: ErrorTypeRateLimit, Code: 1151}
+	ErrSmsTooFrequent         = ErrCode{Msg: "短信发送过于频繁", Type: ErrorTypeRateLimit, Code: 1152}
+	ErrSmsSendFailed          = ErrCode{Msg: "短信发送失败", Type: ErrorTypeExternal, Code: 1153}
+
+	// 登录防护相关错误 (1160-1169)
+	ErrAccountLocked    = ErrCode{Msg: "登录失败次数过多 账号已临时锁定", Type: ErrorTypeRateLimit, Code: 1160}
+	ErrLoginTooFrequent = ErrCode{Msg: "登录尝试过于频繁 请稍后再试", Type: ErrorTypeRateLimit, Code: 1161}
+	ErrTooManyRequests  = ErrCode{Msg: "请求过于频繁 请稍后再试", Type: ErrorTypeRateLimit, Code: 1162}
+
+	// 个人资料相关错误 (1170-1179)
+	ErrAvatarTooLarge          = ErrCode{Msg: "头像文件过大", Type: ErrorTypeValidation, Code: 1170}
+	ErrAvatarTypeInvalid       = ErrCode{Msg: "头像仅支持PNG、JPEG、GIF、WebP格式", Type: ErrorTypeValidation, Code: 1171}
+	ErrNicknameInvalid         = ErrCode{Msg: "昵称不能为空", Type: ErrorTypeValidation, Code: 1172}
+	ErrEmailUnchanged          = ErrCode{Msg: "新邮箱与当前邮箱相同", Type: ErrorTypeValidation, Code: 1173}
+	ErrEmailChangeTokenInvalid = ErrCode{Msg: "邮箱更换链接无效或已过期", Type: ErrorTypeValidation, Code: 1174}
+	ErrEmailChangeTooFrequent  = ErrCode{Msg: "邮箱更换邮件发送过于频繁", Type: ErrorTypeRateLimit, Code: 1175}
+
+	ErrAccountDeletionScheduled    = ErrCode{Msg: "账号已申请注销", Type: ErrorTypeConflict, Code: 1176}
+	ErrAccountDeletionNotScheduled = ErrCode{Msg: "账号未申请注销", Type: ErrorTypeConflict, Code: 1177}
+
+	// API Key相关错误 (1180-1189)
+	ErrAPIKeyNotFound      = ErrCode{Msg: "API Key不存在", Type: ErrorTypeNotFound, Code: 1180}
+	ErrAPIKeyInvalid       = ErrCode{Msg: "API Key无效", Type: ErrorTypeUnauthorized, Code: 1181}
+	ErrAPIKeyExpired       = ErrCode{Msg: "API Key已过期", Type: ErrorTypeUnauthorized, Code: 1182}
+	ErrAPIKeyLimitExceeded = ErrCode{Msg: "API Key数量已达上限", Type: ErrorTypeValidation, Code: 1183}
+	ErrAPIKeyNotAllowed    = ErrCode{Msg: "该接口不支持使用API Key访问", Type: ErrorTypeForbidden, Code: 1184}
+	ErrAPIKeyScopeDenied   = ErrCode{Msg: "API Key的权限范围不足", Type: ErrorTypeForbidden, Code: 1185}
+
+	// 用户管理相关错误 (1190-1199)
+	ErrUserDisabled                = ErrCode{Msg: "账号已被禁用", Type: ErrorTypeForbidden, Code: 1190}
+	ErrCannotDisableSelf           = ErrCode{Msg: "不能禁用自己的账号", Type: ErrorTypeValidation, Code: 1191}
+	ErrImpersonationNotAllowed     = ErrCode{Msg: "模拟登录不能执行该操作", Type: ErrorTypeForbidden, Code: 1192}
+	ErrCannotImpersonateSelf       = ErrCode{Msg: "不能模拟自己的账号", Type: ErrorTypeValidation, Code: 1193}
+	ErrCannotImpersonatePrivileged = ErrCode{Msg: "不能模拟拥有用户管理权限或权限超出自己的用户", Type: ErrorTypeForbidden, Code: 1194}
 )
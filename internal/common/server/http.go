@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"scaffold/internal/common/jwt"
 	"scaffold/internal/common/metrics"
 	"scaffold/internal/common/utils"
 	"scaffold/internal/common/validator"
@@ -36,6 +38,9 @@ func RunHttpServer(port string, metricsClient metrics.Client, registerRouter fun
 
 	engine := gin.Default()
 
+	// 配置可信代理 决定 ClientIP 是否采信 X-Forwarded-For
+	setTrustedProxies(engine)
+
 	engine.Use(errorHandler(), logHandler(), metricsHandler(metricsClient))
 
 	// 注册验证器
@@ -51,6 +56,12 @@ func RunHttpServer(port string, metricsClient metrics.Client, registerRouter fun
 		c.JSONP(404, gin.H{"msg": "404"})
 	})
 
+	// 公开JWKS 下游服务无需共享密钥即可校验令牌 按约定路径挂载在根路由
+	engine.GET("/.well-known/jwks.json", func(c *gin.Context) {
+		c.Header("Cache-Control", "public, max-age=300")
+		c.JSON(http.StatusOK, jwt.DefaultKeyring().JWKS())
+	})
+
 	routerGroup := engine.Group("/api")
 
 	registerRouter(routerGroup)
@@ -78,7 +89,11 @@ func RunHttpServer(port string, metricsClient metrics.Client, registerRouter fun
 
 	if len(clearFunc) > 0 {
 		log.Println("正在执行资源清理")
-		clearFunc[0]()
+		for _, cleanup := range clearFunc {
+			if cleanup != nil {
+				cleanup()
+			}
+		}
 	}
 
 	// 优雅关闭服务
@@ -102,13 +117,55 @@ func shutdownServer(server *http.Server) {
 	log.Println("服务器已退出")
 }
 
+// setTrustedProxies 默认不信任任何代理 ClientIP 取连接的对端地址
+// 部署在反向代理后时 通过 TRUSTED_PROXIES 配置代理的 IP 或 CIDR 逗号分隔
+func setTrustedProxies(r *gin.Engine) {
+	var proxies []string
+	for _, proxy := range strings.Split(utils.GetEnvWithDefault("TRUSTED_PROXIES", ""), ",") {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			proxies = append(proxies, proxy)
+		}
+	}
+
+	if err := r.SetTrustedProxies(proxies); err != nil {
+		panic(errors.WithMessage(err, "TRUSTED_PROXIES配置无效"))
+	}
+	trustedProxies = parseTrustedProxies(proxies)
+}
+
+// parseTrustedProxies 单个 IP 视为只包含自身的网段 格式已由 SetTrustedProxies 校验
+func parseTrustedProxies(proxies []string) []*net.IPNet {
+	nets := make([]*net.IPNet, 0, len(proxies))
+	for _, proxy := range proxies {
+		if !strings.Contains(proxy, "/") {
+			if ip := net.ParseIP(proxy); ip != nil && ip.To4() != nil {
+				proxy += "/32"
+			} else {
+				proxy += "/128"
+			}
+		}
+		if _, ipNet, err := net.ParseCIDR(proxy); err == nil {
+			nets = append(nets, ipNet)
+		}
+	}
+	return nets
+}
+
 func setCORS(r *gin.Engine) {
 	corsCfg := cors.DefaultConfig()
 	allowsStr := utils.GetEnv("SERVER_ALLOW_ORIGINS")
 	allows := strings.Split(allowsStr, ",")
+	// 允许携带 Cookie 时浏览器拒绝通配的来源 需逐个配置
+	for _, origin := range allows {
+		if strings.TrimSpace(origin) == "*" {
+			panic(errors.New("SERVER_ALLOW_ORIGINS不能为* 请配置具体的前端来源"))
+		}
+	}
 
 	corsCfg.AllowOrigins = allows
 	corsCfg.AllowMethods = []string{"GET", "POST", "PUT", "DELETE", "PATCH"}
-	corsCfg.AllowHeaders = []string{"Origin", "Content-Type", "Authorization", "X-Refresh-Token"}
+	corsCfg.AllowHeaders = []string{"Origin", "Content-Type", "Authorization", "X-Refresh-Token", "X-Device-Name", "X-API-Key"}
+	// 第三方登录回调需携带 oauth_nonce Cookie
+	corsCfg.AllowCredentials = true
 	r.Use(cors.New(corsCfg))
 }
@@ -1,12 +1,46 @@
 package server
 
 import (
+	"net"
 	"scaffold/internal/common/reskit/codes"
 
 	"github.com/gin-gonic/gin"
 )
 
-const UserIDKey = "user_id"
+const (
+	UserIDKey    = "user_id"
+	SessionIDKey = "session_id"
+	// ActorIDKey 模拟登录时实际操作的管理员ID
+	ActorIDKey = "actor_id"
+	// TenantIDKey 与 TenantRoleKey 由租户中间件写入 分别为当前组织ID与用户在其中的角色
+	TenantIDKey   = "tenant_id"
+	TenantRoleKey = "tenant_role"
+)
+
+// trustedProxies 由 setTrustedProxies 根据 TRUSTED_PROXIES 写入 为空时不信任任何代理
+var trustedProxies []*net.IPNet
+
+// IsHTTPS 判断客户端是否通过 HTTPS 访问
+// 仅当连接的对端是可信代理时才采信 X-Forwarded-Proto 否则客户端可自行伪造
+func IsHTTPS(ctx *gin.Context) bool {
+	if ctx.Request.TLS != nil {
+		return true
+	}
+	return ctx.GetHeader("X-Forwarded-Proto") == "https" && isTrustedProxy(ctx.RemoteIP())
+}
+
+func isTrustedProxy(remoteIP string) bool {
+	ip := net.ParseIP(remoteIP)
+	if ip == nil {
+		return false
+	}
+	for _, ipNet := range trustedProxies {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
 
 func GetUserID(ctx *gin.Context) (int64, error) {
 	uidStr, exist := ctx.Get(UserIDKey)
@@ -20,4 +54,29 @@ func GetUserID(ctx *gin.Context) (int64, error) {
 	}
 
 	return userID, nil
-}
\ No newline at end of file
+}
+
+// GetSessionID 获取当前access token所属的会话ID 不存在时返回空字符串
+func GetSessionID(ctx *gin.Context) string {
+	return ctx.GetString(SessionIDKey)
+}
+
+// GetActorID 模拟登录时返回实际操作的管理员ID 此时 GetUserID 返回被模拟的用户
+func GetActorID(ctx *gin.Context) (int64, bool) {
+	actorID := ctx.GetInt64(ActorIDKey)
+	return actorID, actorID != 0
+}
+
+// GetTenantID 获取租户中间件解析出的当前组织ID 仓储据此限定查询范围
+func GetTenantID(ctx *gin.Context) (int64, error) {
+	tenantID := ctx.GetInt64(TenantIDKey)
+	if tenantID == 0 {
+		return 0, codes.ErrTenantRequired
+	}
+	return tenantID, nil
+}
+
+// GetTenantRole 获取当前用户在组织中的角色 未经过租户中间件时返回空字符串
+func GetTenantRole(ctx *gin.Context) string {
+	return ctx.GetString(TenantRoleKey)
+}
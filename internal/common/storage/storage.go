@@ -0,0 +1,46 @@
+package storage
+
+import (
+	"io"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"scaffold/internal/common/utils"
+)
+
+const (
+	DriverLocal = "local"
+
+	// localRoutePath 本地存储文件的访问路径 挂载在 /api 路由组下
+	localRoutePath = "/uploads"
+)
+
+// Storage 文件存储 key 为以 / 分隔的相对路径 由调用方保证唯一
+type Storage interface {
+	// Put 保存文件 返回可公开访问的地址
+	Put(key string, r io.Reader, contentType string) (url string, err error)
+	// Remove 删除 url 对应的文件 不属于该存储的地址直接忽略
+	Remove(url string) error
+}
+
+// NewStorage 根据 STORAGE_DRIVER 选择存储 默认保存到本地目录
+func NewStorage() Storage {
+	switch driver := utils.GetEnvWithDefault("STORAGE_DRIVER", DriverLocal); driver {
+	case DriverLocal:
+		return NewLocalStorage(
+			utils.GetEnvWithDefault("STORAGE_LOCAL_DIR", "./uploads"),
+			utils.GetEnvWithDefault("STORAGE_BASE_URL", "/api"+localRoutePath),
+		)
+	default:
+		panic(errors.Errorf("不支持的存储类型: %s", driver))
+	}
+}
+
+// RegisterStatic 使用本地存储时由服务自身提供文件访问
+func RegisterStatic(r *gin.RouterGroup) {
+	if utils.GetEnvWithDefault("STORAGE_DRIVER", DriverLocal) != DriverLocal {
+		return
+	}
+	r.Static(localRoutePath, utils.GetEnvWithDefault("STORAGE_LOCAL_DIR", "./uploads"))
+}
@@ -0,0 +1,31 @@
+package testkit
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aarondl/sqlboiler/v4/boil"
+)
+
+// MockDB 以 sqlmock 代替 sqlboiler 的全局数据库 按顺序校验仓储执行的语句 测试结束后恢复
+func MockDB(t *testing.T) sqlmock.Sqlmock {
+	t.Helper()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	previous := boil.GetDB()
+	boil.SetDB(db)
+	t.Cleanup(func() {
+		boil.SetDB(previous)
+		_ = db.Close()
+	})
+	return mock
+}
+
+// QuoteSQL sqlmock 默认按正则匹配语句 转义后按原文匹配
+func QuoteSQL(query string) string {
+	return regexp.QuoteMeta(query)
+}
@@ -0,0 +1,38 @@
+// Package testkit 各模块测试共用的基础设施替身 只应在 _test.go 中引用
+package testkit
+
+import (
+	"os"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+)
+
+const redisPassword = "scaffold-test"
+
+// RunWithRedis 供 TestMain 调用 以 miniredis 代替 Redis 服务运行整个包的测试
+// 缓存共享进程内唯一的 Redis 连接 因此每个包只启动一个实例 各测试需使用不同的键互不影响
+func RunWithRedis(m *testing.M) {
+	server, err := miniredis.Run()
+	if err != nil {
+		panic(err)
+	}
+	server.RequireAuth(redisPassword)
+
+	env := map[string]string{
+		"REDIS_HOST":      server.Host(),
+		"REDIS_PORT":      server.Port(),
+		"REDIS_PASSWORD":  redisPassword,
+		"REDIS_DB":        "0",
+		"REDIS_POOL_SIZE": "10",
+	}
+	for key, val := range env {
+		if err := os.Setenv(key, val); err != nil {
+			panic(err)
+		}
+	}
+
+	code := m.Run()
+	server.Close()
+	os.Exit(code)
+}
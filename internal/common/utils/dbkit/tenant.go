@@ -0,0 +1,13 @@
+package dbkit
+
+import (
+	"fmt"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// TenantScope 将查询限定在给定租户内 column 为表中的组织ID列 如 orm.XxxColumns.OrganizationID
+// 租户ID通常由 tenant.Resolve 中间件写入上下文 handler 通过 server.GetTenantID 读取后传给仓储
+func TenantScope(column string, tenantID int64) qm.QueryMod {
+	return qm.Where(fmt.Sprintf("%s = ?", column), tenantID)
+}
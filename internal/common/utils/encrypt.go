@@ -4,6 +4,7 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
 	"io"
@@ -16,6 +17,11 @@ func EncryptPassword(password string) ([]byte, error) {
 	return bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 }
 
+// ComparePassword 校验明文密码与bcrypt哈希是否匹配
+func ComparePassword(hashedPassword, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password)) == nil
+}
+
 func GenRandomHexToken() (string, error) {
 	bytes := make([]byte, 64) // 64 bytes = 512 bits
 	if _, err := rand.Read(bytes); err != nil {
@@ -24,6 +30,27 @@ func GenRandomHexToken() (string, error) {
 	return hex.EncodeToString(bytes), nil
 }
 
+// GenRandomHex 生成 n 字节随机数的十六进制字符串 用作不可猜测的ID
+func GenRandomHex(n int) (string, error) {
+	bytes := make([]byte, n)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
+
+// HashToken 对一次性令牌做sha256摘要 缓存与数据库中只保存摘要
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// PKCECodeChallenge 按 RFC 7636 S256 方式计算 code_challenge
+func PKCECodeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
 type AES256Encryptor struct {
 	key []byte
 }
@@ -25,3 +25,12 @@ func GetEnvAsInt(key string) int {
 	}
 	return intVal
 }
+
+// GetEnvWithDefault 用于可选配置 未设置时返回默认值
+func GetEnvWithDefault(key string, defaultVal string) string {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	return val
+}
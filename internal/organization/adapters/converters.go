@@ -0,0 +1,150 @@
+package adapters
+
+import (
+	"github.com/aarondl/null/v8"
+	"scaffold/internal/common/orm"
+	"scaffold/internal/organization/domain"
+)
+
+func domainOrganizationToORM(org *domain.Organization) *orm.Organization {
+	if org == nil {
+		return nil
+	}
+
+	return &orm.Organization{
+		ID:        org.ID,
+		Name:      org.Name,
+		Slug:      org.Slug,
+		CreatedAt: org.CreatedAt,
+		UpdatedAt: org.UpdatedAt,
+	}
+}
+
+func ormOrganizationToDomain(ormOrg *orm.Organization) *domain.Organization {
+	if ormOrg == nil {
+		return nil
+	}
+
+	return &domain.Organization{
+		ID:        ormOrg.ID,
+		Name:      ormOrg.Name,
+		Slug:      ormOrg.Slug,
+		CreatedAt: ormOrg.CreatedAt,
+		UpdatedAt: ormOrg.UpdatedAt,
+	}
+}
+
+func ormMemberToMembership(ormMember *orm.OrganizationMember) *domain.Membership {
+	if ormMember == nil {
+		return nil
+	}
+
+	return &domain.Membership{
+		OrganizationID: ormMember.OrganizationID,
+		UserID:         ormMember.UserID,
+		Role:           domain.Role(ormMember.Role),
+	}
+}
+
+func ormMemberToDomain(ormMember *orm.OrganizationMember) *domain.Member {
+	if ormMember == nil {
+		return nil
+	}
+
+	member := &domain.Member{
+		UserID:    ormMember.UserID,
+		Role:      domain.Role(ormMember.Role),
+		CreatedAt: ormMember.CreatedAt,
+	}
+
+	// 预加载了用户时一并转换展示信息
+	if ormMember.R != nil && ormMember.R.User != nil {
+		member.Nickname = ormMember.R.User.Nickname
+		member.Email = ormMember.R.User.Email.String
+		member.Avatar = ormMember.R.User.Avatar.String
+	}
+
+	return member
+}
+
+func ormMembersToDomain(ormMembers []*orm.OrganizationMember) []*domain.Member {
+	members := make([]*domain.Member, 0, len(ormMembers))
+	for _, ormMember := range ormMembers {
+		if ormMember != nil {
+			members = append(members, ormMemberToDomain(ormMember))
+		}
+	}
+	return members
+}
+
+func domainInvitationToORM(invitation *domain.Invitation) *orm.OrganizationInvitation {
+	if invitation == nil {
+		return nil
+	}
+
+	ormInvitation := &orm.OrganizationInvitation{
+		ID:             invitation.ID,
+		OrganizationID: invitation.OrganizationID,
+		Email:          invitation.Email,
+		Role:           string(invitation.Role),
+		TokenHash:      invitation.TokenHash,
+		InviterID:      invitation.InviterID,
+		Status:         string(invitation.Status),
+		ExpiresAt:      invitation.ExpiresAt,
+		CreatedAt:      invitation.CreatedAt,
+	}
+
+	if !invitation.RespondedAt.IsZero() {
+		ormInvitation.RespondedAt = null.TimeFrom(invitation.RespondedAt)
+	}
+
+	return ormInvitation
+}
+
+func ormInvitationToDomain(ormInvitation *orm.OrganizationInvitation) *domain.Invitation {
+	if ormInvitation == nil {
+		return nil
+	}
+
+	invitation := &domain.Invitation{
+		ID:             ormInvitation.ID,
+		OrganizationID: ormInvitation.OrganizationID,
+		Email:          ormInvitation.Email,
+		Role:           domain.Role(ormInvitation.Role),
+		TokenHash:      ormInvitation.TokenHash,
+		InviterID:      ormInvitation.InviterID,
+		Status:         domain.InvitationStatus(ormInvitation.Status),
+		ExpiresAt:      ormInvitation.ExpiresAt,
+		RespondedAt:    ormInvitation.RespondedAt.Time,
+		CreatedAt:      ormInvitation.CreatedAt,
+	}
+
+	if ormInvitation.R != nil && ormInvitation.R.Organization != nil {
+		invitation.Organization = ormOrganizationToDomain(ormInvitation.R.Organization)
+	}
+
+	return invitation
+}
+
+func ormInvitationsToDomain(ormInvitations []*orm.OrganizationInvitation) []*domain.Invitation {
+	invitations := make([]*domain.Invitation, 0, len(ormInvitations))
+	for _, ormInvitation := range ormInvitations {
+		if ormInvitation != nil {
+			invitations = append(invitations, ormInvitationToDomain(ormInvitation))
+		}
+	}
+	return invitations
+}
+
+func ormUserToProfile(ormUser *orm.User) *domain.UserProfile {
+	if ormUser == nil {
+		return nil
+	}
+
+	return &domain.UserProfile{
+		ID:              ormUser.ID,
+		Nickname:        ormUser.Nickname,
+		Email:           ormUser.Email.String,
+		EmailVerifiedAt: ormUser.EmailVerifiedAt.Time,
+	}
+}
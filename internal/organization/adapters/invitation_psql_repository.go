@@ -0,0 +1,148 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/pkg/errors"
+	"scaffold/internal/common/orm"
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/utils/dbkit"
+	"scaffold/internal/organization/domain"
+	"time"
+)
+
+type InvitationPSQLRepository struct {
+}
+
+func NewInvitationPSQLRepository() domain.InvitationRepository {
+	return &InvitationPSQLRepository{}
+}
+
+func invitationTenantScope(orgID int64) qm.QueryMod {
+	return dbkit.TenantScope(orm.OrganizationInvitationColumns.OrganizationID, orgID)
+}
+
+func (repo *InvitationPSQLRepository) FindByTokenHash(tokenHash string) (*domain.Invitation, error) {
+	ormInvitation, err := orm.OrganizationInvitations(
+		orm.OrganizationInvitationWhere.TokenHash.EQ(tokenHash),
+		qm.Load(orm.OrganizationInvitationRels.Organization),
+	).OneG()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrInvitationNotFound
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return ormInvitationToDomain(ormInvitation), nil
+}
+
+func (repo *InvitationPSQLRepository) Create(invitation *domain.Invitation) (*domain.Invitation, error) {
+	ormInvitation := domainInvitationToORM(invitation)
+
+	if err := ormInvitation.InsertG(boil.Infer()); err != nil {
+		return nil, fmt.Errorf("failed to create invitation: %w", err)
+	}
+
+	return ormInvitationToDomain(ormInvitation), nil
+}
+
+func (repo *InvitationPSQLRepository) ListPending(orgID int64) ([]*domain.Invitation, error) {
+	ormInvitations, err := orm.OrganizationInvitations(
+		invitationTenantScope(orgID),
+		orm.OrganizationInvitationWhere.Status.EQ(string(domain.InvitationPending)),
+		orm.OrganizationInvitationWhere.ExpiresAt.GT(time.Now()),
+		qm.OrderBy(orm.OrganizationInvitationColumns.CreatedAt+" DESC"),
+	).AllG()
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return ormInvitationsToDomain(ormInvitations), nil
+}
+
+func (repo *InvitationPSQLRepository) ListPendingByEmail(email string) ([]*domain.Invitation, error) {
+	ormInvitations, err := orm.OrganizationInvitations(
+		orm.OrganizationInvitationWhere.Email.EQ(email),
+		orm.OrganizationInvitationWhere.Status.EQ(string(domain.InvitationPending)),
+		orm.OrganizationInvitationWhere.ExpiresAt.GT(time.Now()),
+		qm.Load(orm.OrganizationInvitationRels.Organization),
+		qm.OrderBy(orm.OrganizationInvitationColumns.CreatedAt+" DESC"),
+	).AllG()
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return ormInvitationsToDomain(ormInvitations), nil
+}
+
+func (repo *InvitationPSQLRepository) RevokePending(orgID int64, email string) error {
+	_, err := orm.OrganizationInvitations(
+		invitationTenantScope(orgID),
+		orm.OrganizationInvitationWhere.Email.EQ(email),
+		orm.OrganizationInvitationWhere.Status.EQ(string(domain.InvitationPending)),
+	).UpdateAllG(orm.M{
+		orm.OrganizationInvitationColumns.Status:      string(domain.InvitationRevoked),
+		orm.OrganizationInvitationColumns.RespondedAt: null.TimeFrom(time.Now()),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to revoke invitations: %w", err)
+	}
+	return nil
+}
+
+func (repo *InvitationPSQLRepository) Respond(orgID, id int64, status domain.InvitationStatus) error {
+	rows, err := orm.OrganizationInvitations(
+		invitationTenantScope(orgID),
+		orm.OrganizationInvitationWhere.ID.EQ(id),
+		orm.OrganizationInvitationWhere.Status.EQ(string(domain.InvitationPending)),
+	).UpdateAllG(orm.M{
+		orm.OrganizationInvitationColumns.Status:      string(status),
+		orm.OrganizationInvitationColumns.RespondedAt: null.TimeFrom(time.Now()),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update invitation: %w", err)
+	}
+	if rows == 0 {
+		return codes.ErrInvitationNotFound
+	}
+	return nil
+}
+
+func (repo *InvitationPSQLRepository) Accept(invitation *domain.Invitation, userID int64) error {
+	tx, err := boil.BeginTx(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// 仅更新仍处于待处理状态的邀请 防止同一邀请被并发接受
+	rows, err := orm.OrganizationInvitations(
+		orm.OrganizationInvitationWhere.ID.EQ(invitation.ID),
+		orm.OrganizationInvitationWhere.Status.EQ(string(domain.InvitationPending)),
+	).UpdateAll(tx, orm.M{
+		orm.OrganizationInvitationColumns.Status:      string(domain.InvitationAccepted),
+		orm.OrganizationInvitationColumns.RespondedAt: null.TimeFrom(time.Now()),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to accept invitation: %w", err)
+	}
+	if rows == 0 {
+		return codes.ErrInvitationNotFound
+	}
+
+	member := &orm.OrganizationMember{
+		OrganizationID: invitation.OrganizationID,
+		UserID:         userID,
+		Role:           string(invitation.Role),
+	}
+	if err := member.Insert(tx, boil.Infer()); err != nil {
+		return fmt.Errorf("failed to add organization member: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
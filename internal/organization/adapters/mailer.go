@@ -0,0 +1,45 @@
+package adapters
+
+import (
+	"embed"
+	"html/template"
+
+	"github.com/pkg/errors"
+
+	"scaffold/internal/common/email"
+	"scaffold/internal/organization/domain"
+)
+
+//go:embed templates/*.html
+var templateFS embed.FS
+
+const templateInvitation = "org_invitation.html"
+
+type InvitationEmailMailer struct {
+	mailer email.Mailer
+}
+
+func NewInvitationMailer() domain.InvitationMailer {
+	templates := template.Must(template.ParseFS(templateFS, "templates/*.html"))
+
+	templatesMap := make(map[string]*template.Template)
+	for _, tmpl := range templates.Templates() {
+		templatesMap[tmpl.Name()] = tmpl
+	}
+
+	return &InvitationEmailMailer{mailer: email.NewMailer(templatesMap)}
+}
+
+func (m *InvitationEmailMailer) SendInvitation(to, inviterName, orgName, link string) error {
+	data := map[string]any{
+		"Inviter":    inviterName,
+		"OrgName":    orgName,
+		"Link":       link,
+		"ExpireDays": int(domain.InvitationExpire.Hours() / 24),
+	}
+
+	if err := m.mailer.SendWithTemplate(to, "邀请你加入 "+orgName, templateInvitation, data); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/pkg/errors"
+	"scaffold/internal/common/orm"
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/utils/dbkit"
+	"scaffold/internal/organization/domain"
+	"time"
+)
+
+type MemberPSQLRepository struct {
+}
+
+func NewMemberPSQLRepository() domain.MemberRepository {
+	return &MemberPSQLRepository{}
+}
+
+// memberTenantScope 成员查询均限定在当前组织内
+func memberTenantScope(orgID int64) qm.QueryMod {
+	return dbkit.TenantScope(orm.OrganizationMemberColumns.OrganizationID, orgID)
+}
+
+func (repo *MemberPSQLRepository) FindMembership(orgID, userID int64) (*domain.Membership, error) {
+	ormMember, err := orm.OrganizationMembers(
+		memberTenantScope(orgID),
+		orm.OrganizationMemberWhere.UserID.EQ(userID),
+	).OneG()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrOrgMemberNotFound
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return ormMemberToMembership(ormMember), nil
+}
+
+func (repo *MemberPSQLRepository) List(orgID int64) ([]*domain.Member, error) {
+	ormMembers, err := orm.OrganizationMembers(
+		memberTenantScope(orgID),
+		qm.Load(orm.OrganizationMemberRels.User),
+		qm.OrderBy(orm.OrganizationMemberColumns.CreatedAt+" ASC"),
+	).AllG()
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return ormMembersToDomain(ormMembers), nil
+}
+
+func (repo *MemberPSQLRepository) ListUserIDs(orgID int64) ([]int64, error) {
+	ormMembers, err := orm.OrganizationMembers(
+		qm.Select(orm.OrganizationMemberColumns.UserID),
+		memberTenantScope(orgID),
+	).AllG()
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	userIDs := make([]int64, 0, len(ormMembers))
+	for _, m := range ormMembers {
+		userIDs = append(userIDs, m.UserID)
+	}
+	return userIDs, nil
+}
+
+// UpdateRole 与 Remove 在同一事务中锁定组织的全部所有者后再变更
+// 并发的降级或移除因此依次执行 不会同时移走最后两名所有者
+func (repo *MemberPSQLRepository) UpdateRole(orgID, userID int64, role domain.Role) error {
+	tx, err := boil.BeginTx(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if role != domain.RoleOwner {
+		if err := ensureNotLastOwner(tx, orgID, userID); err != nil {
+			return err
+		}
+	}
+
+	rows, err := orm.OrganizationMembers(
+		memberTenantScope(orgID),
+		orm.OrganizationMemberWhere.UserID.EQ(userID),
+	).UpdateAll(tx, orm.M{
+		orm.OrganizationMemberColumns.Role:      string(role),
+		orm.OrganizationMemberColumns.UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update member role: %w", err)
+	}
+	if rows == 0 {
+		return codes.ErrOrgMemberNotFound
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (repo *MemberPSQLRepository) Remove(orgID, userID int64) error {
+	tx, err := boil.BeginTx(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := ensureNotLastOwner(tx, orgID, userID); err != nil {
+		return err
+	}
+
+	rows, err := orm.OrganizationMembers(
+		memberTenantScope(orgID),
+		orm.OrganizationMemberWhere.UserID.EQ(userID),
+	).DeleteAll(tx)
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	if rows == 0 {
+		return codes.ErrOrgMemberNotFound
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// ensureNotLastOwner 用户是组织唯一的所有者时返回 ErrOrgLastOwner
+// 所有者行加锁直到事务结束 聚合查询不能加行锁 因此查出行后计数
+func ensureNotLastOwner(exec boil.Executor, orgID, userID int64) error {
+	owners, err := orm.OrganizationMembers(
+		qm.Select(orm.OrganizationMemberColumns.UserID),
+		memberTenantScope(orgID),
+		orm.OrganizationMemberWhere.Role.EQ(string(domain.RoleOwner)),
+		qm.For("UPDATE"),
+	).All(exec)
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	if len(owners) == 1 && owners[0].UserID == userID {
+		return codes.ErrOrgLastOwner
+	}
+	return nil
+}
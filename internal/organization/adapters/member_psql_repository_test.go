@@ -0,0 +1,96 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pkg/errors"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/testkit"
+	"scaffold/internal/organization/domain"
+)
+
+const (
+	testOrgID  = 3
+	testUserID = 7
+)
+
+// expectLockOwners 所有者行需在变更前加锁 并发的降级或移除才会依次执行
+func expectLockOwners(mock sqlmock.Sqlmock, ownerIDs ...int64) {
+	rows := sqlmock.NewRows([]string{"user_id"})
+	for _, id := range ownerIDs {
+		rows.AddRow(id)
+	}
+	mock.ExpectQuery(testkit.QuoteSQL(`SELECT "user_id" FROM "organization_members" WHERE (organization_id = $1) AND ("organization_members"."role" = $2) FOR UPDATE`)).
+		WithArgs(testOrgID, string(domain.RoleOwner)).
+		WillReturnRows(rows)
+}
+
+func TestRemoveRefusesLastOwner(t *testing.T) {
+	mock := testkit.MockDB(t)
+
+	mock.ExpectBegin()
+	expectLockOwners(mock, testUserID)
+	mock.ExpectRollback()
+
+	err := NewMemberPSQLRepository().Remove(testOrgID, testUserID)
+	if !errors.Is(err, codes.ErrOrgLastOwner) {
+		t.Fatalf("移除最后一名所有者应返回 ErrOrgLastOwner 实际为 %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRemoveOwnerWithAnotherOwner(t *testing.T) {
+	mock := testkit.MockDB(t)
+
+	mock.ExpectBegin()
+	expectLockOwners(mock, testUserID, 8)
+	mock.ExpectExec(testkit.QuoteSQL(`DELETE FROM "organization_members" WHERE (organization_id = $1) AND ("organization_members"."user_id" = $2)`)).
+		WithArgs(testOrgID, testUserID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	if err := NewMemberPSQLRepository().Remove(testOrgID, testUserID); err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUpdateRoleRefusesDemotingLastOwner(t *testing.T) {
+	mock := testkit.MockDB(t)
+
+	mock.ExpectBegin()
+	expectLockOwners(mock, testUserID)
+	mock.ExpectRollback()
+
+	err := NewMemberPSQLRepository().UpdateRole(testOrgID, testUserID, domain.RoleAdmin)
+	if !errors.Is(err, codes.ErrOrgLastOwner) {
+		t.Fatalf("降级最后一名所有者应返回 ErrOrgLastOwner 实际为 %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUpdateRoleDemotesOtherMember(t *testing.T) {
+	mock := testkit.MockDB(t)
+
+	// 被调整的成员不是所有者 不影响唯一的所有者
+	mock.ExpectBegin()
+	expectLockOwners(mock, 8)
+	mock.ExpectExec(testkit.QuoteSQL(`UPDATE "organization_members" SET`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	if err := NewMemberPSQLRepository().UpdateRole(testOrgID, testUserID, domain.RoleMember); err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
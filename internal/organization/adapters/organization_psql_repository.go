@@ -0,0 +1,122 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/pkg/errors"
+	"scaffold/internal/common/orm"
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/organization/domain"
+)
+
+type OrganizationPSQLRepository struct {
+}
+
+func NewOrganizationPSQLRepository() domain.OrganizationRepository {
+	return &OrganizationPSQLRepository{}
+}
+
+func (repo *OrganizationPSQLRepository) FindByID(id int64) (*domain.Organization, error) {
+	ormOrg, err := orm.Organizations(orm.OrganizationWhere.ID.EQ(id)).OneG()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrOrgNotFound
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return ormOrganizationToDomain(ormOrg), nil
+}
+
+func (repo *OrganizationPSQLRepository) FindBySlug(slug string) (*domain.Organization, error) {
+	ormOrg, err := orm.Organizations(orm.OrganizationWhere.Slug.EQ(slug)).OneG()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrOrgNotFound
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return ormOrganizationToDomain(ormOrg), nil
+}
+
+func (repo *OrganizationPSQLRepository) CreateWithOwner(org *domain.Organization, ownerID int64) (*domain.Organization, error) {
+	tx, err := boil.BeginTx(context.Background(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	ormOrg := domainOrganizationToORM(org)
+	if err := ormOrg.Insert(tx, boil.Infer()); err != nil {
+		return nil, fmt.Errorf("failed to create organization: %w", err)
+	}
+
+	member := &orm.OrganizationMember{
+		OrganizationID: ormOrg.ID,
+		UserID:         ownerID,
+		Role:           string(domain.RoleOwner),
+	}
+	if err := member.Insert(tx, boil.Infer()); err != nil {
+		return nil, fmt.Errorf("failed to add organization owner: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return ormOrganizationToDomain(ormOrg), nil
+}
+
+func (repo *OrganizationPSQLRepository) Update(org *domain.Organization) error {
+	rows, err := orm.Organizations(orm.OrganizationWhere.ID.EQ(org.ID)).UpdateAllG(orm.M{
+		orm.OrganizationColumns.Name:      org.Name,
+		orm.OrganizationColumns.UpdatedAt: org.UpdatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update organization: %w", err)
+	}
+	if rows == 0 {
+		return codes.ErrOrgNotFound
+	}
+	return nil
+}
+
+// Delete 成员与邀请由外键级联删除
+func (repo *OrganizationPSQLRepository) Delete(id int64) error {
+	ormOrg := orm.Organization{
+		ID: id,
+	}
+	rows, err := ormOrg.DeleteG()
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	if rows == 0 {
+		return codes.ErrOrgNotFound
+	}
+	return nil
+}
+
+func (repo *OrganizationPSQLRepository) ListByUser(userID int64) ([]*domain.UserOrganization, error) {
+	members, err := orm.OrganizationMembers(
+		orm.OrganizationMemberWhere.UserID.EQ(userID),
+		qm.Load(orm.OrganizationMemberRels.Organization),
+		qm.OrderBy(orm.OrganizationMemberColumns.CreatedAt+" ASC"),
+	).AllG()
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	orgs := make([]*domain.UserOrganization, 0, len(members))
+	for _, m := range members {
+		if m.R == nil || m.R.Organization == nil {
+			continue
+		}
+		orgs = append(orgs, &domain.UserOrganization{
+			Organization: ormOrganizationToDomain(m.R.Organization),
+			Role:         domain.Role(m.Role),
+			JoinedAt:     m.CreatedAt,
+		})
+	}
+	return orgs, nil
+}
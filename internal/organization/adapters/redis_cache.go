@@ -0,0 +1,67 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/utils"
+	"scaffold/internal/organization/domain"
+)
+
+type MembershipRedisCache struct {
+	client *redis.Client
+}
+
+func NewMembershipRedisCache() domain.MembershipCache {
+	return &MembershipRedisCache{client: getRedisClient()}
+}
+
+const keyMembership = "org:membership:"
+
+func membershipKey(orgID, userID int64) string {
+	return utils.GetRedisKey(fmt.Sprintf("%s%d:%d", keyMembership, orgID, userID))
+}
+
+// Get 缓存中只保存角色 组织与用户由键本身确定
+func (ch *MembershipRedisCache) Get(orgID, userID int64) (*domain.Membership, error) {
+	role, err := ch.client.Get(context.Background(), membershipKey(orgID, userID)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, codes.ErrMembershipCacheMiss
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	return &domain.Membership{
+		OrganizationID: orgID,
+		UserID:         userID,
+		Role:           domain.Role(role),
+	}, nil
+}
+
+func (ch *MembershipRedisCache) Set(membership *domain.Membership) error {
+	key := membershipKey(membership.OrganizationID, membership.UserID)
+	if err := ch.client.Set(context.Background(), key, string(membership.Role), domain.MembershipCacheExpire).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (ch *MembershipRedisCache) Invalidate(orgID int64, userIDs ...int64) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		keys = append(keys, membershipKey(orgID, userID))
+	}
+	if err := ch.client.Del(context.Background(), keys...).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package adapters
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/aarondl/null/v8"
+	"github.com/pkg/errors"
+	"scaffold/internal/common/orm"
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/organization/domain"
+)
+
+// UserPSQLReader 只读地查询用户表 组织模块不修改用户数据
+type UserPSQLReader struct {
+}
+
+func NewUserPSQLReader() domain.UserReader {
+	return &UserPSQLReader{}
+}
+
+func (r *UserPSQLReader) FindByID(id int64) (*domain.UserProfile, error) {
+	ormUser, err := orm.Users(orm.UserWhere.ID.EQ(id)).OneG()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return ormUserToProfile(ormUser), nil
+}
+
+func (r *UserPSQLReader) FindByEmail(email string) (*domain.UserProfile, error) {
+	ormUser, err := orm.Users(orm.UserWhere.Email.EQ(null.StringFrom(email))).OneG()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return ormUserToProfile(ormUser), nil
+}
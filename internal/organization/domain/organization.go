@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"time"
+)
+
+const (
+	// InvitationExpire 邀请链接有效期
+	InvitationExpire = 7 * 24 * time.Hour
+	// MembershipCacheExpire 成员关系缓存时长 成员变更时会主动失效
+	MembershipCacheExpire = 10 * time.Minute
+)
+
+// Role 组织内角色 与全局的RBAC角色相互独立
+type Role string
+
+const (
+	RoleOwner  Role = "owner"
+	RoleAdmin  Role = "admin"
+	RoleMember Role = "member"
+)
+
+var roleRank = map[Role]int{
+	RoleMember: 1,
+	RoleAdmin:  2,
+	RoleOwner:  3,
+}
+
+func (r Role) IsValid() bool {
+	_, ok := roleRank[r]
+	return ok
+}
+
+// AtLeast 角色不低于给定角色 owner > admin > member
+func (r Role) AtLeast(role Role) bool {
+	return roleRank[r] >= roleRank[role]
+}
+
+type Organization struct {
+	ID        int64
+	Name      string
+	Slug      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Membership 用户在某个组织中的身份 由租户中间件写入上下文
+type Membership struct {
+	OrganizationID int64
+	UserID         int64
+	Role           Role
+}
+
+// Member 组织成员 附带用户的展示信息
+type Member struct {
+	UserID    int64
+	Nickname  string
+	Email     string
+	Avatar    string
+	Role      Role
+	CreatedAt time.Time
+}
+
+// UserOrganization 当前用户加入的组织及其角色
+type UserOrganization struct {
+	Organization *Organization
+	Role         Role
+	JoinedAt     time.Time
+}
+
+type InvitationStatus string
+
+const (
+	InvitationPending  InvitationStatus = "pending"
+	InvitationAccepted InvitationStatus = "accepted"
+	InvitationDeclined InvitationStatus = "declined"
+	InvitationRevoked  InvitationStatus = "revoked"
+)
+
+type Invitation struct {
+	ID             int64
+	OrganizationID int64
+	// Organization 查询被邀请人的邀请列表时预加载
+	Organization *Organization
+	Email        string
+	Role         Role
+	TokenHash    string
+	InviterID    int64
+	Status       InvitationStatus
+	ExpiresAt    time.Time
+	RespondedAt  time.Time
+	CreatedAt    time.Time
+}
+
+// IsActive 待处理且未过期的邀请才能被接受或拒绝
+func (i *Invitation) IsActive() bool {
+	return i.Status == InvitationPending && time.Now().Before(i.ExpiresAt)
+}
+
+// UserProfile 组织模块需要的用户信息 避免直接依赖用户模块的领域模型
+type UserProfile struct {
+	ID              int64
+	Nickname        string
+	Email           string
+	EmailVerifiedAt time.Time
+}
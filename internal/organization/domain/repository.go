@@ -0,0 +1,53 @@
+package domain
+
+type OrganizationRepository interface {
+	FindByID(id int64) (*Organization, error)
+	FindBySlug(slug string) (*Organization, error)
+
+	// CreateWithOwner 在同一事务中创建组织并将创建者设为所有者
+	CreateWithOwner(org *Organization, ownerID int64) (*Organization, error)
+	Update(org *Organization) error
+	Delete(id int64) error
+	ListByUser(userID int64) ([]*UserOrganization, error)
+}
+
+// MemberRepository 查询均限定在给定组织内
+type MemberRepository interface {
+	FindMembership(orgID, userID int64) (*Membership, error)
+	List(orgID int64) ([]*Member, error)
+	ListUserIDs(orgID int64) ([]int64, error)
+	// UpdateRole 与 Remove 在同一事务中校验并变更 降级或移除最后一名所有者时返回 ErrOrgLastOwner
+	UpdateRole(orgID, userID int64, role Role) error
+	Remove(orgID, userID int64) error
+}
+
+type InvitationRepository interface {
+	FindByTokenHash(tokenHash string) (*Invitation, error)
+	Create(invitation *Invitation) (*Invitation, error)
+	ListPending(orgID int64) ([]*Invitation, error)
+	// ListPendingByEmail 被邀请人查看自己收到的邀请 预加载组织信息
+	ListPendingByEmail(email string) ([]*Invitation, error)
+
+	// RevokePending 作废发往该邮箱的全部待处理邀请 重新邀请时使用
+	RevokePending(orgID int64, email string) error
+	// Respond 将待处理的邀请更新为给定状态 邀请已被处理时返回 ErrInvitationNotFound
+	Respond(orgID, id int64, status InvitationStatus) error
+	// Accept 在同一事务中接受邀请并加入组织
+	Accept(invitation *Invitation, userID int64) error
+}
+
+// MembershipCache 租户中间件每个请求都要校验成员关系 缓存以减少查库
+type MembershipCache interface {
+	Get(orgID, userID int64) (*Membership, error)
+	Set(membership *Membership) error
+	Invalidate(orgID int64, userIDs ...int64) error
+}
+
+type UserReader interface {
+	FindByID(id int64) (*UserProfile, error)
+	FindByEmail(email string) (*UserProfile, error)
+}
+
+type InvitationMailer interface {
+	SendInvitation(to, inviterName, orgName, link string) error
+}
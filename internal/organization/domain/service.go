@@ -0,0 +1,27 @@
+package domain
+
+type OrganizationService interface {
+	CreateOrganization(userID int64, org *Organization) (*Organization, error)
+	GetOrganization(orgID int64) (*Organization, error)
+	UpdateOrganization(operatorID int64, org *Organization) error
+	DeleteOrganization(operatorID, orgID int64) error
+	ListUserOrganizations(userID int64) ([]*UserOrganization, error)
+
+	ListMembers(orgID int64) ([]*Member, error)
+	UpdateMemberRole(operatorID, orgID, userID int64, role Role) error
+	RemoveMember(operatorID, orgID, userID int64) error
+	LeaveOrganization(userID, orgID int64) error
+
+	Invite(operatorID, orgID int64, email string, role Role) (*Invitation, error)
+	ListInvitations(operatorID, orgID int64) ([]*Invitation, error)
+	RevokeInvitation(operatorID, orgID, invitationID int64) error
+	ListUserInvitations(userID int64) ([]*Invitation, error)
+	AcceptInvitation(userID int64, token string) (*Organization, error)
+	DeclineInvitation(token string) error
+}
+
+// MembershipResolver 供租户中间件使用的最小接口
+type MembershipResolver interface {
+	// GetMembership 用户不是组织成员时返回 ErrNotOrgMember
+	GetMembership(orgID, userID int64) (*Membership, error)
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"scaffold/internal/organization/domain"
+)
+
+func domainOrganizationToResponse(org *domain.Organization) *OrganizationResponse {
+	if org == nil {
+		return nil
+	}
+
+	return &OrganizationResponse{
+		ID:        org.ID,
+		Name:      org.Name,
+		Slug:      org.Slug,
+		CreatedAt: org.CreatedAt.Unix(),
+		UpdatedAt: org.UpdatedAt.Unix(),
+	}
+}
+
+func domainUserOrganizationsToResponse(orgs []*domain.UserOrganization) []*UserOrganizationResponse {
+	list := make([]*UserOrganizationResponse, 0, len(orgs))
+	for _, org := range orgs {
+		list = append(list, &UserOrganizationResponse{
+			Organization: domainOrganizationToResponse(org.Organization),
+			Role:         string(org.Role),
+			JoinedAt:     org.JoinedAt.Unix(),
+		})
+	}
+	return list
+}
+
+func domainMembersToResponse(members []*domain.Member) []*MemberResponse {
+	list := make([]*MemberResponse, 0, len(members))
+	for _, member := range members {
+		list = append(list, &MemberResponse{
+			UserID:   member.UserID,
+			Nickname: member.Nickname,
+			Email:    member.Email,
+			Avatar:   member.Avatar,
+			Role:     string(member.Role),
+			JoinedAt: member.CreatedAt.Unix(),
+		})
+	}
+	return list
+}
+
+func domainInvitationToResponse(invitation *domain.Invitation) *InvitationResponse {
+	if invitation == nil {
+		return nil
+	}
+
+	return &InvitationResponse{
+		ID:           invitation.ID,
+		Organization: domainOrganizationToResponse(invitation.Organization),
+		Email:        invitation.Email,
+		Role:         string(invitation.Role),
+		InviterID:    invitation.InviterID,
+		ExpiresAt:    invitation.ExpiresAt.Unix(),
+		CreatedAt:    invitation.CreatedAt.Unix(),
+	}
+}
+
+func domainInvitationsToResponse(invitations []*domain.Invitation) []*InvitationResponse {
+	list := make([]*InvitationResponse, 0, len(invitations))
+	for _, invitation := range invitations {
+		list = append(list, domainInvitationToResponse(invitation))
+	}
+	return list
+}
@@ -0,0 +1,65 @@
+package handler
+
+type OrganizationResponse struct {
+	ID        int64  `json:"id"`
+	Name      string `json:"name"`
+	Slug      string `json:"slug"`
+	CreatedAt int64  `json:"created_at"`
+	UpdatedAt int64  `json:"updated_at"`
+}
+
+type UserOrganizationResponse struct {
+	Organization *OrganizationResponse `json:"organization"`
+	Role         string                `json:"role"`
+	JoinedAt     int64                 `json:"joined_at"`
+}
+
+type MemberResponse struct {
+	UserID   int64  `json:"user_id"`
+	Nickname string `json:"nickname"`
+	Email    string `json:"email,omitempty"`
+	Avatar   string `json:"avatar,omitempty"`
+	Role     string `json:"role"`
+	JoinedAt int64  `json:"joined_at"`
+}
+
+type InvitationResponse struct {
+	ID           int64                 `json:"id"`
+	Organization *OrganizationResponse `json:"organization,omitempty"`
+	Email        string                `json:"email"`
+	Role         string                `json:"role"`
+	InviterID    int64                 `json:"inviter_id"`
+	ExpiresAt    int64                 `json:"expires_at"`
+	CreatedAt    int64                 `json:"created_at"`
+}
+
+type CreateOrganizationRequest struct {
+	Name string `json:"name" binding:"required,max=50"`
+	Slug string `json:"slug" binding:"required,min=3,max=50"`
+}
+
+type UpdateOrganizationRequest struct {
+	Name string `json:"name" binding:"required,max=50"`
+}
+
+type MemberIDRequest struct {
+	UserID int64 `json:"-" uri:"user_id" binding:"required"`
+}
+
+type UpdateMemberRoleRequest struct {
+	UserID int64  `json:"-" uri:"user_id" binding:"required"`
+	Role   string `json:"role" binding:"required,oneof=owner admin member"`
+}
+
+type InviteRequest struct {
+	Email string `json:"email" binding:"required,email,max=80"`
+	Role  string `json:"role" binding:"required,oneof=admin member"`
+}
+
+type InvitationIDRequest struct {
+	ID int64 `json:"-" uri:"id" binding:"required"`
+}
+
+type InvitationTokenRequest struct {
+	Token string `json:"token" binding:"required,max=128"`
+}
@@ -0,0 +1,321 @@
+package handler
+
+import (
+	"scaffold/internal/common/reqkit/bind"
+	"scaffold/internal/common/reskit/response"
+	"scaffold/internal/common/server"
+	"scaffold/internal/organization/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+type HttpHandler struct {
+	service domain.OrganizationService
+}
+
+func NewHttpHandler(service domain.OrganizationService) *HttpHandler {
+	return &HttpHandler{
+		service: service,
+	}
+}
+
+// tenantContext 读取当前用户与租户中间件解析出的组织
+func tenantContext(ctx *gin.Context) (userID, orgID int64, err error) {
+	userID, err = server.GetUserID(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	orgID, err = server.GetTenantID(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	return userID, orgID, nil
+}
+
+// CreateOrganization godoc
+// @Summary      创建组织
+// @Description  创建者自动成为组织所有者。slug 为 3-50 位小写字母、数字与连字符，全局唯一
+// @Tags         organization
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body handler.CreateOrganizationRequest true "请求参数"
+// @Success      200  {object}  response.successResponse{data=handler.OrganizationResponse} "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      401  {object}  response.errorResponse
+// @Failure      409  {object}  response.errorResponse "组织标识已被使用"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/orgs [post]
+func (h *HttpHandler) CreateOrganization(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(CreateOrganizationRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	org, err := h.service.CreateOrganization(userID, &domain.Organization{
+		Name: req.Name,
+		Slug: req.Slug,
+	})
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainOrganizationToResponse(org))
+}
+
+// ListMyOrganizations godoc
+// @Summary      我加入的组织
+// @Tags         organization
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  response.successResponse{data=[]handler.UserOrganizationResponse} "请求成功"
+// @Failure      401  {object}  response.errorResponse
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/orgs [get]
+func (h *HttpHandler) ListMyOrganizations(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	orgs, err := h.service.ListUserOrganizations(userID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainUserOrganizationsToResponse(orgs))
+}
+
+// GetOrganization godoc
+// @Summary      组织详情
+// @Tags         organization
+// @Produce      json
+// @Security     BearerAuth
+// @Param        org_id path int true "组织ID"
+// @Success      200  {object}  response.successResponse{data=handler.OrganizationResponse} "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      401  {object}  response.errorResponse
+// @Failure      403  {object}  response.errorResponse "不是该组织的成员"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/orgs/{org_id} [get]
+func (h *HttpHandler) GetOrganization(ctx *gin.Context) {
+	orgID, err := server.GetTenantID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	org, err := h.service.GetOrganization(orgID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainOrganizationToResponse(org))
+}
+
+// UpdateOrganization godoc
+// @Summary      修改组织信息
+// @Description  需要管理员及以上角色。slug 创建后不可修改
+// @Tags         organization
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        org_id path int true "组织ID"
+// @Param        request body handler.UpdateOrganizationRequest true "请求参数"
+// @Success      200  {object}  response.successResponse "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      401  {object}  response.errorResponse
+// @Failure      403  {object}  response.errorResponse "组织内权限不足"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/orgs/{org_id} [patch]
+func (h *HttpHandler) UpdateOrganization(ctx *gin.Context) {
+	userID, orgID, err := tenantContext(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(UpdateOrganizationRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.service.UpdateOrganization(userID, &domain.Organization{
+		ID:   orgID,
+		Name: req.Name,
+	}); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// DeleteOrganization godoc
+// @Summary      删除组织
+// @Description  仅所有者可操作，成员与邀请一并删除；模拟登录的令牌不能删除组织
+// @Tags         organization
+// @Produce      json
+// @Security     BearerAuth
+// @Param        org_id path int true "组织ID"
+// @Success      200  {object}  response.successResponse "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      401  {object}  response.errorResponse
+// @Failure      403  {object}  response.errorResponse "组织内权限不足"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/orgs/{org_id} [delete]
+func (h *HttpHandler) DeleteOrganization(ctx *gin.Context) {
+	userID, orgID, err := tenantContext(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	if err := h.service.DeleteOrganization(userID, orgID); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// ListMembers godoc
+// @Summary      成员列表
+// @Tags         organization
+// @Produce      json
+// @Security     BearerAuth
+// @Param        org_id path int true "组织ID"
+// @Success      200  {object}  response.successResponse{data=[]handler.MemberResponse} "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      401  {object}  response.errorResponse
+// @Failure      403  {object}  response.errorResponse "不是该组织的成员"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/orgs/{org_id}/members [get]
+func (h *HttpHandler) ListMembers(ctx *gin.Context) {
+	orgID, err := server.GetTenantID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	members, err := h.service.ListMembers(orgID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainMembersToResponse(members))
+}
+
+// UpdateMemberRole godoc
+// @Summary      调整成员角色
+// @Description  管理员只能调整非所有者成员且不能授予所有者角色，所有者可调整任何成员。组织至少保留一名所有者
+// @Tags         organization
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        org_id  path int true "组织ID"
+// @Param        user_id path int true "成员用户ID"
+// @Param        request body handler.UpdateMemberRoleRequest true "请求参数"
+// @Success      200  {object}  response.successResponse "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      401  {object}  response.errorResponse
+// @Failure      403  {object}  response.errorResponse "组织内权限不足"
+// @Failure      404  {object}  response.errorResponse "组织成员不存在"
+// @Failure      409  {object}  response.errorResponse "组织至少需要保留一名所有者"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/orgs/{org_id}/members/{user_id} [patch]
+func (h *HttpHandler) UpdateMemberRole(ctx *gin.Context) {
+	userID, orgID, err := tenantContext(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(UpdateMemberRoleRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.service.UpdateMemberRole(userID, orgID, req.UserID, domain.Role(req.Role)); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// RemoveMember godoc
+// @Summary      移除成员
+// @Description  管理员只能移除非所有者成员，所有者可移除任何成员。移除自己等同于退出组织
+// @Tags         organization
+// @Produce      json
+// @Security     BearerAuth
+// @Param        org_id  path int true "组织ID"
+// @Param        user_id path int true "成员用户ID"
+// @Success      200  {object}  response.successResponse "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      401  {object}  response.errorResponse
+// @Failure      403  {object}  response.errorResponse "组织内权限不足"
+// @Failure      404  {object}  response.errorResponse "组织成员不存在"
+// @Failure      409  {object}  response.errorResponse "组织至少需要保留一名所有者"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/orgs/{org_id}/members/{user_id} [delete]
+func (h *HttpHandler) RemoveMember(ctx *gin.Context) {
+	userID, orgID, err := tenantContext(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(MemberIDRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.service.RemoveMember(userID, orgID, req.UserID); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// LeaveOrganization godoc
+// @Summary      退出组织
+// @Description  最后一名所有者需先将所有权转让给其他成员或删除组织
+// @Tags         organization
+// @Produce      json
+// @Security     BearerAuth
+// @Param        org_id path int true "组织ID"
+// @Success      200  {object}  response.successResponse "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      401  {object}  response.errorResponse
+// @Failure      403  {object}  response.errorResponse "不是该组织的成员"
+// @Failure      409  {object}  response.errorResponse "组织至少需要保留一名所有者"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/orgs/{org_id}/leave [post]
+func (h *HttpHandler) LeaveOrganization(ctx *gin.Context) {
+	userID, orgID, err := tenantContext(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	if err := h.service.LeaveOrganization(userID, orgID); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
@@ -0,0 +1,201 @@
+package handler
+
+import (
+	"scaffold/internal/common/reqkit/bind"
+	"scaffold/internal/common/reskit/response"
+	"scaffold/internal/common/server"
+	"scaffold/internal/organization/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Invite godoc
+// @Summary      邀请成员
+// @Description  需要管理员及以上角色。向该邮箱发送邀请链接，链接7天内有效；重复邀请同一邮箱时旧链接作废
+// @Tags         organization
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        org_id path int true "组织ID"
+// @Param        request body handler.InviteRequest true "请求参数"
+// @Success      200  {object}  response.successResponse{data=handler.InvitationResponse} "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      401  {object}  response.errorResponse
+// @Failure      403  {object}  response.errorResponse "组织内权限不足"
+// @Failure      409  {object}  response.errorResponse "已是该组织的成员"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/orgs/{org_id}/invitations [post]
+func (h *HttpHandler) Invite(ctx *gin.Context) {
+	userID, orgID, err := tenantContext(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(InviteRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	invitation, err := h.service.Invite(userID, orgID, req.Email, domain.Role(req.Role))
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainInvitationToResponse(invitation))
+}
+
+// ListInvitations godoc
+// @Summary      组织的待处理邀请
+// @Description  需要管理员及以上角色
+// @Tags         organization
+// @Produce      json
+// @Security     BearerAuth
+// @Param        org_id path int true "组织ID"
+// @Success      200  {object}  response.successResponse{data=[]handler.InvitationResponse} "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      401  {object}  response.errorResponse
+// @Failure      403  {object}  response.errorResponse "组织内权限不足"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/orgs/{org_id}/invitations [get]
+func (h *HttpHandler) ListInvitations(ctx *gin.Context) {
+	userID, orgID, err := tenantContext(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	invitations, err := h.service.ListInvitations(userID, orgID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainInvitationsToResponse(invitations))
+}
+
+// RevokeInvitation godoc
+// @Summary      撤销邀请
+// @Description  需要管理员及以上角色
+// @Tags         organization
+// @Produce      json
+// @Security     BearerAuth
+// @Param        org_id path int true "组织ID"
+// @Param        id     path int true "邀请ID"
+// @Success      200  {object}  response.successResponse "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      401  {object}  response.errorResponse
+// @Failure      403  {object}  response.errorResponse "组织内权限不足"
+// @Failure      404  {object}  response.errorResponse "邀请不存在或已失效"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/orgs/{org_id}/invitations/{id} [delete]
+func (h *HttpHandler) RevokeInvitation(ctx *gin.Context) {
+	userID, orgID, err := tenantContext(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(InvitationIDRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.service.RevokeInvitation(userID, orgID, req.ID); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// ListMyInvitations godoc
+// @Summary      我收到的邀请
+// @Description  按当前账号的邮箱查询待处理的邀请，邮箱需已验证
+// @Tags         organization
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  response.successResponse{data=[]handler.InvitationResponse} "请求成功"
+// @Failure      401  {object}  response.errorResponse
+// @Failure      403  {object}  response.errorResponse "邮箱未验证"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/orgs/invitations [get]
+func (h *HttpHandler) ListMyInvitations(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	invitations, err := h.service.ListUserInvitations(userID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainInvitationsToResponse(invitations))
+}
+
+// AcceptInvitation godoc
+// @Summary      接受邀请
+// @Description  使用邀请邮件中的 token 加入组织，当前账号的邮箱需与邀请一致且已验证
+// @Tags         organization
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body handler.InvitationTokenRequest true "请求参数"
+// @Success      200  {object}  response.successResponse{data=handler.OrganizationResponse} "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      401  {object}  response.errorResponse
+// @Failure      403  {object}  response.errorResponse "邀请的邮箱与当前账号不一致"
+// @Failure      404  {object}  response.errorResponse "邀请不存在或已失效"
+// @Failure      409  {object}  response.errorResponse "已是该组织的成员"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/orgs/invitations/accept [post]
+func (h *HttpHandler) AcceptInvitation(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(InvitationTokenRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	org, err := h.service.AcceptInvitation(userID, req.Token)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainOrganizationToResponse(org))
+}
+
+// DeclineInvitation godoc
+// @Summary      拒绝邀请
+// @Description  持有邀请邮件中的 token 即可拒绝，无需登录
+// @Tags         organization
+// @Accept       json
+// @Produce      json
+// @Param        request body handler.InvitationTokenRequest true "请求参数"
+// @Success      200  {object}  response.successResponse "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      404  {object}  response.errorResponse "邀请不存在或已失效"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/orgs/invitations/decline [post]
+func (h *HttpHandler) DeclineInvitation(ctx *gin.Context) {
+	req := new(InvitationTokenRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.service.DeclineInvitation(req.Token); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
@@ -0,0 +1,60 @@
+package organization
+
+import (
+	"github.com/gin-gonic/gin"
+	"scaffold/internal/common/middleware/auth"
+	"scaffold/internal/common/middleware/tenant"
+	"scaffold/internal/organization/domain"
+	"scaffold/internal/organization/handler"
+)
+
+func RegisterV1(r *gin.RouterGroup, handler *handler.HttpHandler) func() {
+	g := r.Group("/v1/orgs")
+
+	// 持有邀请链接即可拒绝 无需登录
+	g.POST("/invitations/decline", handler.DeclineInvitation)
+
+	// 组织数据不属于API Key的授权范围 仅允许登录令牌访问
+	protected := g.Group("")
+	protected.Use(auth.JWTValidate(auth.RejectAPIKey()))
+	{
+		protected.POST("", handler.CreateOrganization)
+		protected.GET("", handler.ListMyOrganizations)
+
+		// 我收到的邀请
+		protected.GET("/invitations", handler.ListMyInvitations)
+		protected.POST("/invitations/accept", handler.AcceptInvitation)
+	}
+
+	// 组织内的路由 租户中间件校验成员关系并写入当前组织
+	// 具体角色要求由服务层校验 邀请管理额外在路由上提前拦截
+	org := g.Group("/:org_id")
+	org.Use(auth.JWTValidate(auth.RejectAPIKey()), tenant.Resolve())
+	{
+		org.GET("", handler.GetOrganization)
+		org.PATCH("", handler.UpdateOrganization)
+
+		// 成员
+		org.GET("/members", handler.ListMembers)
+		org.PATCH("/members/:user_id", handler.UpdateMemberRole)
+		org.DELETE("/members/:user_id", handler.RemoveMember)
+		org.POST("/leave", handler.LeaveOrganization)
+
+		// 邀请
+		invitations := org.Group("/invitations")
+		invitations.Use(tenant.RequireRole(domain.RoleAdmin))
+		{
+			invitations.GET("", handler.ListInvitations)
+			invitations.POST("", handler.Invite)
+			invitations.DELETE("/:id", handler.RevokeInvitation)
+		}
+	}
+
+	// 删除组织不可恢复 模拟登录的管理员不能代为操作
+	dangerous := g.Group("/:org_id")
+	dangerous.Use(auth.JWTValidate(auth.RejectAPIKey(), auth.RejectImpersonation()), tenant.Resolve())
+	{
+		dangerous.DELETE("", handler.DeleteOrganization)
+	}
+	return nil
+}
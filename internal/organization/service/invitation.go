@@ -0,0 +1,200 @@
+package service
+
+import (
+	"net/url"
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/utils"
+	"scaffold/internal/organization/domain"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// Invite 所有者角色只能通过成员角色调整授予 邀请只能指定管理员或普通成员
+func (s *service) Invite(operatorID, orgID int64, email string, role domain.Role) (*domain.Invitation, error) {
+	if role != domain.RoleAdmin && role != domain.RoleMember {
+		return nil, codes.ErrOrgRoleInvalid
+	}
+
+	if _, err := s.requireRole(orgID, operatorID, domain.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	org, err := s.orgRepo.FindByID(orgID)
+	if err != nil {
+		return nil, err
+	}
+	inviter, err := s.userReader.FindByID(operatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	email = normalizeEmail(email)
+	if err := s.ensureNotMember(orgID, email); err != nil {
+		return nil, err
+	}
+
+	// 重新邀请时旧链接作废 同一邮箱最多只有一个有效邀请
+	if err := s.invitationRepo.RevokePending(orgID, email); err != nil {
+		return nil, err
+	}
+
+	token, err := utils.GenRandomHexToken()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	invitation, err := s.invitationRepo.Create(&domain.Invitation{
+		OrganizationID: orgID,
+		Email:          email,
+		Role:           role,
+		TokenHash:      utils.HashToken(token),
+		InviterID:      operatorID,
+		Status:         domain.InvitationPending,
+		ExpiresAt:      time.Now().Add(domain.InvitationExpire),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := buildLink(invitationURL, token)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if err := s.mailer.SendInvitation(email, inviter.Nickname, org.Name, link); err != nil {
+		return nil, errors.WithStack(codes.ErrEmailSendFailed.WithCause(err))
+	}
+
+	zap.L().Info("发送组织邀请",
+		zap.Int64("org_id", orgID),
+		zap.Int64("inviter_id", operatorID),
+		zap.Int64("invitation_id", invitation.ID))
+	return invitation, nil
+}
+
+// ensureNotMember 该邮箱已注册且已在组织中时无需邀请
+func (s *service) ensureNotMember(orgID int64, email string) error {
+	user, err := s.userReader.FindByEmail(email)
+	if err != nil {
+		if errors.Is(err, codes.ErrUserNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	_, err = s.memberRepo.FindMembership(orgID, user.ID)
+	if err == nil {
+		return codes.ErrAlreadyOrgMember
+	}
+	if !errors.Is(err, codes.ErrOrgMemberNotFound) {
+		return err
+	}
+	return nil
+}
+
+func (s *service) ListInvitations(operatorID, orgID int64) ([]*domain.Invitation, error) {
+	if _, err := s.requireRole(orgID, operatorID, domain.RoleAdmin); err != nil {
+		return nil, err
+	}
+	return s.invitationRepo.ListPending(orgID)
+}
+
+func (s *service) RevokeInvitation(operatorID, orgID, invitationID int64) error {
+	if _, err := s.requireRole(orgID, operatorID, domain.RoleAdmin); err != nil {
+		return err
+	}
+	return s.invitationRepo.Respond(orgID, invitationID, domain.InvitationRevoked)
+}
+
+// ListUserInvitations 按当前账号的邮箱查询 邮箱需已验证
+func (s *service) ListUserInvitations(userID int64) ([]*domain.Invitation, error) {
+	user, err := s.verifiedUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.invitationRepo.ListPendingByEmail(user.Email)
+}
+
+// AcceptInvitation 邀请只能由邮箱与之一致且已验证邮箱的账号接受
+// 防止他人用被邀请人的邮箱注册后抢先加入组织
+func (s *service) AcceptInvitation(userID int64, token string) (*domain.Organization, error) {
+	invitation, err := s.findActiveInvitation(token)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.verifiedUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Email != invitation.Email {
+		return nil, codes.ErrInvitationEmailMismatch
+	}
+
+	_, err = s.memberRepo.FindMembership(invitation.OrganizationID, userID)
+	if err == nil {
+		return nil, codes.ErrAlreadyOrgMember
+	}
+	if !errors.Is(err, codes.ErrOrgMemberNotFound) {
+		return nil, err
+	}
+
+	if err := s.invitationRepo.Accept(invitation, userID); err != nil {
+		return nil, err
+	}
+
+	s.invalidateMembership(invitation.OrganizationID, userID)
+	return invitation.Organization, nil
+}
+
+// DeclineInvitation 持有邮件中的链接即可拒绝 无需登录
+func (s *service) DeclineInvitation(token string) error {
+	invitation, err := s.findActiveInvitation(token)
+	if err != nil {
+		return err
+	}
+	return s.invitationRepo.Respond(invitation.OrganizationID, invitation.ID, domain.InvitationDeclined)
+}
+
+// findActiveInvitation 已处理或已过期的邀请统一视为不存在
+func (s *service) findActiveInvitation(token string) (*domain.Invitation, error) {
+	invitation, err := s.invitationRepo.FindByTokenHash(utils.HashToken(token))
+	if err != nil {
+		return nil, err
+	}
+	if !invitation.IsActive() {
+		return nil, codes.ErrInvitationNotFound
+	}
+	return invitation, nil
+}
+
+func (s *service) verifiedUser(userID int64) (*domain.UserProfile, error) {
+	user, err := s.userReader.FindByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Email == "" || user.EmailVerifiedAt.IsZero() {
+		return nil, codes.ErrEmailNotVerified
+	}
+	return user, nil
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// buildLink 在前端页面地址上拼接 token 参数
+func buildLink(baseURL, token string) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", err
+	}
+
+	query := u.Query()
+	query.Set("token", token)
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
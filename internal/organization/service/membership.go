@@ -0,0 +1,46 @@
+package service
+
+import (
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/organization/domain"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+type membershipResolver struct {
+	memberRepo domain.MemberRepository
+	cache      domain.MembershipCache
+}
+
+func NewMembershipResolver(memberRepo domain.MemberRepository, cache domain.MembershipCache) domain.MembershipResolver {
+	return &membershipResolver{
+		memberRepo: memberRepo,
+		cache:      cache,
+	}
+}
+
+// GetMembership 优先读缓存 缓存不可用时回源数据库
+// 非成员不写缓存 加入组织后无需等待缓存过期
+func (r *membershipResolver) GetMembership(orgID, userID int64) (*domain.Membership, error) {
+	membership, err := r.cache.Get(orgID, userID)
+	if err == nil {
+		return membership, nil
+	}
+	if !errors.Is(err, codes.ErrMembershipCacheMiss) {
+		zap.L().Warn("读取成员关系缓存失败", zap.Int64("org_id", orgID), zap.Int64("user_id", userID), zap.Error(err))
+	}
+
+	membership, err = r.memberRepo.FindMembership(orgID, userID)
+	if err != nil {
+		if errors.Is(err, codes.ErrOrgMemberNotFound) {
+			return nil, codes.ErrNotOrgMember
+		}
+		return nil, err
+	}
+
+	if err := r.cache.Set(membership); err != nil {
+		zap.L().Warn("写入成员关系缓存失败", zap.Int64("org_id", orgID), zap.Int64("user_id", userID), zap.Error(err))
+	}
+	return membership, nil
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"regexp"
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/utils"
+	"scaffold/internal/organization/domain"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+type service struct {
+	orgRepo        domain.OrganizationRepository
+	memberRepo     domain.MemberRepository
+	invitationRepo domain.InvitationRepository
+	userReader     domain.UserReader
+	cache          domain.MembershipCache
+	resolver       domain.MembershipResolver
+	mailer         domain.InvitationMailer
+}
+
+var invitationURL string
+
+// slug 以小写字母或数字开头结尾 中间可包含连字符
+var slugPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+func NewOrganizationService(
+	orgRepo domain.OrganizationRepository,
+	memberRepo domain.MemberRepository,
+	invitationRepo domain.InvitationRepository,
+	userReader domain.UserReader,
+	cache domain.MembershipCache,
+	resolver domain.MembershipResolver,
+	mailer domain.InvitationMailer,
+) domain.OrganizationService {
+	invitationURL = utils.GetEnv("ORG_INVITATION_URL")
+
+	return &service{
+		orgRepo:        orgRepo,
+		memberRepo:     memberRepo,
+		invitationRepo: invitationRepo,
+		userReader:     userReader,
+		cache:          cache,
+		resolver:       resolver,
+		mailer:         mailer,
+	}
+}
+
+func (s *service) CreateOrganization(userID int64, org *domain.Organization) (*domain.Organization, error) {
+	if !slugPattern.MatchString(org.Slug) {
+		return nil, codes.ErrOrgSlugInvalid
+	}
+
+	_, err := s.orgRepo.FindBySlug(org.Slug)
+	if err == nil {
+		return nil, codes.ErrOrgSlugExists
+	}
+	if !errors.Is(err, codes.ErrOrgNotFound) {
+		return nil, err
+	}
+
+	return s.orgRepo.CreateWithOwner(org, userID)
+}
+
+func (s *service) GetOrganization(orgID int64) (*domain.Organization, error) {
+	return s.orgRepo.FindByID(orgID)
+}
+
+func (s *service) UpdateOrganization(operatorID int64, org *domain.Organization) error {
+	if _, err := s.requireRole(org.ID, operatorID, domain.RoleAdmin); err != nil {
+		return err
+	}
+
+	org.UpdatedAt = time.Now()
+	return s.orgRepo.Update(org)
+}
+
+func (s *service) DeleteOrganization(operatorID, orgID int64) error {
+	if _, err := s.requireRole(orgID, operatorID, domain.RoleOwner); err != nil {
+		return err
+	}
+
+	// 删除前记录成员 删除后逐个失效缓存
+	userIDs, err := s.memberRepo.ListUserIDs(orgID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.orgRepo.Delete(orgID); err != nil {
+		return err
+	}
+
+	s.invalidateMembership(orgID, userIDs...)
+	return nil
+}
+
+func (s *service) ListUserOrganizations(userID int64) ([]*domain.UserOrganization, error) {
+	return s.orgRepo.ListByUser(userID)
+}
+
+func (s *service) ListMembers(orgID int64) ([]*domain.Member, error) {
+	return s.memberRepo.List(orgID)
+}
+
+// UpdateMemberRole 只有所有者可以授予或撤销所有者角色 管理员只能调整非所有者成员
+func (s *service) UpdateMemberRole(operatorID, orgID, userID int64, role domain.Role) error {
+	if !role.IsValid() {
+		return codes.ErrOrgRoleInvalid
+	}
+
+	operator, err := s.requireRole(orgID, operatorID, domain.RoleAdmin)
+	if err != nil {
+		return err
+	}
+
+	target, err := s.memberRepo.FindMembership(orgID, userID)
+	if err != nil {
+		return err
+	}
+	if target.Role == role {
+		return nil
+	}
+
+	if !canManage(operator.Role, target.Role) || !operator.Role.AtLeast(role) {
+		return codes.ErrOrgPermissionDenied
+	}
+
+	if err := s.memberRepo.UpdateRole(orgID, userID, role); err != nil {
+		return err
+	}
+
+	s.invalidateMembership(orgID, userID)
+	return nil
+}
+
+func (s *service) RemoveMember(operatorID, orgID, userID int64) error {
+	if operatorID == userID {
+		return s.LeaveOrganization(userID, orgID)
+	}
+
+	operator, err := s.requireRole(orgID, operatorID, domain.RoleAdmin)
+	if err != nil {
+		return err
+	}
+
+	target, err := s.memberRepo.FindMembership(orgID, userID)
+	if err != nil {
+		return err
+	}
+	if !canManage(operator.Role, target.Role) {
+		return codes.ErrOrgPermissionDenied
+	}
+
+	if err := s.memberRepo.Remove(orgID, userID); err != nil {
+		return err
+	}
+
+	s.invalidateMembership(orgID, userID)
+	return nil
+}
+
+func (s *service) LeaveOrganization(userID, orgID int64) error {
+	if _, err := s.resolver.GetMembership(orgID, userID); err != nil {
+		return err
+	}
+
+	// 最后一名所有者需先转让所有权或删除组织 由仓储在事务中校验
+	if err := s.memberRepo.Remove(orgID, userID); err != nil {
+		return err
+	}
+
+	s.invalidateMembership(orgID, userID)
+	return nil
+}
+
+// requireRole 操作人在组织内的角色不低于给定角色
+func (s *service) requireRole(orgID, userID int64, role domain.Role) (*domain.Membership, error) {
+	membership, err := s.resolver.GetMembership(orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !membership.Role.AtLeast(role) {
+		return nil, codes.ErrOrgPermissionDenied
+	}
+	return membership, nil
+}
+
+// canManage 所有者可管理任何成员 管理员只能管理非所有者成员
+func canManage(operator, target domain.Role) bool {
+	if operator == domain.RoleOwner {
+		return true
+	}
+	return operator.AtLeast(domain.RoleAdmin) && target != domain.RoleOwner
+}
+
+// invalidateMembership 失效失败只会让变更延迟到缓存过期后生效 不影响本次操作
+func (s *service) invalidateMembership(orgID int64, userIDs ...int64) {
+	if err := s.cache.Invalidate(orgID, userIDs...); err != nil {
+		zap.L().Warn("失效成员关系缓存失败", zap.Int64("org_id", orgID), zap.Int64s("user_ids", userIDs), zap.Error(err))
+	}
+}
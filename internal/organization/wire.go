@@ -0,0 +1,28 @@
+//go:build wireinject
+// +build wireinject
+
+package organization
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/wire"
+	"scaffold/internal/organization/adapters"
+	"scaffold/internal/organization/handler"
+	"scaffold/internal/organization/service"
+)
+
+func InitV1(r *gin.RouterGroup) func() {
+	wire.Build(
+		RegisterV1,
+		handler.NewHttpHandler,
+		service.NewOrganizationService,
+		service.NewMembershipResolver,
+		adapters.NewOrganizationPSQLRepository,
+		adapters.NewMemberPSQLRepository,
+		adapters.NewInvitationPSQLRepository,
+		adapters.NewUserPSQLReader,
+		adapters.NewMembershipRedisCache,
+		adapters.NewInvitationMailer,
+	)
+	return nil
+}
@@ -0,0 +1,30 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run -mod=mod github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package organization
+
+import (
+	"github.com/gin-gonic/gin"
+	"scaffold/internal/organization/adapters"
+	"scaffold/internal/organization/handler"
+	"scaffold/internal/organization/service"
+)
+
+// Injectors from wire.go:
+
+func InitV1(r *gin.RouterGroup) func() {
+	organizationRepository := adapters.NewOrganizationPSQLRepository()
+	memberRepository := adapters.NewMemberPSQLRepository()
+	invitationRepository := adapters.NewInvitationPSQLRepository()
+	userReader := adapters.NewUserPSQLReader()
+	membershipCache := adapters.NewMembershipRedisCache()
+	membershipResolver := service.NewMembershipResolver(memberRepository, membershipCache)
+	invitationMailer := adapters.NewInvitationMailer()
+	organizationService := service.NewOrganizationService(organizationRepository, memberRepository, invitationRepository, userReader, membershipCache, membershipResolver, invitationMailer)
+	httpHandler := handler.NewHttpHandler(organizationService)
+	v := RegisterV1(r, httpHandler)
+	return v
+}
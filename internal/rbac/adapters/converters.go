@@ -0,0 +1,92 @@
+package adapters
+
+import (
+	"scaffold/internal/common/orm"
+	"scaffold/internal/rbac/domain"
+)
+
+func domainRoleToORM(role *domain.Role) *orm.Role {
+	if role == nil {
+		return nil
+	}
+
+	return &orm.Role{
+		ID:          role.ID,
+		Name:        role.Name,
+		Description: role.Description,
+		CreatedAt:   role.CreatedAt,
+		UpdatedAt:   role.UpdatedAt,
+	}
+}
+
+func ormRoleToDomain(ormRole *orm.Role) *domain.Role {
+	if ormRole == nil {
+		return nil
+	}
+
+	role := &domain.Role{
+		ID:          ormRole.ID,
+		Name:        ormRole.Name,
+		Description: ormRole.Description,
+		CreatedAt:   ormRole.CreatedAt,
+		UpdatedAt:   ormRole.UpdatedAt,
+	}
+
+	// 预加载了权限时一并转换
+	if ormRole.R != nil {
+		role.Permissions = make([]*domain.Permission, 0, len(ormRole.R.RolePermissions))
+		for _, rp := range ormRole.R.RolePermissions {
+			if rp.R != nil && rp.R.Permission != nil {
+				role.Permissions = append(role.Permissions, ormPermissionToDomain(rp.R.Permission))
+			}
+		}
+	}
+
+	return role
+}
+
+func ormRolesToDomain(ormRoles []*orm.Role) []*domain.Role {
+	roles := make([]*domain.Role, 0, len(ormRoles))
+	for _, ormRole := range ormRoles {
+		if ormRole != nil {
+			roles = append(roles, ormRoleToDomain(ormRole))
+		}
+	}
+	return roles
+}
+
+func domainPermissionToORM(permission *domain.Permission) *orm.Permission {
+	if permission == nil {
+		return nil
+	}
+
+	return &orm.Permission{
+		ID:          permission.ID,
+		Code:        permission.Code,
+		Description: permission.Description,
+		CreatedAt:   permission.CreatedAt,
+	}
+}
+
+func ormPermissionToDomain(ormPermission *orm.Permission) *domain.Permission {
+	if ormPermission == nil {
+		return nil
+	}
+
+	return &domain.Permission{
+		ID:          ormPermission.ID,
+		Code:        ormPermission.Code,
+		Description: ormPermission.Description,
+		CreatedAt:   ormPermission.CreatedAt,
+	}
+}
+
+func ormPermissionsToDomain(ormPermissions []*orm.Permission) []*domain.Permission {
+	permissions := make([]*domain.Permission, 0, len(ormPermissions))
+	for _, ormPermission := range ormPermissions {
+		if ormPermission != nil {
+			permissions = append(permissions, ormPermissionToDomain(ormPermission))
+		}
+	}
+	return permissions
+}
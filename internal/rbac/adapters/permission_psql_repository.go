@@ -0,0 +1,61 @@
+package adapters
+
+import (
+	"fmt"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"scaffold/internal/common/orm"
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/rbac/domain"
+)
+
+type PermissionPSQLRepository struct {
+}
+
+func NewPermissionPSQLRepository() domain.PermissionRepository {
+	return &PermissionPSQLRepository{}
+}
+
+func (repo *PermissionPSQLRepository) FindByCodes(permCodes []string) ([]*domain.Permission, error) {
+	if len(permCodes) == 0 {
+		return []*domain.Permission{}, nil
+	}
+
+	ormPermissions, err := orm.Permissions(orm.PermissionWhere.Code.IN(permCodes)).AllG()
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return ormPermissionsToDomain(ormPermissions), nil
+}
+
+func (repo *PermissionPSQLRepository) Create(permission *domain.Permission) (*domain.Permission, error) {
+	ormPermission := domainPermissionToORM(permission)
+
+	if err := ormPermission.InsertG(boil.Infer()); err != nil {
+		return nil, fmt.Errorf("failed to create permission: %w", err)
+	}
+
+	return ormPermissionToDomain(ormPermission), nil
+}
+
+func (repo *PermissionPSQLRepository) Delete(id int64) error {
+	ormPermission := orm.Permission{
+		ID: id,
+	}
+	rows, err := ormPermission.DeleteG()
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	if rows == 0 {
+		return codes.ErrPermissionNotFound
+	}
+	return nil
+}
+
+func (repo *PermissionPSQLRepository) List() ([]*domain.Permission, error) {
+	ormPermissions, err := orm.Permissions(qm.OrderBy(orm.PermissionColumns.Code + " ASC")).AllG()
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return ormPermissionsToDomain(ormPermissions), nil
+}
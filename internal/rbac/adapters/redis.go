@@ -0,0 +1,43 @@
+package adapters
+
+import (
+	"context"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+
+	"scaffold/internal/common/utils"
+)
+
+var (
+	redisClient     *redis.Client
+	redisClientOnce sync.Once
+)
+
+// getRedisClient 权限模块内的缓存共享同一个连接池
+func getRedisClient() *redis.Client {
+	redisClientOnce.Do(func() {
+		host := utils.GetEnv("REDIS_HOST")
+		port := utils.GetEnv("REDIS_PORT")
+		password := utils.GetEnv("REDIS_PASSWORD")
+		db := utils.GetEnvAsInt("REDIS_DB")
+		poolSize := utils.GetEnvAsInt("REDIS_POOL_SIZE")
+
+		addr := host + ":" + port
+		client := redis.NewClient(&redis.Options{
+			Addr:     addr,
+			DB:       db,
+			Password: password,
+			PoolSize: poolSize,
+		})
+
+		// 可选：ping 检查连接
+		if err := client.Ping(context.Background()).Err(); err != nil {
+			panic(err)
+		}
+
+		redisClient = client
+	})
+
+	return redisClient
+}
@@ -0,0 +1,100 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/utils"
+	"scaffold/internal/rbac/domain"
+)
+
+type PermissionRedisCache struct {
+	client *redis.Client
+}
+
+func NewPermissionRedisCache() domain.PermissionCache {
+	return &PermissionRedisCache{client: getRedisClient()}
+}
+
+const (
+	// 用户权限缓存键带有版本号 版本递增即全部失效 旧键随过期时间自然清理
+	keyPermissionVersion = "rbac:permission_version"
+	keyUserPermissions   = "rbac:user_permissions:"
+)
+
+func (ch *PermissionRedisCache) userKey(ctx context.Context, userID int64) (string, error) {
+	version, err := ch.client.Get(ctx, utils.GetRedisKey(keyPermissionVersion)).Int64()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return "", errors.WithStack(err)
+	}
+	return utils.GetRedisKey(fmt.Sprintf("%s%d:%d", keyUserPermissions, version, userID)), nil
+}
+
+func (ch *PermissionRedisCache) GetUserPermissions(userID int64) ([]string, error) {
+	ctx := context.Background()
+	key, err := ch.userKey(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := ch.client.Get(ctx, key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, codes.ErrPermissionCacheMiss
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	var permCodes []string
+	if err := json.Unmarshal(result, &permCodes); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return permCodes, nil
+}
+
+func (ch *PermissionRedisCache) SetUserPermissions(userID int64, permCodes []string) error {
+	ctx := context.Background()
+	key, err := ch.userKey(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	// 无权限的用户同样缓存 避免反复查库
+	if permCodes == nil {
+		permCodes = []string{}
+	}
+	data, err := json.Marshal(permCodes)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := ch.client.Set(ctx, key, data, domain.UserPermissionExpire).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (ch *PermissionRedisCache) InvalidateUser(userID int64) error {
+	ctx := context.Background()
+	key, err := ch.userKey(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := ch.client.Del(ctx, key).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (ch *PermissionRedisCache) InvalidateAll() error {
+	if err := ch.client.Incr(context.Background(), utils.GetRedisKey(keyPermissionVersion)).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
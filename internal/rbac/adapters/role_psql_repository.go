@@ -0,0 +1,151 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/pkg/errors"
+	"scaffold/internal/common/orm"
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/utils/dbkit"
+	"scaffold/internal/rbac/domain"
+)
+
+type RolePSQLRepository struct {
+}
+
+func NewRolePSQLRepository() domain.RoleRepository {
+	return &RolePSQLRepository{}
+}
+
+// loadPermissions 查询角色时预加载其权限
+func loadPermissions() qm.QueryMod {
+	return qm.Load(qm.Rels(orm.RoleRels.RolePermissions, orm.RolePermissionRels.Permission))
+}
+
+func (repo *RolePSQLRepository) FindByID(id int64) (*domain.Role, error) {
+	ormRole, err := orm.Roles(orm.RoleWhere.ID.EQ(id), loadPermissions()).OneG()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrRoleNotFound
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return ormRoleToDomain(ormRole), nil
+}
+
+func (repo *RolePSQLRepository) FindByName(name string) (*domain.Role, error) {
+	ormRole, err := orm.Roles(orm.RoleWhere.Name.EQ(name)).OneG()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrRoleNotFound
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return ormRoleToDomain(ormRole), nil
+}
+
+func (repo *RolePSQLRepository) Create(role *domain.Role) (*domain.Role, error) {
+	ormRole := domainRoleToORM(role)
+
+	if err := ormRole.InsertG(boil.Infer()); err != nil {
+		return nil, fmt.Errorf("failed to create role: %w", err)
+	}
+
+	return ormRoleToDomain(ormRole), nil
+}
+
+func (repo *RolePSQLRepository) Update(role *domain.Role) error {
+	rows, err := orm.Roles(orm.RoleWhere.ID.EQ(role.ID)).UpdateAllG(orm.M{
+		orm.RoleColumns.Name:        role.Name,
+		orm.RoleColumns.Description: role.Description,
+		orm.RoleColumns.UpdatedAt:   role.UpdatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update role: %w", err)
+	}
+	if rows == 0 {
+		return codes.ErrRoleNotFound
+	}
+	return nil
+}
+
+func (repo *RolePSQLRepository) Delete(id int64) error {
+	ormRole := orm.Role{
+		ID: id,
+	}
+	rows, err := ormRole.DeleteG()
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	if rows == 0 {
+		return codes.ErrRoleNotFound
+	}
+	return nil
+}
+
+func (repo *RolePSQLRepository) List(query *domain.RoleQuery) (*domain.RoleList, error) {
+	var whereMods []qm.QueryMod
+	if query.Keyword != "" {
+		like := "%" + query.Keyword + "%"
+		whereMods = append(whereMods, qm.Where(fmt.Sprintf("(%s LIKE ? OR %s LIKE ?)", orm.RoleColumns.Name, orm.RoleColumns.Description), like, like))
+	}
+	// 1.计算total
+	total, err := orm.Roles(whereMods...).CountG()
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	// 2.计算offset
+	offset, err := dbkit.ComputeOffset(query.Page, query.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	listMods := append(whereMods,
+		loadPermissions(),
+		qm.OrderBy(orm.RoleColumns.ID+" ASC"),
+		qm.Offset(offset),
+		qm.Limit(query.PageSize),
+	)
+
+	// 3.查询数据
+	roles, err := orm.Roles(listMods...).AllG()
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	return &domain.RoleList{
+		Total: total,
+		List:  ormRolesToDomain(roles),
+	}, nil
+}
+
+func (repo *RolePSQLRepository) SetPermissions(roleID int64, permissionIDs []int64) error {
+	tx, err := boil.BeginTx(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := orm.RolePermissions(orm.RolePermissionWhere.RoleID.EQ(roleID)).DeleteAll(tx); err != nil {
+		return fmt.Errorf("failed to clear role permissions: %w", err)
+	}
+
+	for _, permissionID := range permissionIDs {
+		rp := &orm.RolePermission{
+			RoleID:       roleID,
+			PermissionID: permissionID,
+		}
+		if err := rp.Insert(tx, boil.Infer()); err != nil {
+			return fmt.Errorf("failed to set role permission: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
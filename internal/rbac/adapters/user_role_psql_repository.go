@@ -0,0 +1,88 @@
+package adapters
+
+import (
+	"fmt"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"scaffold/internal/common/orm"
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/rbac/domain"
+)
+
+type UserRolePSQLRepository struct {
+}
+
+func NewUserRolePSQLRepository() domain.UserRoleRepository {
+	return &UserRolePSQLRepository{}
+}
+
+func (repo *UserRolePSQLRepository) Assign(userID, roleID int64) error {
+	exists, err := orm.UserExistsG(userID)
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	if !exists {
+		return codes.ErrUserNotFound
+	}
+
+	// 重复分配视为成功
+	userRole := &orm.UserRole{
+		UserID: userID,
+		RoleID: roleID,
+	}
+	err = userRole.UpsertG(false,
+		[]string{orm.UserRoleColumns.UserID, orm.UserRoleColumns.RoleID},
+		boil.None(), boil.Infer(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to assign role: %w", err)
+	}
+	return nil
+}
+
+func (repo *UserRolePSQLRepository) Revoke(userID, roleID int64) error {
+	rows, err := orm.UserRoles(
+		orm.UserRoleWhere.UserID.EQ(userID),
+		orm.UserRoleWhere.RoleID.EQ(roleID),
+	).DeleteAllG()
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	if rows == 0 {
+		return codes.ErrRoleNotFound
+	}
+	return nil
+}
+
+func (repo *UserRolePSQLRepository) ListRoles(userID int64) ([]*domain.Role, error) {
+	ormRoles, err := orm.Roles(
+		qm.InnerJoin(fmt.Sprintf("%s ur ON ur.%s = %s",
+			orm.TableNames.UserRoles, orm.UserRoleColumns.RoleID, orm.RoleTableColumns.ID)),
+		qm.Where("ur."+orm.UserRoleColumns.UserID+" = ?", userID),
+		qm.OrderBy(orm.RoleTableColumns.ID+" ASC"),
+	).AllG()
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return ormRolesToDomain(ormRoles), nil
+}
+
+func (repo *UserRolePSQLRepository) ListPermissionCodes(userID int64) ([]string, error) {
+	ormPermissions, err := orm.Permissions(
+		qm.Distinct(orm.PermissionTableColumns.Code),
+		qm.InnerJoin(fmt.Sprintf("%s rp ON rp.%s = %s",
+			orm.TableNames.RolePermissions, orm.RolePermissionColumns.PermissionID, orm.PermissionTableColumns.ID)),
+		qm.InnerJoin(fmt.Sprintf("%s ur ON ur.%s = rp.%s",
+			orm.TableNames.UserRoles, orm.UserRoleColumns.RoleID, orm.RolePermissionColumns.RoleID)),
+		qm.Where("ur."+orm.UserRoleColumns.UserID+" = ?", userID),
+	).AllG()
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	permCodes := make([]string, 0, len(ormPermissions))
+	for _, p := range ormPermissions {
+		permCodes = append(permCodes, p.Code)
+	}
+	return permCodes, nil
+}
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"time"
+)
+
+type Role struct {
+	ID          int64
+	Name        string
+	Description string
+	Permissions []*Permission
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// PermissionCodes 角色拥有的权限码
+func (r *Role) PermissionCodes() []string {
+	permCodes := make([]string, 0, len(r.Permissions))
+	for _, p := range r.Permissions {
+		permCodes = append(permCodes, p.Code)
+	}
+	return permCodes
+}
+
+// Permission 权限码约定为 resource:action 形式 如 user:read
+type Permission struct {
+	ID          int64
+	Code        string
+	Description string
+	CreatedAt   time.Time
+}
+
+type RoleQuery struct {
+	Keyword  string
+	Page     int
+	PageSize int
+}
+
+type RoleList struct {
+	Total int64
+	List  []*Role
+}
+
+// UserAccess 用户当前的角色与汇总后的权限
+type UserAccess struct {
+	Roles       []*Role
+	Permissions []string
+}
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"time"
+)
+
+// UserPermissionExpire 用户权限缓存时长 授权变更时会主动失效
+const UserPermissionExpire = 10 * time.Minute
+
+type RoleRepository interface {
+	FindByID(id int64) (*Role, error)
+	FindByName(name string) (*Role, error)
+
+	Create(role *Role) (*Role, error)
+	Update(role *Role) error
+	Delete(id int64) error
+	List(query *RoleQuery) (*RoleList, error)
+
+	// SetPermissions 以给定权限整体替换角色现有权限
+	SetPermissions(roleID int64, permissionIDs []int64) error
+}
+
+type PermissionRepository interface {
+	FindByCodes(permCodes []string) ([]*Permission, error)
+
+	Create(permission *Permission) (*Permission, error)
+	Delete(id int64) error
+	List() ([]*Permission, error)
+}
+
+type UserRoleRepository interface {
+	Assign(userID, roleID int64) error
+	Revoke(userID, roleID int64) error
+	ListRoles(userID int64) ([]*Role, error)
+	ListPermissionCodes(userID int64) ([]string, error)
+}
+
+type PermissionCache interface {
+	GetUserPermissions(userID int64) ([]string, error)
+	SetUserPermissions(userID int64, permCodes []string) error
+	// InvalidateUser 用户角色变化时失效单个用户
+	InvalidateUser(userID int64) error
+	// InvalidateAll 角色或权限本身变化时 影响面不确定 全部失效
+	InvalidateAll() error
+}
@@ -0,0 +1,28 @@
+package domain
+
+type RBACService interface {
+	CreateRole(role *Role) (*Role, error)
+	GetRole(id int64) (*Role, error)
+	UpdateRole(role *Role) error
+	DeleteRole(id int64) error
+	ListRoles(query *RoleQuery) (*RoleList, error)
+	SetRolePermissions(roleID int64, permCodes []string) error
+
+	CreatePermission(permission *Permission) (*Permission, error)
+	DeletePermission(id int64) error
+	ListPermissions() ([]*Permission, error)
+
+	AssignUserRole(userID, roleID int64) error
+	RevokeUserRole(userID, roleID int64) error
+	GetUserAccess(userID int64) (*UserAccess, error)
+	// ListUserPermissions 用户通过全部角色获得的权限码
+	ListUserPermissions(userID int64) ([]string, error)
+
+	PermissionChecker
+}
+
+// PermissionChecker 供鉴权中间件使用的最小接口
+type PermissionChecker interface {
+	// HasPermissions 用户需同时拥有全部给定权限
+	HasPermissions(userID int64, permCodes ...string) (bool, error)
+}
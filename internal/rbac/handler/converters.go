@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"scaffold/internal/rbac/domain"
+)
+
+func domainPermissionToResponse(permission *domain.Permission) *PermissionResponse {
+	if permission == nil {
+		return nil
+	}
+
+	return &PermissionResponse{
+		ID:          permission.ID,
+		Code:        permission.Code,
+		Description: permission.Description,
+		CreatedAt:   permission.CreatedAt.Unix(),
+	}
+}
+
+func domainPermissionsToResponse(permissions []*domain.Permission) []*PermissionResponse {
+	list := make([]*PermissionResponse, 0, len(permissions))
+	for _, permission := range permissions {
+		list = append(list, domainPermissionToResponse(permission))
+	}
+	return list
+}
+
+func domainRoleToResponse(role *domain.Role) *RoleResponse {
+	if role == nil {
+		return nil
+	}
+
+	return &RoleResponse{
+		ID:          role.ID,
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: role.PermissionCodes(),
+		CreatedAt:   role.CreatedAt.Unix(),
+		UpdatedAt:   role.UpdatedAt.Unix(),
+	}
+}
+
+func domainRoleListToResponse(data *domain.RoleList) *RoleListResponse {
+	if data == nil {
+		return nil
+	}
+
+	list := make([]*RoleResponse, 0, len(data.List))
+	for _, role := range data.List {
+		list = append(list, domainRoleToResponse(role))
+	}
+
+	return &RoleListResponse{
+		Total: data.Total,
+		List:  list,
+	}
+}
+
+func domainUserAccessToResponse(access *domain.UserAccess) *UserAccessResponse {
+	if access == nil {
+		return nil
+	}
+
+	roles := make([]string, 0, len(access.Roles))
+	for _, role := range access.Roles {
+		roles = append(roles, role.Name)
+	}
+
+	return &UserAccessResponse{
+		Roles:       roles,
+		Permissions: access.Permissions,
+	}
+}
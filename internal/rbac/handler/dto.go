@@ -0,0 +1,71 @@
+package handler
+
+type PermissionResponse struct {
+	ID          int64  `json:"id"`
+	Code        string `json:"code"`
+	Description string `json:"description,omitempty"`
+	CreatedAt   int64  `json:"created_at"`
+}
+
+type RoleResponse struct {
+	ID          int64    `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Permissions []string `json:"permissions"`
+	CreatedAt   int64    `json:"created_at"`
+	UpdatedAt   int64    `json:"updated_at"`
+}
+
+type RoleListResponse struct {
+	Total int64           `json:"total"`
+	List  []*RoleResponse `json:"list"`
+}
+
+type UserAccessResponse struct {
+	Roles       []string `json:"roles"`
+	Permissions []string `json:"permissions"`
+}
+
+type CreateRoleRequest struct {
+	Name        string `json:"name" binding:"required,max=50"`
+	Description string `json:"description" binding:"max=255"`
+}
+
+type UpdateRoleRequest struct {
+	ID          int64  `json:"-" uri:"id" binding:"required"`
+	Name        string `json:"name" binding:"required,max=50"`
+	Description string `json:"description" binding:"max=255"`
+}
+
+type RoleIDRequest struct {
+	ID int64 `json:"-" uri:"id" binding:"required"`
+}
+
+type ListRoleRequest struct {
+	Page     int    `form:"page,default=1" binding:"min=1"`
+	PageSize int    `form:"page_size,default=5" binding:"min=5,max=20"`
+	Keyword  string `form:"keyword" binding:"max=20"`
+}
+
+type SetRolePermissionsRequest struct {
+	ID          int64    `json:"-" uri:"id" binding:"required"`
+	Permissions []string `json:"permissions" binding:"max=200,dive,required,max=100"`
+}
+
+type CreatePermissionRequest struct {
+	Code        string `json:"code" binding:"required,max=100"`
+	Description string `json:"description" binding:"max=255"`
+}
+
+type PermissionIDRequest struct {
+	ID int64 `json:"-" uri:"id" binding:"required"`
+}
+
+type UserIDRequest struct {
+	UserID int64 `json:"-" uri:"user_id" binding:"required"`
+}
+
+type UserRoleRequest struct {
+	UserID int64 `json:"-" uri:"user_id" binding:"required"`
+	RoleID int64 `json:"-" uri:"role_id" binding:"required"`
+}
@@ -0,0 +1,403 @@
+package handler
+
+import (
+	"scaffold/internal/common/reqkit/bind"
+	"scaffold/internal/common/reskit/response"
+	"scaffold/internal/common/server"
+	"scaffold/internal/rbac/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+type HttpHandler struct {
+	service domain.RBACService
+}
+
+func NewHttpHandler(service domain.RBACService) *HttpHandler {
+	return &HttpHandler{
+		service: service,
+	}
+}
+
+// MyAccess godoc
+// @Summary      当前用户的角色与权限
+// @Description  前端可据此控制菜单与按钮的展示
+// @Tags         rbac
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  response.successResponse{data=handler.UserAccessResponse} "请求成功"
+// @Failure      401  {object}  response.errorResponse
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/rbac/me [get]
+func (h *HttpHandler) MyAccess(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	access, err := h.service.GetUserAccess(userID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainUserAccessToResponse(access))
+}
+
+// CreateRole godoc
+// @Summary      创建角色
+// @Tags         rbac
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body handler.CreateRoleRequest true "请求参数"
+// @Success      200  {object}  response.successResponse{data=handler.RoleResponse} "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      403  {object}  response.errorResponse "没有访问权限"
+// @Failure      409  {object}  response.errorResponse "角色名已存在"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/rbac/roles [post]
+func (h *HttpHandler) CreateRole(ctx *gin.Context) {
+	req := new(CreateRoleRequest)
+
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	role, err := h.service.CreateRole(&domain.Role{
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainRoleToResponse(role))
+}
+
+// UpdateRole godoc
+// @Summary      更新角色
+// @Tags         rbac
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path int true "角色id"
+// @Param        request body handler.UpdateRoleRequest true "请求参数"
+// @Success      200  {object}  response.successResponse "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      403  {object}  response.errorResponse "没有访问权限"
+// @Failure      404  {object}  response.errorResponse "角色不存在"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/rbac/roles/{id} [put]
+func (h *HttpHandler) UpdateRole(ctx *gin.Context) {
+	req := new(UpdateRoleRequest)
+
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.service.UpdateRole(&domain.Role{
+		ID:          req.ID,
+		Name:        req.Name,
+		Description: req.Description,
+	}); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// DeleteRole godoc
+// @Summary      删除角色
+// @Description  删除后持有该角色的用户立即失去对应权限
+// @Tags         rbac
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path int true "角色id"
+// @Success      200  {object}  response.successResponse "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      403  {object}  response.errorResponse "没有访问权限"
+// @Failure      404  {object}  response.errorResponse "角色不存在"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/rbac/roles/{id} [delete]
+func (h *HttpHandler) DeleteRole(ctx *gin.Context) {
+	req := new(RoleIDRequest)
+
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.service.DeleteRole(req.ID); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// GetRole godoc
+// @Summary      角色详情
+// @Tags         rbac
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path int true "角色id"
+// @Success      200  {object}  response.successResponse{data=handler.RoleResponse} "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      403  {object}  response.errorResponse "没有访问权限"
+// @Failure      404  {object}  response.errorResponse "角色不存在"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/rbac/roles/{id} [get]
+func (h *HttpHandler) GetRole(ctx *gin.Context) {
+	req := new(RoleIDRequest)
+
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	role, err := h.service.GetRole(req.ID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainRoleToResponse(role))
+}
+
+// ListRoles godoc
+// @Summary      角色列表
+// @Tags         rbac
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        keyword    query     string  false  "关键词"
+// @Param        page       query     int     false  "页号"
+// @Param        page_size  query     int     false  "页码"
+// @Success      200  {object}  response.successResponse{data=handler.RoleListResponse} "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      403  {object}  response.errorResponse "没有访问权限"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/rbac/roles [get]
+func (h *HttpHandler) ListRoles(ctx *gin.Context) {
+	req := new(ListRoleRequest)
+
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	data, err := h.service.ListRoles(&domain.RoleQuery{
+		Keyword:  req.Keyword,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainRoleListToResponse(data))
+}
+
+// SetRolePermissions godoc
+// @Summary      设置角色权限
+// @Description  以提交的权限码整体替换角色现有权限，传空数组即清空
+// @Tags         rbac
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path int true "角色id"
+// @Param        request body handler.SetRolePermissionsRequest true "请求参数"
+// @Success      200  {object}  response.successResponse "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      403  {object}  response.errorResponse "没有访问权限"
+// @Failure      404  {object}  response.errorResponse "角色或权限不存在"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/rbac/roles/{id}/permissions [put]
+func (h *HttpHandler) SetRolePermissions(ctx *gin.Context) {
+	req := new(SetRolePermissionsRequest)
+
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.service.SetRolePermissions(req.ID, req.Permissions); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// CreatePermission godoc
+// @Summary      创建权限
+// @Description  权限码约定为 resource:action 形式，如 user:read
+// @Tags         rbac
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body handler.CreatePermissionRequest true "请求参数"
+// @Success      200  {object}  response.successResponse{data=handler.PermissionResponse} "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      403  {object}  response.errorResponse "没有访问权限"
+// @Failure      409  {object}  response.errorResponse "权限码已存在"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/rbac/permissions [post]
+func (h *HttpHandler) CreatePermission(ctx *gin.Context) {
+	req := new(CreatePermissionRequest)
+
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	permission, err := h.service.CreatePermission(&domain.Permission{
+		Code:        req.Code,
+		Description: req.Description,
+	})
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainPermissionToResponse(permission))
+}
+
+// DeletePermission godoc
+// @Summary      删除权限
+// @Tags         rbac
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path int true "权限id"
+// @Success      200  {object}  response.successResponse "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      403  {object}  response.errorResponse "没有访问权限"
+// @Failure      404  {object}  response.errorResponse "权限不存在"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/rbac/permissions/{id} [delete]
+func (h *HttpHandler) DeletePermission(ctx *gin.Context) {
+	req := new(PermissionIDRequest)
+
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.service.DeletePermission(req.ID); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// ListPermissions godoc
+// @Summary      权限列表
+// @Tags         rbac
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  response.successResponse{data=[]handler.PermissionResponse} "请求成功"
+// @Failure      403  {object}  response.errorResponse "没有访问权限"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/rbac/permissions [get]
+func (h *HttpHandler) ListPermissions(ctx *gin.Context) {
+	permissions, err := h.service.ListPermissions()
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainPermissionsToResponse(permissions))
+}
+
+// GetUserAccess godoc
+// @Summary      用户的角色与权限
+// @Tags         rbac
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        user_id path int true "用户id"
+// @Success      200  {object}  response.successResponse{data=handler.UserAccessResponse} "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      403  {object}  response.errorResponse "没有访问权限"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/rbac/users/{user_id}/roles [get]
+func (h *HttpHandler) GetUserAccess(ctx *gin.Context) {
+	req := new(UserIDRequest)
+
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	access, err := h.service.GetUserAccess(req.UserID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainUserAccessToResponse(access))
+}
+
+// AssignUserRole godoc
+// @Summary      为用户分配角色
+// @Description  重复分配视为成功，立即生效
+// @Tags         rbac
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        user_id path int true "用户id"
+// @Param        role_id path int true "角色id"
+// @Success      200  {object}  response.successResponse "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      403  {object}  response.errorResponse "没有访问权限"
+// @Failure      404  {object}  response.errorResponse "用户或角色不存在"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/rbac/users/{user_id}/roles/{role_id} [post]
+func (h *HttpHandler) AssignUserRole(ctx *gin.Context) {
+	req := new(UserRoleRequest)
+
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.service.AssignUserRole(req.UserID, req.RoleID); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// RevokeUserRole godoc
+// @Summary      撤销用户角色
+// @Description  立即生效，令牌中的角色声明在下次刷新时更新
+// @Tags         rbac
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        user_id path int true "用户id"
+// @Param        role_id path int true "角色id"
+// @Success      200  {object}  response.successResponse "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      403  {object}  response.errorResponse "没有访问权限"
+// @Failure      404  {object}  response.errorResponse "用户未持有该角色"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/rbac/users/{user_id}/roles/{role_id} [delete]
+func (h *HttpHandler) RevokeUserRole(ctx *gin.Context) {
+	req := new(UserRoleRequest)
+
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.service.RevokeUserRole(req.UserID, req.RoleID); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
@@ -0,0 +1,42 @@
+package rbac
+
+import (
+	"github.com/gin-gonic/gin"
+	"scaffold/internal/common/middleware/auth"
+	"scaffold/internal/rbac/handler"
+)
+
+// permManage 管理角色与权限所需的权限码
+const permManage = "rbac:manage"
+
+func RegisterV1(r *gin.RouterGroup, handler *handler.HttpHandler) func() {
+	g := r.Group("/v1/rbac")
+	g.Use(auth.JWTValidate())
+	{
+		g.GET("/me", handler.MyAccess)
+	}
+
+	// 管理接口可修改任意用户的角色 只允许管理员本人的登录令牌访问
+	admin := r.Group("/v1/rbac")
+	admin.Use(auth.JWTValidate(auth.RejectAPIKey(), auth.RejectImpersonation()), auth.Require(permManage))
+	{
+		// 角色
+		admin.GET("/roles", handler.ListRoles)
+		admin.POST("/roles", handler.CreateRole)
+		admin.GET("/roles/:id", handler.GetRole)
+		admin.PUT("/roles/:id", handler.UpdateRole)
+		admin.DELETE("/roles/:id", handler.DeleteRole)
+		admin.PUT("/roles/:id/permissions", handler.SetRolePermissions)
+
+		// 权限
+		admin.GET("/permissions", handler.ListPermissions)
+		admin.POST("/permissions", handler.CreatePermission)
+		admin.DELETE("/permissions/:id", handler.DeletePermission)
+
+		// 用户角色
+		admin.GET("/users/:user_id/roles", handler.GetUserAccess)
+		admin.POST("/users/:user_id/roles/:role_id", handler.AssignUserRole)
+		admin.DELETE("/users/:user_id/roles/:role_id", handler.RevokeUserRole)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"scaffold/internal/rbac/domain"
+	userdomain "scaffold/internal/user/domain"
+)
+
+// rbacClaimsProvider 签发令牌时写入用户角色名与权限码 供前端与下游服务展示或粗粒度判断
+// 细粒度的权限校验仍以 auth.Require 实时查询为准 角色变更无需等待令牌过期
+type rbacClaimsProvider struct {
+	userRoleRepo domain.UserRoleRepository
+}
+
+func NewTokenClaimsProvider(userRoleRepo domain.UserRoleRepository) userdomain.TokenClaimsProvider {
+	return &rbacClaimsProvider{
+		userRoleRepo: userRoleRepo,
+	}
+}
+
+func (p *rbacClaimsProvider) FillClaims(payload *userdomain.JwtPayload) error {
+	roles, err := p.userRoleRepo.ListRoles(payload.UserID)
+	if err != nil {
+		return err
+	}
+
+	payload.Roles = make([]string, 0, len(roles))
+	for _, role := range roles {
+		payload.Roles = append(payload.Roles, role.Name)
+	}
+
+	permCodes, err := p.userRoleRepo.ListPermissionCodes(payload.UserID)
+	if err != nil {
+		return err
+	}
+	payload.Scopes = permCodes
+	return nil
+}
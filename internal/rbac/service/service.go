@@ -0,0 +1,227 @@
+package service
+
+import (
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/rbac/domain"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+type service struct {
+	roleRepo       domain.RoleRepository
+	permissionRepo domain.PermissionRepository
+	userRoleRepo   domain.UserRoleRepository
+	cache          domain.PermissionCache
+}
+
+func NewRBACService(
+	roleRepo domain.RoleRepository,
+	permissionRepo domain.PermissionRepository,
+	userRoleRepo domain.UserRoleRepository,
+	cache domain.PermissionCache,
+) domain.RBACService {
+	return &service{
+		roleRepo:       roleRepo,
+		permissionRepo: permissionRepo,
+		userRoleRepo:   userRoleRepo,
+		cache:          cache,
+	}
+}
+
+func (s *service) CreateRole(role *domain.Role) (*domain.Role, error) {
+	if err := s.checkRoleNameAvailable(role.Name, 0); err != nil {
+		return nil, err
+	}
+	return s.roleRepo.Create(role)
+}
+
+func (s *service) GetRole(id int64) (*domain.Role, error) {
+	return s.roleRepo.FindByID(id)
+}
+
+func (s *service) UpdateRole(role *domain.Role) error {
+	if err := s.checkRoleNameAvailable(role.Name, role.ID); err != nil {
+		return err
+	}
+	role.UpdatedAt = time.Now()
+	return s.roleRepo.Update(role)
+}
+
+// checkRoleNameAvailable 角色名唯一 更新时排除自身
+func (s *service) checkRoleNameAvailable(name string, selfID int64) error {
+	existing, err := s.roleRepo.FindByName(name)
+	if err != nil {
+		if errors.Is(err, codes.ErrRoleNotFound) {
+			return nil
+		}
+		return err
+	}
+	if existing.ID != selfID {
+		return codes.ErrRoleAlreadyExists
+	}
+	return nil
+}
+
+func (s *service) DeleteRole(id int64) error {
+	if err := s.roleRepo.Delete(id); err != nil {
+		return err
+	}
+	return s.cache.InvalidateAll()
+}
+
+func (s *service) ListRoles(query *domain.RoleQuery) (*domain.RoleList, error) {
+	return s.roleRepo.List(query)
+}
+
+func (s *service) SetRolePermissions(roleID int64, permCodes []string) error {
+	if _, err := s.roleRepo.FindByID(roleID); err != nil {
+		return err
+	}
+
+	permCodes = uniqueCodes(permCodes)
+	permissions, err := s.permissionRepo.FindByCodes(permCodes)
+	if err != nil {
+		return err
+	}
+
+	// 存在未定义的权限码时整体拒绝
+	if len(permissions) != len(permCodes) {
+		found := make(map[string]struct{}, len(permissions))
+		for _, p := range permissions {
+			found[p.Code] = struct{}{}
+		}
+		missing := make([]string, 0)
+		for _, c := range permCodes {
+			if _, ok := found[c]; !ok {
+				missing = append(missing, c)
+			}
+		}
+		return codes.ErrPermissionNotFound.WithDetail(map[string]any{
+			"missing": missing,
+		})
+	}
+
+	permissionIDs := make([]int64, 0, len(permissions))
+	for _, p := range permissions {
+		permissionIDs = append(permissionIDs, p.ID)
+	}
+	if err := s.roleRepo.SetPermissions(roleID, permissionIDs); err != nil {
+		return err
+	}
+
+	return s.cache.InvalidateAll()
+}
+
+func (s *service) CreatePermission(permission *domain.Permission) (*domain.Permission, error) {
+	existing, err := s.permissionRepo.FindByCodes([]string{permission.Code})
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 {
+		return nil, codes.ErrPermissionAlreadyExists
+	}
+	return s.permissionRepo.Create(permission)
+}
+
+func (s *service) DeletePermission(id int64) error {
+	if err := s.permissionRepo.Delete(id); err != nil {
+		return err
+	}
+	return s.cache.InvalidateAll()
+}
+
+func (s *service) ListPermissions() ([]*domain.Permission, error) {
+	return s.permissionRepo.List()
+}
+
+func (s *service) AssignUserRole(userID, roleID int64) error {
+	if _, err := s.roleRepo.FindByID(roleID); err != nil {
+		return err
+	}
+	if err := s.userRoleRepo.Assign(userID, roleID); err != nil {
+		return err
+	}
+	return s.cache.InvalidateUser(userID)
+}
+
+func (s *service) RevokeUserRole(userID, roleID int64) error {
+	if err := s.userRoleRepo.Revoke(userID, roleID); err != nil {
+		return err
+	}
+	return s.cache.InvalidateUser(userID)
+}
+
+func (s *service) GetUserAccess(userID int64) (*domain.UserAccess, error) {
+	roles, err := s.userRoleRepo.ListRoles(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	permCodes, err := s.getUserPermissions(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.UserAccess{
+		Roles:       roles,
+		Permissions: permCodes,
+	}, nil
+}
+
+func (s *service) HasPermissions(userID int64, permCodes ...string) (bool, error) {
+	owned, err := s.getUserPermissions(userID)
+	if err != nil {
+		return false, err
+	}
+
+	ownedSet := make(map[string]struct{}, len(owned))
+	for _, c := range owned {
+		ownedSet[c] = struct{}{}
+	}
+	for _, c := range permCodes {
+		if _, ok := ownedSet[c]; !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (s *service) ListUserPermissions(userID int64) ([]string, error) {
+	return s.getUserPermissions(userID)
+}
+
+// getUserPermissions 优先读缓存 缓存不可用时回源数据库
+func (s *service) getUserPermissions(userID int64) ([]string, error) {
+	permCodes, err := s.cache.GetUserPermissions(userID)
+	if err == nil {
+		return permCodes, nil
+	}
+	if !errors.Is(err, codes.ErrPermissionCacheMiss) {
+		zap.L().Warn("读取权限缓存失败", zap.Int64("user_id", userID), zap.Error(err))
+	}
+
+	permCodes, err = s.userRoleRepo.ListPermissionCodes(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.cache.SetUserPermissions(userID, permCodes); err != nil {
+		zap.L().Warn("写入权限缓存失败", zap.Int64("user_id", userID), zap.Error(err))
+	}
+	return permCodes, nil
+}
+
+func uniqueCodes(permCodes []string) []string {
+	seen := make(map[string]struct{}, len(permCodes))
+	result := make([]string, 0, len(permCodes))
+	for _, c := range permCodes {
+		if _, ok := seen[c]; ok {
+			continue
+		}
+		seen[c] = struct{}{}
+		result = append(result, c)
+	}
+	return result
+}
@@ -0,0 +1,25 @@
+//go:build wireinject
+// +build wireinject
+
+package rbac
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/wire"
+	"scaffold/internal/rbac/adapters"
+	"scaffold/internal/rbac/handler"
+	"scaffold/internal/rbac/service"
+)
+
+func InitV1(r *gin.RouterGroup) func() {
+	wire.Build(
+		RegisterV1,
+		handler.NewHttpHandler,
+		service.NewRBACService,
+		adapters.NewRolePSQLRepository,
+		adapters.NewPermissionPSQLRepository,
+		adapters.NewUserRolePSQLRepository,
+		adapters.NewPermissionRedisCache,
+	)
+	return nil
+}
@@ -0,0 +1,27 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run -mod=mod github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package rbac
+
+import (
+	"github.com/gin-gonic/gin"
+	"scaffold/internal/rbac/adapters"
+	"scaffold/internal/rbac/handler"
+	"scaffold/internal/rbac/service"
+)
+
+// Injectors from wire.go:
+
+func InitV1(r *gin.RouterGroup) func() {
+	roleRepository := adapters.NewRolePSQLRepository()
+	permissionRepository := adapters.NewPermissionPSQLRepository()
+	userRoleRepository := adapters.NewUserRolePSQLRepository()
+	permissionCache := adapters.NewPermissionRedisCache()
+	rbacService := service.NewRBACService(roleRepository, permissionRepository, userRoleRepository, permissionCache)
+	httpHandler := handler.NewHttpHandler(rbacService)
+	v := RegisterV1(r, httpHandler)
+	return v
+}
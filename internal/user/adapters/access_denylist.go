@@ -0,0 +1,155 @@
+package adapters
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+
+	"scaffold/internal/common/utils"
+	"scaffold/internal/user/domain"
+)
+
+const (
+	keyDenyToken   = "user:access_deny:token:"
+	keyDenySession = "user:access_deny:session:"
+	keyDenyUser    = "user:access_deny:user:"
+
+	// 未吊销的结果在进程内缓存的时间 吊销最多延迟该时长在其他实例上生效
+	denylistLocalAllowTTL = 3 * time.Second
+	// 进程内缓存条目上限 超过时清理已过期条目
+	denylistLocalMaxEntries = 10000
+)
+
+type denylistEntry struct {
+	denied    bool
+	expiresAt time.Time
+}
+
+type AccessTokenRedisDenylist struct {
+	client *redis.Client
+
+	mu    sync.Mutex
+	local map[string]denylistEntry
+}
+
+var (
+	accessTokenDenylist     *AccessTokenRedisDenylist
+	accessTokenDenylistOnce sync.Once
+)
+
+// NewAccessTokenDenylist 全局共享同一实例 本进程内的吊销可立即反映到进程内缓存
+func NewAccessTokenDenylist() domain.AccessTokenDenylist {
+	accessTokenDenylistOnce.Do(func() {
+		accessTokenDenylist = &AccessTokenRedisDenylist{
+			client: getRedisClient(),
+			local:  make(map[string]denylistEntry),
+		}
+	})
+	return accessTokenDenylist
+}
+
+func (d *AccessTokenRedisDenylist) DenyToken(jti string, ttl time.Duration) error {
+	if err := d.client.Set(context.Background(), utils.GetRedisKey(keyDenyToken+jti), 1, ttl).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	d.setLocal(jti, true, time.Now().Add(ttl))
+	return nil
+}
+
+func (d *AccessTokenRedisDenylist) DenySession(sessionID string, ttl time.Duration) error {
+	if err := d.client.Set(context.Background(), utils.GetRedisKey(keyDenySession+sessionID), 1, ttl).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	d.clearLocal()
+	return nil
+}
+
+func (d *AccessTokenRedisDenylist) DenyUserTokensBefore(userID int64, before time.Time, ttl time.Duration) error {
+	key := utils.GetRedisKey(keyDenyUser + strconv.FormatInt(userID, 10))
+	if err := d.client.Set(context.Background(), key, before.Unix(), ttl).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	d.clearLocal()
+	return nil
+}
+
+// IsDenied 依次命中进程内缓存与 Redis 三类吊销条目通过一次 MGET 查询
+func (d *AccessTokenRedisDenylist) IsDenied(claims *domain.AccessTokenClaims) (bool, error) {
+	if denied, ok := d.getLocal(claims.ID); ok {
+		return denied, nil
+	}
+
+	values, err := d.client.MGet(context.Background(),
+		utils.GetRedisKey(keyDenyToken+claims.ID),
+		utils.GetRedisKey(keyDenySession+claims.SessionID),
+		utils.GetRedisKey(keyDenyUser+strconv.FormatInt(claims.UserID, 10)),
+	).Result()
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	denied := values[0] != nil || (claims.SessionID != "" && values[1] != nil)
+	if cutoff, ok := values[2].(string); ok && !denied {
+		// iat 只精确到秒 与截止时间同一秒签发的令牌无法区分先后 一律视为已吊销
+		// 代价是截止后同一秒内重新登录得到的令牌也会失效 需再次登录
+		before, _ := strconv.ParseInt(cutoff, 10, 64)
+		denied = claims.IssuedAt.Unix() <= before
+	}
+
+	// 已吊销的令牌不会恢复 缓存到令牌过期
+	if denied {
+		d.setLocal(claims.ID, true, claims.ExpiresAt)
+	} else {
+		d.setLocal(claims.ID, false, time.Now().Add(denylistLocalAllowTTL))
+	}
+	return denied, nil
+}
+
+func (d *AccessTokenRedisDenylist) getLocal(jti string) (denied bool, ok bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	entry, ok := d.local[jti]
+	if !ok {
+		return false, false
+	}
+	if time.Now().After(entry.expiresAt) {
+		delete(d.local, jti)
+		return false, false
+	}
+	return entry.denied, true
+}
+
+func (d *AccessTokenRedisDenylist) setLocal(jti string, denied bool, expiresAt time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if len(d.local) >= denylistLocalMaxEntries {
+		now := time.Now()
+		for k, entry := range d.local {
+			if now.After(entry.expiresAt) {
+				delete(d.local, k)
+			}
+		}
+	}
+	if len(d.local) >= denylistLocalMaxEntries {
+		return
+	}
+
+	d.local[jti] = denylistEntry{denied: denied, expiresAt: expiresAt}
+}
+
+// clearLocal 会话或用户级吊销无法按 jti 定位 直接清空进程内缓存
+func (d *AccessTokenRedisDenylist) clearLocal() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.local = make(map[string]denylistEntry)
+}
@@ -0,0 +1,101 @@
+package adapters
+
+import (
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"scaffold/internal/user/domain"
+)
+
+var (
+	testTokenSeq atomic.Int64
+	testUserSeq  atomic.Int64
+)
+
+// newTestUserID 用户级吊销写入 Redis 后一直有效 每个用例使用不同的用户
+func newTestUserID() int64 {
+	return 3000 + testUserSeq.Add(1)
+}
+
+// newTestClaims 每个令牌使用不同的 jti 避免命中其他用例写入的进程内缓存
+func newTestClaims(userID int64, issuedAt time.Time) *domain.AccessTokenClaims {
+	seq := testTokenSeq.Add(1)
+	return &domain.AccessTokenClaims{
+		ID:        "jti-" + strconv.FormatInt(seq, 10),
+		UserID:    userID,
+		SessionID: "session-" + strconv.FormatInt(seq, 10),
+		IssuedAt:  issuedAt,
+		ExpiresAt: issuedAt.Add(15 * time.Minute),
+	}
+}
+
+func assertDenied(t *testing.T, denylist domain.AccessTokenDenylist, claims *domain.AccessTokenClaims, want bool) {
+	t.Helper()
+
+	denied, err := denylist.IsDenied(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if denied != want {
+		t.Fatalf("签发于 %s 的令牌 期望吊销=%v 实际为 %v", claims.IssuedAt.Format(time.RFC3339), want, denied)
+	}
+}
+
+func TestDenyUserTokensBeforeCutoff(t *testing.T) {
+	denylist := NewAccessTokenDenylist()
+	userID, otherUserID := newTestUserID(), newTestUserID()
+
+	// 令牌 iat 只精确到秒
+	cutoff := time.Now().Truncate(time.Second)
+	if err := denylist.DenyUserTokensBefore(userID, cutoff.Add(300*time.Millisecond), time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	assertDenied(t, denylist, newTestClaims(userID, cutoff.Add(-time.Second)), true)
+	// 与截止时间同一秒签发的令牌无法区分先后 一律视为已吊销
+	assertDenied(t, denylist, newTestClaims(userID, cutoff), true)
+	assertDenied(t, denylist, newTestClaims(userID, cutoff.Add(time.Second)), false)
+
+	// 其他用户不受影响
+	assertDenied(t, denylist, newTestClaims(otherUserID, cutoff.Add(-time.Second)), false)
+}
+
+func TestDenyUserTokensBeforeClearsLocalCache(t *testing.T) {
+	denylist := NewAccessTokenDenylist()
+	userID := newTestUserID()
+
+	claims := newTestClaims(userID, time.Now().Truncate(time.Second))
+	// 未吊销的结果会在进程内缓存一小段时间
+	assertDenied(t, denylist, claims, false)
+
+	if err := denylist.DenyUserTokensBefore(userID, time.Now(), time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	assertDenied(t, denylist, claims, true)
+}
+
+func TestDenyTokenAndSession(t *testing.T) {
+	denylist := NewAccessTokenDenylist()
+	userID := newTestUserID()
+	now := time.Now().Truncate(time.Second)
+
+	token := newTestClaims(userID, now)
+	if err := denylist.DenyToken(token.ID, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	assertDenied(t, denylist, token, true)
+
+	session := newTestClaims(userID, now)
+	sameSession := newTestClaims(userID, now)
+	sameSession.SessionID = session.SessionID
+	if err := denylist.DenySession(session.SessionID, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	assertDenied(t, denylist, session, true)
+	assertDenied(t, denylist, sameSession, true)
+
+	// 同一用户的其他令牌不受影响
+	assertDenied(t, denylist, newTestClaims(userID, now), false)
+}
@@ -0,0 +1,91 @@
+package adapters
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/pkg/errors"
+	"scaffold/internal/common/reskit/codes"
+	"time"
+
+	"scaffold/internal/common/orm"
+	"scaffold/internal/user/domain"
+)
+
+type APIKeyPSQLRepository struct {
+}
+
+func NewAPIKeyPSQLRepository() domain.APIKeyRepository {
+	return &APIKeyPSQLRepository{}
+}
+
+func (r *APIKeyPSQLRepository) FindByHash(tokenHash string) (*domain.APIKey, error) {
+	ormKey, err := orm.APIKeys(orm.APIKeyWhere.TokenHash.EQ(tokenHash)).OneG()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrAPIKeyInvalid
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return ormAPIKeyToDomain(ormKey), nil
+}
+
+func (r *APIKeyPSQLRepository) ListByUserID(userID int64) ([]*domain.APIKey, error) {
+	ormKeys, err := orm.APIKeys(
+		orm.APIKeyWhere.UserID.EQ(userID),
+		qm.OrderBy(orm.APIKeyColumns.CreatedAt+" DESC"),
+	).AllG()
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	keys := make([]*domain.APIKey, 0, len(ormKeys))
+	for _, ormKey := range ormKeys {
+		keys = append(keys, ormAPIKeyToDomain(ormKey))
+	}
+	return keys, nil
+}
+
+func (r *APIKeyPSQLRepository) CountByUserID(userID int64) (int64, error) {
+	count, err := orm.APIKeys(orm.APIKeyWhere.UserID.EQ(userID)).CountG()
+	if err != nil {
+		return 0, fmt.Errorf("database error: %w", err)
+	}
+	return count, nil
+}
+
+func (r *APIKeyPSQLRepository) Create(key *domain.APIKey) (*domain.APIKey, error) {
+	ormKey := domainAPIKeyToORM(key)
+
+	if err := ormKey.InsertG(boil.Infer()); err != nil {
+		return nil, fmt.Errorf("failed to create api key: %w", err)
+	}
+
+	return ormAPIKeyToDomain(ormKey), nil
+}
+
+func (r *APIKeyPSQLRepository) Delete(userID, id int64) error {
+	rows, err := orm.APIKeys(
+		orm.APIKeyWhere.ID.EQ(id),
+		orm.APIKeyWhere.UserID.EQ(userID),
+	).DeleteAllG()
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	if rows == 0 {
+		return codes.ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+func (r *APIKeyPSQLRepository) UpdateLastUsed(id int64, at time.Time) error {
+	_, err := orm.APIKeys(orm.APIKeyWhere.ID.EQ(id)).UpdateAllG(orm.M{
+		orm.APIKeyColumns.LastUsedAt: null.TimeFrom(at),
+	})
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package adapters
+
+import (
+	"fmt"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"scaffold/internal/user/domain"
+)
+
+type AuditLogPSQLRepository struct {
+}
+
+func NewAuditLogPSQLRepository() domain.AuditLogRepository {
+	return &AuditLogPSQLRepository{}
+}
+
+func (r *AuditLogPSQLRepository) Create(log *domain.AuditLog) error {
+	ormLog := domainAuditLogToORM(log)
+	if err := ormLog.InsertG(boil.Infer()); err != nil {
+		return fmt.Errorf("failed to create audit log: %w", err)
+	}
+
+	log.ID = ormLog.ID
+	log.CreatedAt = ormLog.CreatedAt
+	return nil
+}
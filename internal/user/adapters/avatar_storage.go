@@ -0,0 +1,39 @@
+package adapters
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"scaffold/internal/common/storage"
+	"scaffold/internal/common/utils"
+	"scaffold/internal/user/domain"
+)
+
+type UserAvatarStorage struct {
+	storage storage.Storage
+}
+
+func NewAvatarStorage() domain.AvatarStorage {
+	return &UserAvatarStorage{storage: storage.NewStorage()}
+}
+
+// SaveAvatar 每次上传使用新的文件名 避免浏览器与CDN缓存旧头像
+func (s *UserAvatarStorage) SaveAvatar(userID int64, data []byte, contentType string) (string, error) {
+	name, err := utils.GenRandomHex(8)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	key := fmt.Sprintf("avatars/%d/%s%s", userID, name, domain.AvatarContentTypes[contentType])
+	url, err := s.storage.Put(key, bytes.NewReader(data), contentType)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	return url, nil
+}
+
+func (s *UserAvatarStorage) RemoveAvatar(url string) error {
+	return s.storage.Remove(url)
+}
@@ -2,6 +2,7 @@ package adapters
 
 import (
 	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/types"
 	"scaffold/internal/common/orm"
 	"scaffold/internal/user/domain"
 )
@@ -13,16 +14,31 @@ func domainUserToORM(user *domain.User) *orm.User {
 
 	ormUser := &orm.User{
 		ID:       user.ID,
-		Email:    user.Email,
 		Nickname: user.Nickname,
 	}
 
+	if user.Email != "" {
+		ormUser.Email = null.StringFrom(user.Email)
+	}
+
+	if user.Avatar != "" {
+		ormUser.Avatar = null.StringFrom(user.Avatar)
+	}
+
 	if user.PasswordHash != "" {
 		ormUser.PasswordHash = null.StringFrom(user.PasswordHash)
 	}
 
-	if user.GithubID != "" {
-		ormUser.GithubID = null.StringFrom(user.GithubID)
+	if !user.EmailVerifiedAt.IsZero() {
+		ormUser.EmailVerifiedAt = null.TimeFrom(user.EmailVerifiedAt)
+	}
+
+	if user.Phone != "" {
+		ormUser.Phone = null.StringFrom(user.Phone)
+	}
+
+	if !user.PhoneVerifiedAt.IsZero() {
+		ormUser.PhoneVerifiedAt = null.TimeFrom(user.PhoneVerifiedAt)
 	}
 
 	return ormUser
@@ -35,8 +51,10 @@ func ormUserToDomain(ormUser *orm.User) *domain.User {
 
 	user := &domain.User{
 		ID:          ormUser.ID,
-		Email:       ormUser.Email,
+		Email:       ormUser.Email.String,
+		Phone:       ormUser.Phone.String,
 		Nickname:    ormUser.Nickname,
+		Avatar:      ormUser.Avatar.String,
 		CreatedAt:   ormUser.CreatedAt,
 		UpdatedAt:   ormUser.UpdatedAt,
 		LastLoginAt: ormUser.LastLoginAt,
@@ -46,9 +64,197 @@ func ormUserToDomain(ormUser *orm.User) *domain.User {
 		user.PasswordHash = ormUser.PasswordHash.String
 	}
 
-	if ormUser.GithubID.Valid {
-		user.GithubID = ormUser.GithubID.String
+	if ormUser.EmailVerifiedAt.Valid {
+		user.EmailVerifiedAt = ormUser.EmailVerifiedAt.Time
+	}
+
+	if ormUser.PhoneVerifiedAt.Valid {
+		user.PhoneVerifiedAt = ormUser.PhoneVerifiedAt.Time
+	}
+
+	if ormUser.DisabledAt.Valid {
+		user.DisabledAt = ormUser.DisabledAt.Time
+	}
+
+	if ormUser.DeletionScheduledAt.Valid {
+		user.DeletionScheduledAt = ormUser.DeletionScheduledAt.Time
 	}
 
 	return user
 }
+
+func domainIdentityToORM(identity *domain.UserIdentity) *orm.UserIdentity {
+	if identity == nil {
+		return nil
+	}
+
+	ormIdentity := &orm.UserIdentity{
+		ID:       identity.ID,
+		UserID:   identity.UserID,
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+	}
+
+	if identity.Email != "" {
+		ormIdentity.Email = null.StringFrom(identity.Email)
+	}
+
+	if !identity.LastUsedAt.IsZero() {
+		ormIdentity.LastUsedAt = null.TimeFrom(identity.LastUsedAt)
+	}
+
+	return ormIdentity
+}
+
+func ormIdentityToDomain(ormIdentity *orm.UserIdentity) *domain.UserIdentity {
+	if ormIdentity == nil {
+		return nil
+	}
+
+	identity := &domain.UserIdentity{
+		ID:        ormIdentity.ID,
+		UserID:    ormIdentity.UserID,
+		Provider:  ormIdentity.Provider,
+		Subject:   ormIdentity.Subject,
+		CreatedAt: ormIdentity.CreatedAt,
+		UpdatedAt: ormIdentity.UpdatedAt,
+	}
+
+	if ormIdentity.Email.Valid {
+		identity.Email = ormIdentity.Email.String
+	}
+
+	if ormIdentity.LastUsedAt.Valid {
+		identity.LastUsedAt = ormIdentity.LastUsedAt.Time
+	}
+
+	return identity
+}
+
+func domainAPIKeyToORM(key *domain.APIKey) *orm.APIKey {
+	if key == nil {
+		return nil
+	}
+
+	ormKey := &orm.APIKey{
+		ID:        key.ID,
+		UserID:    key.UserID,
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		TokenHash: key.TokenHash,
+		Scopes:    types.StringArray(key.Scopes),
+	}
+
+	if ormKey.Scopes == nil {
+		ormKey.Scopes = types.StringArray{}
+	}
+
+	if !key.ExpiresAt.IsZero() {
+		ormKey.ExpiresAt = null.TimeFrom(key.ExpiresAt)
+	}
+
+	return ormKey
+}
+
+func ormAPIKeyToDomain(ormKey *orm.APIKey) *domain.APIKey {
+	if ormKey == nil {
+		return nil
+	}
+
+	key := &domain.APIKey{
+		ID:        ormKey.ID,
+		UserID:    ormKey.UserID,
+		Name:      ormKey.Name,
+		Prefix:    ormKey.Prefix,
+		TokenHash: ormKey.TokenHash,
+		Scopes:    ormKey.Scopes,
+		CreatedAt: ormKey.CreatedAt,
+	}
+
+	if ormKey.ExpiresAt.Valid {
+		key.ExpiresAt = ormKey.ExpiresAt.Time
+	}
+
+	if ormKey.LastUsedAt.Valid {
+		key.LastUsedAt = ormKey.LastUsedAt.Time
+	}
+
+	return key
+}
+
+func domainPasskeyToORM(passkey *domain.Passkey) *orm.WebauthnCredential {
+	if passkey == nil {
+		return nil
+	}
+
+	ormPasskey := &orm.WebauthnCredential{
+		ID:              passkey.ID,
+		UserID:          passkey.UserID,
+		Name:            passkey.Name,
+		CredentialID:    passkey.CredentialID,
+		PublicKey:       passkey.PublicKey,
+		AttestationType: passkey.AttestationType,
+		Transports:      types.StringArray(passkey.Transports),
+		Aaguid:          passkey.AAGUID,
+		SignCount:       int64(passkey.SignCount),
+		BackupEligible:  passkey.BackupEligible,
+		BackupState:     passkey.BackupState,
+	}
+
+	if ormPasskey.Transports == nil {
+		ormPasskey.Transports = types.StringArray{}
+	}
+
+	if ormPasskey.Aaguid == nil {
+		ormPasskey.Aaguid = []byte{}
+	}
+
+	return ormPasskey
+}
+
+func ormPasskeyToDomain(ormPasskey *orm.WebauthnCredential) *domain.Passkey {
+	if ormPasskey == nil {
+		return nil
+	}
+
+	passkey := &domain.Passkey{
+		ID:              ormPasskey.ID,
+		UserID:          ormPasskey.UserID,
+		Name:            ormPasskey.Name,
+		CredentialID:    ormPasskey.CredentialID,
+		PublicKey:       ormPasskey.PublicKey,
+		AttestationType: ormPasskey.AttestationType,
+		Transports:      ormPasskey.Transports,
+		AAGUID:          ormPasskey.Aaguid,
+		SignCount:       uint32(ormPasskey.SignCount),
+		BackupEligible:  ormPasskey.BackupEligible,
+		BackupState:     ormPasskey.BackupState,
+		CreatedAt:       ormPasskey.CreatedAt,
+	}
+
+	if ormPasskey.LastUsedAt.Valid {
+		passkey.LastUsedAt = ormPasskey.LastUsedAt.Time
+	}
+
+	return passkey
+}
+
+func domainAuditLogToORM(log *domain.AuditLog) *orm.AuditLog {
+	if log == nil {
+		return nil
+	}
+
+	ormLog := &orm.AuditLog{
+		ActorID:   log.ActorID,
+		Action:    log.Action,
+		Reason:    log.Reason,
+		IP:        log.IP,
+		UserAgent: log.UserAgent,
+	}
+
+	if log.TargetUserID != 0 {
+		ormLog.TargetUserID = null.Int64From(log.TargetUserID)
+	}
+
+	return ormLog
+}
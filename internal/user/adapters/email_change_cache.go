@@ -0,0 +1,80 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/utils"
+	"scaffold/internal/user/domain"
+)
+
+type EmailChangeRedisCache struct {
+	client *redis.Client
+}
+
+func NewEmailChangeRedisCache() domain.EmailChangeCache {
+	return &EmailChangeRedisCache{client: getRedisClient()}
+}
+
+const (
+	keyEmailChangeToken          = "user:email_change:"
+	keyEmailChangeCooldown       = "user:email_change_cooldown:"
+	keyEmailChangeCooldownPeriod = time.Minute
+)
+
+func (ch *EmailChangeRedisCache) SaveToken(token string, ticket *domain.EmailChangeTicket) error {
+	ticketByte, err := json.Marshal(ticket)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// 只保存令牌摘要 缓存泄露时令牌仍不可用
+	key := utils.GetRedisKey(keyEmailChangeToken + utils.HashToken(token))
+	if err := ch.client.Set(context.Background(), key, ticketByte, domain.EmailChangeTokenExpire).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (ch *EmailChangeRedisCache) ConsumeToken(token string) (*domain.EmailChangeTicket, error) {
+	key := utils.GetRedisKey(keyEmailChangeToken + utils.HashToken(token))
+
+	// GETDEL 保证令牌只能被使用一次
+	result, err := ch.client.GetDel(context.Background(), key).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, codes.ErrEmailChangeTokenInvalid
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	ticket := new(domain.EmailChangeTicket)
+	if err := json.Unmarshal([]byte(result), ticket); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return ticket, nil
+}
+
+func (ch *EmailChangeRedisCache) AcquireCooldown(userID int64) (bool, time.Duration, error) {
+	key := utils.GetRedisKey(keyEmailChangeCooldown + strconv.FormatInt(userID, 10))
+
+	ok, err := ch.client.SetNX(context.Background(), key, 1, keyEmailChangeCooldownPeriod).Result()
+	if err != nil {
+		return false, 0, errors.WithStack(err)
+	}
+	if ok {
+		return true, 0, nil
+	}
+
+	ttl, err := ch.client.TTL(context.Background(), key).Result()
+	if err != nil {
+		return false, 0, errors.WithStack(err)
+	}
+	return false, ttl, nil
+}
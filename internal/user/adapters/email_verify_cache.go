@@ -0,0 +1,80 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/utils"
+	"scaffold/internal/user/domain"
+)
+
+type EmailVerifyRedisCache struct {
+	client *redis.Client
+}
+
+func NewEmailVerifyRedisCache() domain.EmailVerifyCache {
+	return &EmailVerifyRedisCache{client: getRedisClient()}
+}
+
+const (
+	keyEmailVerifyToken          = "user:email_verify:"
+	keyEmailVerifyCooldown       = "user:email_verify_cooldown:"
+	keyEmailVerifyCooldownPeriod = time.Minute
+)
+
+func (ch *EmailVerifyRedisCache) SaveToken(token string, ticket *domain.EmailVerifyTicket) error {
+	ticketByte, err := json.Marshal(ticket)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// 只保存令牌摘要 缓存泄露时令牌仍不可用
+	key := utils.GetRedisKey(keyEmailVerifyToken + utils.HashToken(token))
+	if err := ch.client.Set(context.Background(), key, ticketByte, domain.EmailVerifyTokenExpire).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (ch *EmailVerifyRedisCache) ConsumeToken(token string) (*domain.EmailVerifyTicket, error) {
+	key := utils.GetRedisKey(keyEmailVerifyToken + utils.HashToken(token))
+
+	// GETDEL 保证令牌只能被使用一次
+	result, err := ch.client.GetDel(context.Background(), key).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, codes.ErrEmailVerifyTokenInvalid
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	ticket := new(domain.EmailVerifyTicket)
+	if err := json.Unmarshal([]byte(result), ticket); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return ticket, nil
+}
+
+func (ch *EmailVerifyRedisCache) AcquireResendCooldown(userID int64) (bool, time.Duration, error) {
+	key := utils.GetRedisKey(keyEmailVerifyCooldown + strconv.FormatInt(userID, 10))
+
+	ok, err := ch.client.SetNX(context.Background(), key, 1, keyEmailVerifyCooldownPeriod).Result()
+	if err != nil {
+		return false, 0, errors.WithStack(err)
+	}
+	if ok {
+		return true, 0, nil
+	}
+
+	ttl, err := ch.client.TTL(context.Background(), key).Result()
+	if err != nil {
+		return false, 0, errors.WithStack(err)
+	}
+	return false, ttl, nil
+}
@@ -0,0 +1,86 @@
+package adapters
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/pkg/errors"
+	"scaffold/internal/common/reskit/codes"
+	"time"
+
+	"scaffold/internal/common/orm"
+	"scaffold/internal/user/domain"
+)
+
+type UserIdentityPSQLRepository struct {
+}
+
+func NewUserIdentityPSQLRepository() domain.UserIdentityRepository {
+	return &UserIdentityPSQLRepository{}
+}
+
+func (r *UserIdentityPSQLRepository) FindByProviderSubject(provider, subject string) (*domain.UserIdentity, error) {
+	ormIdentity, err := orm.UserIdentities(
+		orm.UserIdentityWhere.Provider.EQ(provider),
+		orm.UserIdentityWhere.Subject.EQ(subject),
+	).OneG()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrIdentityNotFound
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return ormIdentityToDomain(ormIdentity), nil
+}
+
+func (r *UserIdentityPSQLRepository) ListByUserID(userID int64) ([]*domain.UserIdentity, error) {
+	ormIdentities, err := orm.UserIdentities(
+		orm.UserIdentityWhere.UserID.EQ(userID),
+		qm.OrderBy(orm.UserIdentityColumns.CreatedAt+" ASC"),
+	).AllG()
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	identities := make([]*domain.UserIdentity, 0, len(ormIdentities))
+	for _, ormIdentity := range ormIdentities {
+		identities = append(identities, ormIdentityToDomain(ormIdentity))
+	}
+	return identities, nil
+}
+
+func (r *UserIdentityPSQLRepository) Create(identity *domain.UserIdentity) (*domain.UserIdentity, error) {
+	ormIdentity := domainIdentityToORM(identity)
+
+	if err := ormIdentity.InsertG(boil.Infer()); err != nil {
+		return nil, fmt.Errorf("failed to create user identity: %w", err)
+	}
+
+	return ormIdentityToDomain(ormIdentity), nil
+}
+
+func (r *UserIdentityPSQLRepository) Delete(userID, id int64) error {
+	rows, err := orm.UserIdentities(
+		orm.UserIdentityWhere.ID.EQ(id),
+		orm.UserIdentityWhere.UserID.EQ(userID),
+	).DeleteAllG()
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	if rows == 0 {
+		return codes.ErrIdentityNotFound
+	}
+	return nil
+}
+
+func (r *UserIdentityPSQLRepository) UpdateLastUsed(id int64) error {
+	_, err := orm.UserIdentities(orm.UserIdentityWhere.ID.EQ(id)).UpdateAllG(orm.M{
+		orm.UserIdentityColumns.LastUsedAt: null.TimeFrom(time.Now()),
+	})
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	return nil
+}
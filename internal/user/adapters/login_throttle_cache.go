@@ -0,0 +1,162 @@
+package adapters
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+
+	"scaffold/internal/common/utils"
+	"scaffold/internal/user/domain"
+)
+
+type LoginThrottleRedisCache struct {
+	client *redis.Client
+}
+
+func NewLoginThrottleRedisCache() domain.LoginThrottleCache {
+	return &LoginThrottleRedisCache{client: getRedisClient()}
+}
+
+// 键中的账号与IP均使用摘要 避免在缓存中明文保存
+const (
+	keyLoginFailures   = "user:login_failures:"
+	keyLoginIPFailures = "user:login_ip_failures:"
+	keyLoginLock       = "user:login_lock:"
+	keyLoginDelay      = "user:login_delay:"
+	keyIPThrottle      = "user:throttle:"
+)
+
+func (ch *LoginThrottleRedisCache) GetFailures(account, ip string) (int64, int64, error) {
+	keys := []string{utils.GetRedisKey(keyLoginFailures + utils.HashToken(account))}
+	if ip != "" {
+		keys = append(keys, utils.GetRedisKey(keyLoginIPFailures+utils.HashToken(ip)))
+	}
+
+	values, err := ch.client.MGet(context.Background(), keys...).Result()
+	if err != nil {
+		return 0, 0, errors.WithStack(err)
+	}
+
+	counts := make([]int64, 2)
+	for i, value := range values {
+		if value == nil {
+			continue
+		}
+		str, _ := value.(string)
+		count, err := strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			return 0, 0, errors.WithStack(err)
+		}
+		counts[i] = count
+	}
+	return counts[0], counts[1], nil
+}
+
+func (ch *LoginThrottleRedisCache) IncrFailures(account, ip string) (int64, int64, error) {
+	accountKey := utils.GetRedisKey(keyLoginFailures + utils.HashToken(account))
+
+	// 账号计数每次失败都顺延窗口
+	pipe := ch.client.TxPipeline()
+	accountIncr := pipe.Incr(context.Background(), accountKey)
+	pipe.Expire(context.Background(), accountKey, domain.LoginFailureWindow)
+	if _, err := pipe.Exec(context.Background()); err != nil {
+		return 0, 0, errors.WithStack(err)
+	}
+
+	if ip == "" {
+		return accountIncr.Val(), 0, nil
+	}
+
+	// IP计数使用固定窗口 避免被持续顺延
+	ipFailures, err := ch.incrWindow(utils.GetRedisKey(keyLoginIPFailures+utils.HashToken(ip)), domain.LoginFailureWindow)
+	if err != nil {
+		return 0, 0, err
+	}
+	return accountIncr.Val(), ipFailures, nil
+}
+
+func (ch *LoginThrottleRedisCache) ResetFailures(account string) error {
+	accountHash := utils.HashToken(account)
+
+	err := ch.client.Del(context.Background(),
+		utils.GetRedisKey(keyLoginFailures+accountHash),
+		utils.GetRedisKey(keyLoginDelay+accountHash),
+	).Err()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (ch *LoginThrottleRedisCache) IPFailuresTTL(ip string) (time.Duration, error) {
+	ttl, err := ch.client.TTL(context.Background(), utils.GetRedisKey(keyLoginIPFailures+utils.HashToken(ip))).Result()
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return max(ttl, 0), nil
+}
+
+func (ch *LoginThrottleRedisCache) Lock(account string, duration time.Duration) error {
+	key := utils.GetRedisKey(keyLoginLock + utils.HashToken(account))
+	if err := ch.client.Set(context.Background(), key, 1, duration).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (ch *LoginThrottleRedisCache) SetDelay(account string, delay time.Duration) error {
+	key := utils.GetRedisKey(keyLoginDelay + utils.HashToken(account))
+	if err := ch.client.Set(context.Background(), key, 1, delay).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (ch *LoginThrottleRedisCache) Blocked(account string) (time.Duration, time.Duration, error) {
+	accountHash := utils.HashToken(account)
+
+	pipe := ch.client.Pipeline()
+	lockTTL := pipe.PTTL(context.Background(), utils.GetRedisKey(keyLoginLock+accountHash))
+	delayTTL := pipe.PTTL(context.Background(), utils.GetRedisKey(keyLoginDelay+accountHash))
+	if _, err := pipe.Exec(context.Background()); err != nil {
+		return 0, 0, errors.WithStack(err)
+	}
+
+	// 键不存在时 PTTL 返回负数
+	return max(lockTTL.Val(), 0), max(delayTTL.Val(), 0), nil
+}
+
+func (ch *LoginThrottleRedisCache) IncrIPRequests(rule domain.IPThrottleRule, ip string) (int64, time.Duration, error) {
+	key := utils.GetRedisKey(keyIPThrottle + rule.Scope + ":" + utils.HashToken(ip))
+
+	count, err := ch.incrWindow(key, rule.Window)
+	if err != nil {
+		return 0, 0, err
+	}
+	if count <= rule.Limit {
+		return count, 0, nil
+	}
+
+	ttl, err := ch.client.PTTL(context.Background(), key).Result()
+	if err != nil {
+		return 0, 0, errors.WithStack(err)
+	}
+	return count, max(ttl, 0), nil
+}
+
+// incrWindow 固定窗口 只在首次计数时设置过期时间
+func (ch *LoginThrottleRedisCache) incrWindow(key string, window time.Duration) (int64, error) {
+	count, err := ch.client.Incr(context.Background(), key).Result()
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	if count == 1 {
+		if err := ch.client.Expire(context.Background(), key, window).Err(); err != nil {
+			return 0, errors.WithStack(err)
+		}
+	}
+	return count, nil
+}
@@ -0,0 +1,142 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/utils"
+	"scaffold/internal/user/domain"
+)
+
+type MagicLinkRedisCache struct {
+	client *redis.Client
+}
+
+func NewMagicLinkRedisCache() domain.MagicLinkCache {
+	return &MagicLinkRedisCache{client: getRedisClient()}
+}
+
+// 键中的邮箱与IP均使用摘要 避免在缓存中明文保存
+const (
+	keyMagicLinkTicket   = "user:magic_link:"
+	keyMagicLinkToken    = "user:magic_link_token:"
+	keyMagicLinkAttempts = "user:magic_link_attempts:"
+	keyMagicLinkCooldown = "user:magic_link_cooldown:"
+	keyMagicLinkIP       = "user:magic_link_ip:"
+)
+
+func (ch *MagicLinkRedisCache) SaveTicket(ticket *domain.MagicLinkTicket) error {
+	ticketByte, err := json.Marshal(ticket)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	emailHash := utils.HashToken(ticket.Email)
+
+	// 重新发送时清空错误次数 旧链接的索引留待过期 使用时会因摘要不一致而失效
+	pipe := ch.client.TxPipeline()
+	pipe.Set(context.Background(), utils.GetRedisKey(keyMagicLinkTicket+emailHash), ticketByte, domain.MagicLinkExpire)
+	pipe.Set(context.Background(), utils.GetRedisKey(keyMagicLinkToken+ticket.TokenHash), ticket.Email, domain.MagicLinkExpire)
+	pipe.Del(context.Background(), utils.GetRedisKey(keyMagicLinkAttempts+emailHash))
+	if _, err := pipe.Exec(context.Background()); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (ch *MagicLinkRedisCache) GetTicket(email string) (*domain.MagicLinkTicket, error) {
+	key := utils.GetRedisKey(keyMagicLinkTicket + utils.HashToken(email))
+
+	result, err := ch.client.Get(context.Background(), key).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, codes.ErrMagicLinkInvalid
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	ticket := new(domain.MagicLinkTicket)
+	if err := json.Unmarshal([]byte(result), ticket); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return ticket, nil
+}
+
+func (ch *MagicLinkRedisCache) GetEmailByToken(token string) (string, error) {
+	email, err := ch.client.Get(context.Background(), utils.GetRedisKey(keyMagicLinkToken+utils.HashToken(token))).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", codes.ErrMagicLinkInvalid
+		}
+		return "", errors.WithStack(err)
+	}
+	return email, nil
+}
+
+func (ch *MagicLinkRedisCache) IncrAttempts(email string) (int64, error) {
+	key := utils.GetRedisKey(keyMagicLinkAttempts + utils.HashToken(email))
+
+	pipe := ch.client.TxPipeline()
+	incr := pipe.Incr(context.Background(), key)
+	pipe.Expire(context.Background(), key, domain.MagicLinkExpire)
+	if _, err := pipe.Exec(context.Background()); err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return incr.Val(), nil
+}
+
+func (ch *MagicLinkRedisCache) RemoveTicket(email string) error {
+	emailHash := utils.HashToken(email)
+
+	deleted, err := ch.client.Del(context.Background(), utils.GetRedisKey(keyMagicLinkTicket+emailHash)).Result()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if deleted == 0 {
+		return codes.ErrMagicLinkInvalid
+	}
+
+	if err := ch.client.Del(context.Background(), utils.GetRedisKey(keyMagicLinkAttempts+emailHash)).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (ch *MagicLinkRedisCache) AcquireCooldown(email string) (bool, time.Duration, error) {
+	key := utils.GetRedisKey(keyMagicLinkCooldown + utils.HashToken(email))
+
+	ok, err := ch.client.SetNX(context.Background(), key, 1, domain.MagicLinkCooldown).Result()
+	if err != nil {
+		return false, 0, errors.WithStack(err)
+	}
+	if ok {
+		return true, 0, nil
+	}
+
+	ttl, err := ch.client.TTL(context.Background(), key).Result()
+	if err != nil {
+		return false, 0, errors.WithStack(err)
+	}
+	return false, ttl, nil
+}
+
+func (ch *MagicLinkRedisCache) IncrIPRequests(ip string) (int64, error) {
+	key := utils.GetRedisKey(keyMagicLinkIP + utils.HashToken(ip))
+
+	// 固定窗口 只在首次计数时设置过期时间
+	count, err := ch.client.Incr(context.Background(), key).Result()
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	if count == 1 {
+		if err := ch.client.Expire(context.Background(), key, domain.MagicLinkIPWindow).Err(); err != nil {
+			return 0, errors.WithStack(err)
+		}
+	}
+	return count, nil
+}
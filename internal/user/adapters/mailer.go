@@ -0,0 +1,89 @@
+package adapters
+
+import (
+	"embed"
+	"html/template"
+
+	"github.com/pkg/errors"
+
+	"scaffold/internal/common/email"
+	"scaffold/internal/user/domain"
+)
+
+//go:embed templates/*.html
+var templateFS embed.FS
+
+const (
+	templateEmailVerify   = "email_verify.html"
+	templatePasswordReset = "password_reset.html"
+	templateMagicLink     = "magic_link.html"
+	templateEmailChange   = "email_change.html"
+)
+
+type UserEmailMailer struct {
+	mailer email.Mailer
+}
+
+func NewUserMailer() domain.UserMailer {
+	templates := template.Must(template.ParseFS(templateFS, "templates/*.html"))
+
+	templatesMap := make(map[string]*template.Template)
+	for _, tmpl := range templates.Templates() {
+		templatesMap[tmpl.Name()] = tmpl
+	}
+
+	return &UserEmailMailer{mailer: email.NewMailer(templatesMap)}
+}
+
+func (m *UserEmailMailer) SendEmailVerification(to, nickname, link string) error {
+	data := map[string]any{
+		"Nickname":    nickname,
+		"Link":        link,
+		"ExpireHours": int(domain.EmailVerifyTokenExpire.Hours()),
+	}
+
+	if err := m.mailer.SendWithTemplate(to, "验证你的邮箱", templateEmailVerify, data); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (m *UserEmailMailer) SendPasswordReset(to, nickname, link string) error {
+	data := map[string]any{
+		"Nickname":      nickname,
+		"Link":          link,
+		"ExpireMinutes": int(domain.PasswordResetTokenExpire.Minutes()),
+	}
+
+	if err := m.mailer.SendWithTemplate(to, "重置你的密码", templatePasswordReset, data); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (m *UserEmailMailer) SendMagicLink(to, link, code string) error {
+	data := map[string]any{
+		"Link":          link,
+		"Code":          code,
+		"ExpireMinutes": int(domain.MagicLinkExpire.Minutes()),
+	}
+
+	if err := m.mailer.SendWithTemplate(to, "登录你的账号", templateMagicLink, data); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (m *UserEmailMailer) SendEmailChange(to, nickname, link string) error {
+	data := map[string]any{
+		"Nickname":      nickname,
+		"Email":         to,
+		"Link":          link,
+		"ExpireMinutes": int(domain.EmailChangeTokenExpire.Minutes()),
+	}
+
+	if err := m.mailer.SendWithTemplate(to, "确认更换邮箱", templateEmailChange, data); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
@@ -0,0 +1,12 @@
+package adapters
+
+import (
+	"testing"
+
+	"scaffold/internal/common/testkit"
+)
+
+// TestMain 缓存共享进程内唯一的 Redis 连接 整个包以同一个 miniredis 实例代替 Redis 服务
+func TestMain(m *testing.M) {
+	testkit.RunWithRedis(m)
+}
@@ -0,0 +1,143 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/utils"
+	"scaffold/internal/user/domain"
+)
+
+type MFARedisCache struct {
+	client *redis.Client
+}
+
+func NewMFARedisCache() domain.MFACache {
+	return &MFARedisCache{client: getRedisClient()}
+}
+
+const (
+	keyMFAPending         = "user:mfa_pending:"
+	keyMFAPendingAttempts = "user:mfa_pending_attempts:"
+	keyMFAUsedCode        = "user:mfa_used_code:"
+	keyMFAUserFailures    = "user:mfa_failures:"
+)
+
+func (ch *MFARedisCache) SavePendingToken(token string, ticket *domain.MFAPendingTicket) error {
+	ticketByte, err := json.Marshal(ticket)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	key := utils.GetRedisKey(keyMFAPending + utils.HashToken(token))
+	if err := ch.client.Set(context.Background(), key, ticketByte, domain.MFAPendingExpire).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (ch *MFARedisCache) GetPendingToken(token string) (*domain.MFAPendingTicket, error) {
+	key := utils.GetRedisKey(keyMFAPending + utils.HashToken(token))
+
+	result, err := ch.client.Get(context.Background(), key).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, codes.ErrMFATokenInvalid
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	ticket := new(domain.MFAPendingTicket)
+	if err := json.Unmarshal([]byte(result), ticket); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return ticket, nil
+}
+
+func (ch *MFARedisCache) IncrPendingAttempts(token string) (int64, error) {
+	key := utils.GetRedisKey(keyMFAPendingAttempts + utils.HashToken(token))
+
+	pipe := ch.client.TxPipeline()
+	incr := pipe.Incr(context.Background(), key)
+	pipe.Expire(context.Background(), key, domain.MFAPendingExpire)
+	if _, err := pipe.Exec(context.Background()); err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return incr.Val(), nil
+}
+
+// RemovePendingToken 并发校验时只有删除成功的一方可以继续 令牌已不存在时返回错误
+func (ch *MFARedisCache) RemovePendingToken(token string) error {
+	hash := utils.HashToken(token)
+
+	deleted, err := ch.client.Del(context.Background(), utils.GetRedisKey(keyMFAPending+hash)).Result()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if deleted == 0 {
+		return codes.ErrMFATokenInvalid
+	}
+
+	if err := ch.client.Del(context.Background(), utils.GetRedisKey(keyMFAPendingAttempts+hash)).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (ch *MFARedisCache) IncrUserFailures(userID int64) (int64, error) {
+	key := utils.GetRedisKey(keyMFAUserFailures + strconv.FormatInt(userID, 10))
+
+	pipe := ch.client.TxPipeline()
+	incr := pipe.Incr(context.Background(), key)
+	pipe.Expire(context.Background(), key, domain.MFAUserLockWindow)
+	if _, err := pipe.Exec(context.Background()); err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return incr.Val(), nil
+}
+
+func (ch *MFARedisCache) GetUserFailures(userID int64) (int64, time.Duration, error) {
+	key := utils.GetRedisKey(keyMFAUserFailures + strconv.FormatInt(userID, 10))
+
+	pipe := ch.client.Pipeline()
+	get := pipe.Get(context.Background(), key)
+	ttl := pipe.PTTL(context.Background(), key)
+	if _, err := pipe.Exec(context.Background()); err != nil && !errors.Is(err, redis.Nil) {
+		return 0, 0, errors.WithStack(err)
+	}
+
+	failures, err := get.Int64()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, 0, nil
+		}
+		return 0, 0, errors.WithStack(err)
+	}
+	// 键不存在时 PTTL 返回负数
+	return failures, max(ttl.Val(), 0), nil
+}
+
+func (ch *MFARedisCache) ResetUserFailures(userID int64) error {
+	key := utils.GetRedisKey(keyMFAUserFailures + strconv.FormatInt(userID, 10))
+
+	if err := ch.client.Del(context.Background(), key).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (ch *MFARedisCache) MarkCodeUsed(userID int64, code string) (bool, error) {
+	key := utils.GetRedisKey(keyMFAUsedCode + strconv.FormatInt(userID, 10) + ":" + code)
+
+	ok, err := ch.client.SetNX(context.Background(), key, 1, domain.MFACodeReplayWindow).Result()
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	return ok, nil
+}
@@ -0,0 +1,154 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/pkg/errors"
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/utils"
+	"time"
+
+	"scaffold/internal/common/orm"
+	"scaffold/internal/user/domain"
+)
+
+// UserMFAPSQLRepository TOTP密钥以AES-256加密后落库 数据库泄露时无法直接生成验证码
+type UserMFAPSQLRepository struct {
+	encryptor *utils.AES256Encryptor
+}
+
+func NewUserMFAPSQLRepository() domain.UserMFARepository {
+	encryptor, err := utils.NewAES256Encryptor(utils.GetEnv("MFA_ENCRYPTION_KEY"))
+	if err != nil {
+		panic(errors.WithMessage(err, "MFA_ENCRYPTION_KEY 需为base64编码的32字节密钥"))
+	}
+
+	return &UserMFAPSQLRepository{encryptor: encryptor}
+}
+
+func (r *UserMFAPSQLRepository) FindByUserID(userID int64) (*domain.UserMFA, error) {
+	ormMFA, err := orm.UserMfas(orm.UserMfaWhere.UserID.EQ(userID)).OneG()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrMFANotEnrolled
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	secret, err := r.encryptor.Decrypt(ormMFA.TotpSecret)
+	if err != nil {
+		return nil, errors.WithMessage(err, "解密TOTP密钥失败")
+	}
+
+	mfa := &domain.UserMFA{
+		UserID:    ormMFA.UserID,
+		Secret:    secret,
+		CreatedAt: ormMFA.CreatedAt,
+		UpdatedAt: ormMFA.UpdatedAt,
+	}
+	if ormMFA.EnabledAt.Valid {
+		mfa.EnabledAt = ormMFA.EnabledAt.Time
+	}
+	return mfa, nil
+}
+
+func (r *UserMFAPSQLRepository) Save(mfa *domain.UserMFA) error {
+	secret, err := r.encryptor.Encrypt(mfa.Secret)
+	if err != nil {
+		return errors.WithMessage(err, "加密TOTP密钥失败")
+	}
+
+	ormMFA := &orm.UserMfa{
+		UserID:     mfa.UserID,
+		TotpSecret: secret,
+		UpdatedAt:  time.Now(),
+	}
+	if !mfa.EnabledAt.IsZero() {
+		ormMFA.EnabledAt = null.TimeFrom(mfa.EnabledAt)
+	}
+
+	if err := ormMFA.UpsertG(true,
+		[]string{orm.UserMfaColumns.UserID},
+		boil.Whitelist(orm.UserMfaColumns.TotpSecret, orm.UserMfaColumns.EnabledAt, orm.UserMfaColumns.UpdatedAt),
+		boil.Infer(),
+	); err != nil {
+		return fmt.Errorf("failed to save user mfa: %w", err)
+	}
+	return nil
+}
+
+func (r *UserMFAPSQLRepository) Delete(userID int64) error {
+	tx, err := boil.BeginTx(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := orm.UserRecoveryCodes(orm.UserRecoveryCodeWhere.UserID.EQ(userID)).DeleteAll(tx); err != nil {
+		return fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+	if _, err := orm.UserMfas(orm.UserMfaWhere.UserID.EQ(userID)).DeleteAll(tx); err != nil {
+		return fmt.Errorf("failed to delete user mfa: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (r *UserMFAPSQLRepository) ReplaceRecoveryCodes(userID int64, codeHashes []string) error {
+	tx, err := boil.BeginTx(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := orm.UserRecoveryCodes(orm.UserRecoveryCodeWhere.UserID.EQ(userID)).DeleteAll(tx); err != nil {
+		return fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+
+	for _, codeHash := range codeHashes {
+		ormCode := &orm.UserRecoveryCode{
+			UserID:   userID,
+			CodeHash: codeHash,
+		}
+		if err := ormCode.Insert(tx, boil.Infer()); err != nil {
+			return fmt.Errorf("failed to create recovery code: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// UseRecoveryCode 条件更新保证并发请求中只有一个能使用成功
+func (r *UserMFAPSQLRepository) UseRecoveryCode(userID int64, codeHash string) (bool, error) {
+	rows, err := orm.UserRecoveryCodes(
+		orm.UserRecoveryCodeWhere.UserID.EQ(userID),
+		orm.UserRecoveryCodeWhere.CodeHash.EQ(codeHash),
+		orm.UserRecoveryCodeWhere.UsedAt.IsNull(),
+	).UpdateAllG(orm.M{
+		orm.UserRecoveryCodeColumns.UsedAt: null.TimeFrom(time.Now()),
+	})
+	if err != nil {
+		return false, fmt.Errorf("database error: %w", err)
+	}
+	return rows > 0, nil
+}
+
+func (r *UserMFAPSQLRepository) CountRecoveryCodes(userID int64) (int64, error) {
+	count, err := orm.UserRecoveryCodes(
+		orm.UserRecoveryCodeWhere.UserID.EQ(userID),
+		orm.UserRecoveryCodeWhere.UsedAt.IsNull(),
+	).CountG()
+	if err != nil {
+		return 0, fmt.Errorf("database error: %w", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,137 @@
+package adapters
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/user/domain"
+)
+
+const (
+	githubAuthorizeURL = "https://github.com/login/oauth/authorize"
+	githubTokenURL     = "https://github.com/login/oauth/access_token"
+	githubUserURL      = "https://api.github.com/user"
+	githubUserEmailURL = "https://api.github.com/user/emails"
+)
+
+type githubUser struct {
+	ID        int64  `json:"id"`
+	Login     string `json:"login"`
+	Name      string `json:"name"`
+	AvatarURL string `json:"avatar_url"`
+}
+
+type githubEmail struct {
+	Email    string `json:"email"`
+	Primary  bool   `json:"primary"`
+	Verified bool   `json:"verified"`
+}
+
+type GithubProvider struct {
+	clientID     string
+	clientSecret string
+	redirectURL  string
+}
+
+func NewGithubProvider(clientID, clientSecret, redirectURL string) domain.OAuthProvider {
+	return &GithubProvider{
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		redirectURL:  redirectURL,
+	}
+}
+
+func (p *GithubProvider) Name() string {
+	return domain.OAuthProviderGithub
+}
+
+func (p *GithubProvider) AuthCodeURL(state, codeChallenge string) (string, error) {
+	return buildAuthCodeURL(githubAuthorizeURL, map[string]string{
+		"client_id":    p.clientID,
+		"redirect_uri": p.redirectURL,
+		"scope":        "read:user user:email",
+	}, state, codeChallenge), nil
+}
+
+// Exchange GitHub API 调用逻辑 - 返回包装好的领域错误
+func (p *GithubProvider) Exchange(code, codeVerifier string) (*domain.OAuthUserInfo, error) {
+	form := map[string]string{
+		"client_id":     p.clientID,
+		"client_secret": p.clientSecret,
+		"code":          code,
+		"code_verifier": codeVerifier,
+	}
+	if p.redirectURL != "" {
+		form["redirect_uri"] = p.redirectURL
+	}
+
+	accessToken, err := exchangeAuthorizationCode(githubTokenURL, form)
+	if err != nil {
+		return nil, errors.WithStack(codes.ErrGitHubAPIError.WithSlug("get_access_token 获取失败").WithCause(err))
+	}
+
+	userInfo, err := p.fetchUserInfo(accessToken)
+	if err != nil {
+		return nil, errors.WithStack(codes.ErrGitHubAPIError.WithSlug("get_user_info 获取失败").WithCause(err))
+	}
+
+	return userInfo, nil
+}
+
+func (p *GithubProvider) fetchUserInfo(accessToken string) (*domain.OAuthUserInfo, error) {
+	var user githubUser
+
+	res, err := oauthClient.R().
+		SetAuthToken(accessToken).
+		SetHeader("Accept", "application/vnd.github+json").
+		SetResult(&user).
+		Get(githubUserURL)
+	if err != nil {
+		return nil, err
+	}
+	if res.IsError() || user.ID == 0 {
+		return nil, codes.ErrOAuthUserInfoMissing
+	}
+
+	// 公开邮箱不一定是主邮箱 统一通过邮箱接口获取已验证的主邮箱
+	email, err := p.fetchPrimaryEmail(accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.OAuthUserInfo{
+		Provider:      domain.OAuthProviderGithub,
+		ID:            strconv.FormatInt(user.ID, 10),
+		Login:         user.Login,
+		Nickname:      user.Name,
+		Email:         email,
+		EmailVerified: email != "",
+		Avatar:        user.AvatarURL,
+	}, nil
+}
+
+func (p *GithubProvider) fetchPrimaryEmail(accessToken string) (string, error) {
+	var emails []githubEmail
+
+	res, err := oauthClient.R().
+		SetAuthToken(accessToken).
+		SetHeader("Accept", "application/vnd.github+json").
+		SetResult(&emails).
+		Get(githubUserEmailURL)
+	if err != nil {
+		return "", err
+	}
+	// 未授权 user:email scope 时接口不可用 按无邮箱处理
+	if res.IsError() {
+		return "", nil
+	}
+
+	for _, e := range emails {
+		if e.Primary && e.Verified {
+			return e.Email, nil
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,60 @@
+package adapters
+
+import (
+	"github.com/pkg/errors"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/user/domain"
+)
+
+const (
+	googleAuthorizeURL = "https://accounts.google.com/o/oauth2/v2/auth"
+	googleTokenURL     = "https://oauth2.googleapis.com/token"
+	googleUserInfoURL  = "https://openidconnect.googleapis.com/v1/userinfo"
+)
+
+type GoogleProvider struct {
+	clientID     string
+	clientSecret string
+	redirectURL  string
+}
+
+func NewGoogleProvider(clientID, clientSecret, redirectURL string) domain.OAuthProvider {
+	return &GoogleProvider{
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		redirectURL:  redirectURL,
+	}
+}
+
+func (p *GoogleProvider) Name() string {
+	return domain.OAuthProviderGoogle
+}
+
+func (p *GoogleProvider) AuthCodeURL(state, codeChallenge string) (string, error) {
+	return buildAuthCodeURL(googleAuthorizeURL, map[string]string{
+		"client_id":    p.clientID,
+		"redirect_uri": p.redirectURL,
+		"scope":        "openid email profile",
+	}, state, codeChallenge), nil
+}
+
+func (p *GoogleProvider) Exchange(code, codeVerifier string) (*domain.OAuthUserInfo, error) {
+	accessToken, err := exchangeAuthorizationCode(googleTokenURL, map[string]string{
+		"client_id":     p.clientID,
+		"client_secret": p.clientSecret,
+		"redirect_uri":  p.redirectURL,
+		"code":          code,
+		"code_verifier": codeVerifier,
+	})
+	if err != nil {
+		return nil, errors.WithStack(codes.ErrGoogleAPIError.WithSlug("get_access_token 获取失败").WithCause(err))
+	}
+
+	claims, err := fetchOIDCUserInfo(googleUserInfoURL, accessToken)
+	if err != nil {
+		return nil, errors.WithStack(codes.ErrGoogleAPIError.WithSlug("get_user_info 获取失败").WithCause(err))
+	}
+
+	return claims.toUserInfo(domain.OAuthProviderGoogle), nil
+}
@@ -0,0 +1,154 @@
+package adapters
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/user/domain"
+)
+
+// oidcDiscovery /.well-known/openid-configuration 中用到的字段
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+}
+
+// oidcUserInfo 标准 userinfo 声明
+type oidcUserInfo struct {
+	Subject           string `json:"sub"`
+	Name              string `json:"name"`
+	PreferredUsername string `json:"preferred_username"`
+	Email             string `json:"email"`
+	EmailVerified     bool   `json:"email_verified"`
+	Picture           string `json:"picture"`
+}
+
+func (c *oidcUserInfo) toUserInfo(provider string) *domain.OAuthUserInfo {
+	info := &domain.OAuthUserInfo{
+		Provider: provider,
+		ID:       c.Subject,
+		Login:    c.PreferredUsername,
+		Nickname: c.Name,
+		Avatar:   c.Picture,
+	}
+
+	// 未经提供商验证的邮箱不可用于关联已有账号
+	if c.EmailVerified && c.Email != "" {
+		info.Email = c.Email
+		info.EmailVerified = true
+	}
+	return info
+}
+
+func fetchOIDCUserInfo(userInfoURL, accessToken string) (*oidcUserInfo, error) {
+	var claims oidcUserInfo
+
+	res, err := oauthClient.R().
+		SetAuthToken(accessToken).
+		SetHeader("Accept", "application/json").
+		SetResult(&claims).
+		Get(userInfoURL)
+	if err != nil {
+		return nil, err
+	}
+	if res.IsError() || claims.Subject == "" {
+		return nil, codes.ErrOAuthUserInfoMissing
+	}
+
+	return &claims, nil
+}
+
+// OIDCProvider 通过 discovery 文档接入任意 OpenID Connect 提供商
+type OIDCProvider struct {
+	issuer       string
+	clientID     string
+	clientSecret string
+	redirectURL  string
+	scopes       string
+
+	mu        sync.Mutex
+	discovery *oidcDiscovery
+}
+
+func NewOIDCProvider(issuer, clientID, clientSecret, redirectURL, scopes string) domain.OAuthProvider {
+	return &OIDCProvider{
+		issuer:       strings.TrimSuffix(issuer, "/"),
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		redirectURL:  redirectURL,
+		scopes:       scopes,
+	}
+}
+
+func (p *OIDCProvider) Name() string {
+	return domain.OAuthProviderOIDC
+}
+
+func (p *OIDCProvider) AuthCodeURL(state, codeChallenge string) (string, error) {
+	discovery, err := p.getDiscovery()
+	if err != nil {
+		return "", errors.WithStack(codes.ErrOIDCAPIError.WithSlug("discovery 获取失败").WithCause(err))
+	}
+
+	return buildAuthCodeURL(discovery.AuthorizationEndpoint, map[string]string{
+		"client_id":    p.clientID,
+		"redirect_uri": p.redirectURL,
+		"scope":        p.scopes,
+	}, state, codeChallenge), nil
+}
+
+func (p *OIDCProvider) Exchange(code, codeVerifier string) (*domain.OAuthUserInfo, error) {
+	discovery, err := p.getDiscovery()
+	if err != nil {
+		return nil, errors.WithStack(codes.ErrOIDCAPIError.WithSlug("discovery 获取失败").WithCause(err))
+	}
+
+	accessToken, err := exchangeAuthorizationCode(discovery.TokenEndpoint, map[string]string{
+		"client_id":     p.clientID,
+		"client_secret": p.clientSecret,
+		"redirect_uri":  p.redirectURL,
+		"code":          code,
+		"code_verifier": codeVerifier,
+	})
+	if err != nil {
+		return nil, errors.WithStack(codes.ErrOIDCAPIError.WithSlug("get_access_token 获取失败").WithCause(err))
+	}
+
+	claims, err := fetchOIDCUserInfo(discovery.UserinfoEndpoint, accessToken)
+	if err != nil {
+		return nil, errors.WithStack(codes.ErrOIDCAPIError.WithSlug("get_user_info 获取失败").WithCause(err))
+	}
+
+	return claims.toUserInfo(domain.OAuthProviderOIDC), nil
+}
+
+// getDiscovery 首次使用时拉取并缓存 失败时下次请求重试 避免启动时依赖提供商可用
+func (p *OIDCProvider) getDiscovery() (*oidcDiscovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	var discovery oidcDiscovery
+	res, err := oauthClient.R().
+		SetHeader("Accept", "application/json").
+		SetResult(&discovery).
+		Get(p.issuer + "/.well-known/openid-configuration")
+	if err != nil {
+		return nil, err
+	}
+	if res.IsError() || discovery.AuthorizationEndpoint == "" ||
+		discovery.TokenEndpoint == "" || discovery.UserinfoEndpoint == "" {
+		return nil, errors.Errorf("无效的discovery文档: %s", res.String())
+	}
+
+	p.discovery = &discovery
+	return p.discovery, nil
+}
@@ -0,0 +1,117 @@
+package adapters
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"resty.dev/v3"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/utils"
+	"scaffold/internal/user/domain"
+)
+
+// 第三方接口调用共用同一个客户端
+var oauthClient = resty.New().SetTimeout(10 * time.Second)
+
+type OAuthRegistry struct {
+	providers map[string]domain.OAuthProvider
+}
+
+// NewOAuthProviderRegistry 根据环境变量启用提供商 未配置 CLIENT_ID 的提供商不会注册
+func NewOAuthProviderRegistry() domain.OAuthProviderRegistry {
+	registry := &OAuthRegistry{
+		providers: make(map[string]domain.OAuthProvider),
+	}
+
+	if clientID := utils.GetEnvWithDefault("GITHUB_CLIENT_ID", ""); clientID != "" {
+		registry.Register(NewGithubProvider(
+			clientID,
+			utils.GetEnv("GITHUB_CLIENT_SECRET"),
+			utils.GetEnvWithDefault("GITHUB_REDIRECT_URL", ""),
+		))
+	}
+
+	if clientID := utils.GetEnvWithDefault("GOOGLE_CLIENT_ID", ""); clientID != "" {
+		registry.Register(NewGoogleProvider(
+			clientID,
+			utils.GetEnv("GOOGLE_CLIENT_SECRET"),
+			utils.GetEnv("GOOGLE_REDIRECT_URL"),
+		))
+	}
+
+	if clientID := utils.GetEnvWithDefault("OIDC_CLIENT_ID", ""); clientID != "" {
+		registry.Register(NewOIDCProvider(
+			utils.GetEnv("OIDC_ISSUER"),
+			clientID,
+			utils.GetEnv("OIDC_CLIENT_SECRET"),
+			utils.GetEnv("OIDC_REDIRECT_URL"),
+			utils.GetEnvWithDefault("OIDC_SCOPES", "openid email profile"),
+		))
+	}
+
+	return registry
+}
+
+func (r *OAuthRegistry) Register(provider domain.OAuthProvider) {
+	r.providers[provider.Name()] = provider
+}
+
+func (r *OAuthRegistry) Get(name string) (domain.OAuthProvider, error) {
+	provider, ok := r.providers[name]
+	if !ok {
+		return nil, codes.ErrOAuthInvalidProvider
+	}
+	return provider, nil
+}
+
+// buildAuthCodeURL 拼接授权地址 统一附带 state 与 PKCE 参数
+func buildAuthCodeURL(endpoint string, params map[string]string, state, codeChallenge string) string {
+	query := url.Values{}
+	for k, v := range params {
+		if v != "" {
+			query.Set(k, v)
+		}
+	}
+	query.Set("response_type", "code")
+	query.Set("state", state)
+	query.Set("code_challenge", codeChallenge)
+	query.Set("code_challenge_method", "S256")
+
+	return endpoint + "?" + query.Encode()
+}
+
+type oauthTokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	Scope            string `json:"scope"`
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// exchangeAuthorizationCode 标准 OAuth2 授权码换取 access_token
+func exchangeAuthorizationCode(tokenURL string, form map[string]string) (string, error) {
+	var result oauthTokenResponse
+
+	form["grant_type"] = "authorization_code"
+	res, err := oauthClient.R().
+		SetHeader("Accept", "application/json").
+		SetFormData(form).
+		SetResult(&result).
+		SetError(&result).
+		Post(tokenURL)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	if res.IsError() || result.AccessToken == "" {
+		return "", codes.ErrOAuthInvalidCode.WithDetail(map[string]any{
+			"reason": "empty_access_token",
+			"error":  result.Error,
+		})
+	}
+
+	return result.AccessToken, nil
+}
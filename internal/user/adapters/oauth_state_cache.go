@@ -0,0 +1,56 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/utils"
+	"scaffold/internal/user/domain"
+)
+
+type OAuthStateRedisCache struct {
+	client *redis.Client
+}
+
+func NewOAuthStateRedisCache() domain.OAuthStateCache {
+	return &OAuthStateRedisCache{client: getRedisClient()}
+}
+
+const (
+	keyOAuthState = "user:oauth_state:"
+)
+
+func (ch *OAuthStateRedisCache) SaveState(state string, oauthState *domain.OAuthState) error {
+	stateByte, err := json.Marshal(oauthState)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	key := utils.GetRedisKey(keyOAuthState + utils.HashToken(state))
+	if err := ch.client.Set(context.Background(), key, stateByte, domain.OAuthStateExpire).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (ch *OAuthStateRedisCache) ConsumeState(state string) (*domain.OAuthState, error) {
+	key := utils.GetRedisKey(keyOAuthState + utils.HashToken(state))
+
+	result, err := ch.client.GetDel(context.Background(), key).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, codes.ErrOAuthStateInvalid
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	oauthState := new(domain.OAuthState)
+	if err := json.Unmarshal([]byte(result), oauthState); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return oauthState, nil
+}
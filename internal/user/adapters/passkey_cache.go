@@ -0,0 +1,57 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/utils"
+	"scaffold/internal/user/domain"
+)
+
+type PasskeyRedisCache struct {
+	client *redis.Client
+}
+
+func NewPasskeyRedisCache() domain.PasskeyCache {
+	return &PasskeyRedisCache{client: getRedisClient()}
+}
+
+const (
+	keyPasskeyCeremony = "user:passkey_ceremony:"
+)
+
+func (ch *PasskeyRedisCache) SaveCeremony(ceremonyID string, ceremony *domain.PasskeyCeremony) error {
+	ceremonyByte, err := json.Marshal(ceremony)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	key := utils.GetRedisKey(keyPasskeyCeremony + utils.HashToken(ceremonyID))
+	if err := ch.client.Set(context.Background(), key, ceremonyByte, domain.PasskeyCeremonyExpire).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (ch *PasskeyRedisCache) ConsumeCeremony(ceremonyID string) (*domain.PasskeyCeremony, error) {
+	key := utils.GetRedisKey(keyPasskeyCeremony + utils.HashToken(ceremonyID))
+
+	// GETDEL 保证挑战只能使用一次 防止断言被重放
+	result, err := ch.client.GetDel(context.Background(), key).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, codes.ErrPasskeyCeremonyInvalid
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	ceremony := new(domain.PasskeyCeremony)
+	if err := json.Unmarshal([]byte(result), ceremony); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return ceremony, nil
+}
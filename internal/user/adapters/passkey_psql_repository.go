@@ -0,0 +1,89 @@
+package adapters
+
+import (
+	"fmt"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"scaffold/internal/common/reskit/codes"
+	"time"
+
+	"scaffold/internal/common/orm"
+	"scaffold/internal/user/domain"
+)
+
+type PasskeyPSQLRepository struct {
+}
+
+func NewPasskeyPSQLRepository() domain.PasskeyRepository {
+	return &PasskeyPSQLRepository{}
+}
+
+func (r *PasskeyPSQLRepository) ListByUserID(userID int64) ([]*domain.Passkey, error) {
+	ormPasskeys, err := orm.WebauthnCredentials(
+		orm.WebauthnCredentialWhere.UserID.EQ(userID),
+		qm.OrderBy(orm.WebauthnCredentialColumns.CreatedAt+" DESC"),
+	).AllG()
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	passkeys := make([]*domain.Passkey, 0, len(ormPasskeys))
+	for _, ormPasskey := range ormPasskeys {
+		passkeys = append(passkeys, ormPasskeyToDomain(ormPasskey))
+	}
+	return passkeys, nil
+}
+
+func (r *PasskeyPSQLRepository) CountByUserID(userID int64) (int64, error) {
+	count, err := orm.WebauthnCredentials(orm.WebauthnCredentialWhere.UserID.EQ(userID)).CountG()
+	if err != nil {
+		return 0, fmt.Errorf("database error: %w", err)
+	}
+	return count, nil
+}
+
+func (r *PasskeyPSQLRepository) Create(passkey *domain.Passkey) (*domain.Passkey, error) {
+	exists, err := orm.WebauthnCredentials(
+		orm.WebauthnCredentialWhere.CredentialID.EQ(passkey.CredentialID),
+	).ExistsG()
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	if exists {
+		return nil, codes.ErrPasskeyAlreadyRegistered
+	}
+
+	ormPasskey := domainPasskeyToORM(passkey)
+	if err := ormPasskey.InsertG(boil.Infer()); err != nil {
+		return nil, fmt.Errorf("failed to create passkey: %w", err)
+	}
+
+	return ormPasskeyToDomain(ormPasskey), nil
+}
+
+func (r *PasskeyPSQLRepository) Delete(userID, id int64) error {
+	rows, err := orm.WebauthnCredentials(
+		orm.WebauthnCredentialWhere.ID.EQ(id),
+		orm.WebauthnCredentialWhere.UserID.EQ(userID),
+	).DeleteAllG()
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	if rows == 0 {
+		return codes.ErrPasskeyNotFound
+	}
+	return nil
+}
+
+func (r *PasskeyPSQLRepository) UpdateUsage(id int64, signCount uint32, backupState bool, at time.Time) error {
+	_, err := orm.WebauthnCredentials(orm.WebauthnCredentialWhere.ID.EQ(id)).UpdateAllG(orm.M{
+		orm.WebauthnCredentialColumns.SignCount:   int64(signCount),
+		orm.WebauthnCredentialColumns.BackupState: backupState,
+		orm.WebauthnCredentialColumns.LastUsedAt:  null.TimeFrom(at),
+	})
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	return nil
+}
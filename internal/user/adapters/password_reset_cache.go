@@ -0,0 +1,56 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/utils"
+	"scaffold/internal/user/domain"
+)
+
+type PasswordResetRedisCache struct {
+	client *redis.Client
+}
+
+func NewPasswordResetRedisCache() domain.PasswordResetCache {
+	return &PasswordResetRedisCache{client: getRedisClient()}
+}
+
+const (
+	keyPasswordResetToken = "user:password_reset:"
+)
+
+func (ch *PasswordResetRedisCache) SaveToken(token string, ticket *domain.PasswordResetTicket) error {
+	ticketByte, err := json.Marshal(ticket)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	key := utils.GetRedisKey(keyPasswordResetToken + utils.HashToken(token))
+	if err := ch.client.Set(context.Background(), key, ticketByte, domain.PasswordResetTokenExpire).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (ch *PasswordResetRedisCache) ConsumeToken(token string) (*domain.PasswordResetTicket, error) {
+	key := utils.GetRedisKey(keyPasswordResetToken + utils.HashToken(token))
+
+	result, err := ch.client.GetDel(context.Background(), key).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, codes.ErrPasswordResetTokenInvalid
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	ticket := new(domain.PasswordResetTicket)
+	if err := json.Unmarshal([]byte(result), ticket); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return ticket, nil
+}
@@ -1,13 +1,17 @@
 package adapters
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	_ "github.com/lib/pq"
 	"github.com/pkg/errors"
 	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/utils/dbkit"
+	"strings"
 	"time"
 
 	"scaffold/internal/common/orm"
@@ -33,7 +37,18 @@ func (r *UserPSQLRepository) FindByID(id int64) (*domain.User, error) {
 }
 
 func (r *UserPSQLRepository) FindByEmail(email string) (*domain.User, error) {
-	ormUser, err := orm.Users(orm.UserWhere.Email.EQ(email)).OneG()
+	ormUser, err := orm.Users(orm.UserWhere.Email.EQ(null.StringFrom(email))).OneG()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return ormUserToDomain(ormUser), nil
+}
+
+func (r *UserPSQLRepository) FindByPhone(phone string) (*domain.User, error) {
+	ormUser, err := orm.Users(orm.UserWhere.Phone.EQ(null.StringFrom(phone))).OneG()
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, codes.ErrUserNotFound
@@ -64,24 +79,26 @@ func (r *UserPSQLRepository) Update(user *domain.User) (*domain.User, error) {
 	return ormUserToDomain(ormUser), nil
 }
 
-func (r *UserPSQLRepository) FindByOAuthID(provider, oauthID string) (*domain.User, error) {
-	var ormUser *orm.User
-	var err error
+func (r *UserPSQLRepository) CreateWithIdentity(user *domain.User, identity *domain.UserIdentity) (*domain.User, error) {
+	tx, err := boil.BeginTx(context.Background(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
 
-	switch provider {
-	case "github":
-		ormUser, err = orm.Users(
-			orm.UserWhere.GithubID.EQ(null.StringFrom(oauthID)),
-		).OneG()
-	default:
-		return nil, codes.ErrOAuthInvalidCode
+	ormUser := domainUserToORM(user)
+	if err := ormUser.Insert(tx, boil.Infer()); err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, codes.ErrUserNotFound
-		}
-		return nil, fmt.Errorf("database error: %w", err)
+	ormIdentity := domainIdentityToORM(identity)
+	ormIdentity.UserID = ormUser.ID
+	if err := ormIdentity.Insert(tx, boil.Infer()); err != nil {
+		return nil, fmt.Errorf("failed to create user identity: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
 	return ormUserToDomain(ormUser), nil
@@ -98,10 +115,335 @@ func (r *UserPSQLRepository) UpdateLastLogin(id int64) error {
 	return err
 }
 
+func (r *UserPSQLRepository) MarkEmailVerified(id int64) error {
+	_, err := orm.Users(orm.UserWhere.ID.EQ(id)).UpdateAllG(orm.M{
+		orm.UserColumns.EmailVerifiedAt: null.TimeFrom(time.Now()),
+	})
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	return nil
+}
+
+func (r *UserPSQLRepository) ClaimUnverifiedEmail(id int64) error {
+	tx, err := boil.BeginTx(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	ormUser, err := orm.Users(orm.UserWhere.ID.EQ(id), qm.For("UPDATE")).One(tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return codes.ErrUserNotFound
+		}
+		return fmt.Errorf("database error: %w", err)
+	}
+	// 并发请求已完成认领 不能再清除真正所有者此后设置的凭证
+	if ormUser.EmailVerifiedAt.Valid {
+		return nil
+	}
+
+	if _, err := orm.UserIdentities(orm.UserIdentityWhere.UserID.EQ(id)).DeleteAll(tx); err != nil {
+		return fmt.Errorf("failed to delete user identities: %w", err)
+	}
+	if _, err := orm.APIKeys(orm.APIKeyWhere.UserID.EQ(id)).DeleteAll(tx); err != nil {
+		return fmt.Errorf("failed to delete api keys: %w", err)
+	}
+	if _, err := orm.UserRecoveryCodes(orm.UserRecoveryCodeWhere.UserID.EQ(id)).DeleteAll(tx); err != nil {
+		return fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+	if _, err := orm.UserMfas(orm.UserMfaWhere.UserID.EQ(id)).DeleteAll(tx); err != nil {
+		return fmt.Errorf("failed to delete user mfa: %w", err)
+	}
+	if _, err := orm.WebauthnCredentials(orm.WebauthnCredentialWhere.UserID.EQ(id)).DeleteAll(tx); err != nil {
+		return fmt.Errorf("failed to delete webauthn credentials: %w", err)
+	}
+
+	// 手机号同样可能由抢注者绑定 保留会让其继续通过短信登录
+	now := time.Now()
+	ormUser.PasswordHash = null.String{}
+	ormUser.Phone = null.String{}
+	ormUser.PhoneVerifiedAt = null.Time{}
+	ormUser.EmailVerifiedAt = null.TimeFrom(now)
+	ormUser.UpdatedAt = now
+	if _, err := ormUser.Update(tx, boil.Whitelist(
+		orm.UserColumns.PasswordHash,
+		orm.UserColumns.Phone,
+		orm.UserColumns.PhoneVerifiedAt,
+		orm.UserColumns.EmailVerifiedAt,
+		orm.UserColumns.UpdatedAt,
+	)); err != nil {
+		return fmt.Errorf("failed to claim user email: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (r *UserPSQLRepository) UpdateEmail(id int64, email string) error {
+	now := time.Now()
+	_, err := orm.Users(orm.UserWhere.ID.EQ(id)).UpdateAllG(orm.M{
+		orm.UserColumns.Email:           null.StringFrom(email),
+		orm.UserColumns.EmailVerifiedAt: null.TimeFrom(now),
+		orm.UserColumns.UpdatedAt:       now,
+	})
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	return nil
+}
+
+func (r *UserPSQLRepository) UpdateProfile(id int64, update *domain.ProfileUpdate) error {
+	cols := orm.M{
+		orm.UserColumns.UpdatedAt: time.Now(),
+	}
+	if update.Nickname != nil {
+		cols[orm.UserColumns.Nickname] = *update.Nickname
+	}
+
+	if _, err := orm.Users(orm.UserWhere.ID.EQ(id)).UpdateAllG(cols); err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	return nil
+}
+
+func (r *UserPSQLRepository) UpdateAvatar(id int64, avatar string) error {
+	_, err := orm.Users(orm.UserWhere.ID.EQ(id)).UpdateAllG(orm.M{
+		orm.UserColumns.Avatar:    null.NewString(avatar, avatar != ""),
+		orm.UserColumns.UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	return nil
+}
+
+func (r *UserPSQLRepository) UpdatePassword(id int64, passwordHash string) error {
+	_, err := orm.Users(orm.UserWhere.ID.EQ(id)).UpdateAllG(orm.M{
+		orm.UserColumns.PasswordHash: null.StringFrom(passwordHash),
+		orm.UserColumns.UpdatedAt:    time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	return nil
+}
+
 func (r *UserPSQLRepository) EmailExists(email string) (bool, error) {
-	exists, err := orm.Users(orm.UserWhere.Email.EQ(email)).ExistsG()
+	exists, err := orm.Users(orm.UserWhere.Email.EQ(null.StringFrom(email))).ExistsG()
 	if err != nil {
 		return false, fmt.Errorf("database error: %w", err)
 	}
 	return exists, nil
 }
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// likePattern 转义通配符后构造包含匹配的模式
+func likePattern(keyword string) string {
+	return "%" + likeEscaper.Replace(keyword) + "%"
+}
+
+// List ILIKE 与相似度运算符均可使用 pg_trgm 的 GIN 索引
+func (r *UserPSQLRepository) List(query *domain.UserQuery) (*domain.UserList, error) {
+	var whereMods []qm.QueryMod
+	if query.Email != "" {
+		whereMods = append(whereMods, qm.Where(orm.UserColumns.Email+" ILIKE ?", likePattern(query.Email)))
+	}
+	if query.Nickname != "" {
+		whereMods = append(whereMods, qm.Where(
+			fmt.Sprintf("(%s ILIKE ? OR %s %% ?)", orm.UserColumns.Nickname, orm.UserColumns.Nickname),
+			likePattern(query.Nickname), query.Nickname,
+		))
+	}
+	if !query.CreatedFrom.IsZero() {
+		whereMods = append(whereMods, orm.UserWhere.CreatedAt.GTE(query.CreatedFrom))
+	}
+	if !query.CreatedTo.IsZero() {
+		whereMods = append(whereMods, orm.UserWhere.CreatedAt.LT(query.CreatedTo))
+	}
+	if query.Disabled != nil {
+		if *query.Disabled {
+			whereMods = append(whereMods, orm.UserWhere.DisabledAt.IsNotNull())
+		} else {
+			whereMods = append(whereMods, orm.UserWhere.DisabledAt.IsNull())
+		}
+	}
+
+	keyset := dbkit.NewKeyset[*domain.User](
+		orm.UserTableColumns.ID,
+		orm.UserTableColumns.CreatedAt,
+		query.PrevCursor,
+		query.NextCursor,
+		query.PageSize,
+	)
+
+	ormUsers, err := orm.Users(keyset.ApplyKeysetMods(whereMods)...).AllG()
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	users := make([]*domain.User, 0, len(ormUsers))
+	for _, ormUser := range ormUsers {
+		users = append(users, ormUserToDomain(ormUser))
+	}
+
+	result := keyset.BuildPaginationResult(users)
+	return &domain.UserList{
+		Items:      result.Items,
+		PrevCursor: result.PrevCursor,
+		NextCursor: result.NextCursor,
+		HasPrev:    result.HasPrev,
+		HasNext:    result.HasNext,
+	}, nil
+}
+
+func (r *UserPSQLRepository) Disable(id int64, at time.Time) error {
+	exists, err := orm.Users(orm.UserWhere.ID.EQ(id)).ExistsG()
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	if !exists {
+		return codes.ErrUserNotFound
+	}
+
+	_, err = orm.Users(
+		orm.UserWhere.ID.EQ(id),
+		orm.UserWhere.DisabledAt.IsNull(),
+	).UpdateAllG(orm.M{
+		orm.UserColumns.DisabledAt: null.TimeFrom(at),
+		orm.UserColumns.UpdatedAt:  time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	return nil
+}
+
+func (r *UserPSQLRepository) Enable(id int64) error {
+	rows, err := orm.Users(orm.UserWhere.ID.EQ(id)).UpdateAllG(orm.M{
+		orm.UserColumns.DisabledAt: null.Time{},
+		orm.UserColumns.UpdatedAt:  time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	if rows == 0 {
+		return codes.ErrUserNotFound
+	}
+	return nil
+}
+
+func (r *UserPSQLRepository) ScheduleDeletion(id int64, at time.Time) error {
+	_, err := orm.Users(orm.UserWhere.ID.EQ(id)).UpdateAllG(orm.M{
+		orm.UserColumns.DeletionScheduledAt: null.TimeFrom(at),
+		orm.UserColumns.UpdatedAt:           time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	return nil
+}
+
+func (r *UserPSQLRepository) CancelDeletion(id int64) error {
+	_, err := orm.Users(orm.UserWhere.ID.EQ(id)).UpdateAllG(orm.M{
+		orm.UserColumns.DeletionScheduledAt: null.Time{},
+		orm.UserColumns.UpdatedAt:           time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	return nil
+}
+
+func (r *UserPSQLRepository) ListDueForDeletion(before time.Time, limit int) ([]*domain.User, error) {
+	ormUsers, err := orm.Users(
+		orm.UserWhere.DeletionScheduledAt.LTE(null.TimeFrom(before)),
+		qm.OrderBy(orm.UserColumns.DeletionScheduledAt),
+		qm.Limit(limit),
+	).AllG()
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	users := make([]*domain.User, 0, len(ormUsers))
+	for _, ormUser := range ormUsers {
+		users = append(users, ormUserToDomain(ormUser))
+	}
+	return users, nil
+}
+
+// Anonymize 删除登录凭证后清空个人信息再软删除 保留的行仅供其他业务数据的外键引用
+func (r *UserPSQLRepository) Anonymize(id int64) error {
+	tx, err := boil.BeginTx(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	ormUser, err := orm.Users(orm.UserWhere.ID.EQ(id)).One(tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return codes.ErrUserNotFound
+		}
+		return fmt.Errorf("database error: %w", err)
+	}
+
+	if _, err := orm.UserIdentities(orm.UserIdentityWhere.UserID.EQ(id)).DeleteAll(tx); err != nil {
+		return fmt.Errorf("failed to delete user identities: %w", err)
+	}
+	if _, err := orm.APIKeys(orm.APIKeyWhere.UserID.EQ(id)).DeleteAll(tx); err != nil {
+		return fmt.Errorf("failed to delete api keys: %w", err)
+	}
+	if _, err := orm.UserRecoveryCodes(orm.UserRecoveryCodeWhere.UserID.EQ(id)).DeleteAll(tx); err != nil {
+		return fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+	if _, err := orm.UserMfas(orm.UserMfaWhere.UserID.EQ(id)).DeleteAll(tx); err != nil {
+		return fmt.Errorf("failed to delete user mfa: %w", err)
+	}
+	if _, err := orm.WebauthnCredentials(orm.WebauthnCredentialWhere.UserID.EQ(id)).DeleteAll(tx); err != nil {
+		return fmt.Errorf("failed to delete webauthn credentials: %w", err)
+	}
+	if _, err := orm.UserRoles(orm.UserRoleWhere.UserID.EQ(id)).DeleteAll(tx); err != nil {
+		return fmt.Errorf("failed to delete user roles: %w", err)
+	}
+	// 成员关系缓存随过期时间自然失效 期间该用户的会话已全部吊销
+	if _, err := orm.OrganizationMembers(orm.OrganizationMemberWhere.UserID.EQ(id)).DeleteAll(tx); err != nil {
+		return fmt.Errorf("failed to delete organization memberships: %w", err)
+	}
+
+	ormUser.Nickname = domain.DeletedUserNickname
+	ormUser.Email = null.String{}
+	ormUser.EmailVerifiedAt = null.Time{}
+	ormUser.Phone = null.String{}
+	ormUser.PhoneVerifiedAt = null.Time{}
+	ormUser.PasswordHash = null.String{}
+	ormUser.Avatar = null.String{}
+	ormUser.DeletionScheduledAt = null.Time{}
+	ormUser.UpdatedAt = time.Now()
+	if _, err := ormUser.Update(tx, boil.Whitelist(
+		orm.UserColumns.Nickname,
+		orm.UserColumns.Email,
+		orm.UserColumns.EmailVerifiedAt,
+		orm.UserColumns.Phone,
+		orm.UserColumns.PhoneVerifiedAt,
+		orm.UserColumns.PasswordHash,
+		orm.UserColumns.Avatar,
+		orm.UserColumns.DeletionScheduledAt,
+		orm.UserColumns.UpdatedAt,
+	)); err != nil {
+		return fmt.Errorf("failed to anonymize user: %w", err)
+	}
+
+	if _, err := ormUser.Delete(tx, false); err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
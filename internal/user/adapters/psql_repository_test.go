@@ -0,0 +1,58 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"scaffold/internal/common/testkit"
+)
+
+func TestClaimUnverifiedEmailClearsCredentials(t *testing.T) {
+	mock := testkit.MockDB(t)
+	const userID = 7
+
+	mock.ExpectBegin()
+	// 锁定用户行 避免并发认领时清除邮箱所有者此后设置的凭证
+	mock.ExpectQuery(testkit.QuoteSQL(`FROM "users" WHERE ("users"."id" = $1) AND ("users"."deleted_at" is null) LIMIT 1 FOR UPDATE`)).
+		WithArgs(userID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email", "password_hash", "phone", "phone_verified_at"}).
+			AddRow(userID, "victim@example.com", "hash", "+8613800000000", time.Now()))
+	for _, table := range []string{"user_identities", "api_keys", "user_recovery_codes", "user_mfa", "webauthn_credentials"} {
+		mock.ExpectExec(testkit.QuoteSQL(`DELETE FROM "` + table + `" WHERE ("` + table + `"."user_id" = $1)`)).
+			WithArgs(userID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectExec(testkit.QuoteSQL(`UPDATE "users" SET "password_hash"=$1,"phone"=$2,"phone_verified_at"=$3,"email_verified_at"=$4,"updated_at"=$5 WHERE "id"=$6`)).
+		WithArgs(nil, nil, nil, sqlmock.AnyArg(), sqlmock.AnyArg(), userID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	if err := NewUserPSQLRepository().ClaimUnverifiedEmail(userID); err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClaimUnverifiedEmailSkipsVerifiedUser(t *testing.T) {
+	mock := testkit.MockDB(t)
+	const userID = 8
+
+	// 并发请求已完成认领 不再修改任何数据
+	mock.ExpectBegin()
+	mock.ExpectQuery(testkit.QuoteSQL(`FROM "users" WHERE ("users"."id" = $1) AND ("users"."deleted_at" is null) LIMIT 1 FOR UPDATE`)).
+		WithArgs(userID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email", "password_hash", "email_verified_at"}).
+			AddRow(userID, "owner@example.com", "hash", time.Now()))
+	mock.ExpectRollback()
+
+	if err := NewUserPSQLRepository().ClaimUnverifiedEmail(userID); err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
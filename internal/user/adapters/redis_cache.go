@@ -2,8 +2,9 @@ package adapters
 
 import (
 	"context"
-	"encoding/json"
 	"scaffold/internal/common/reskit/codes"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
@@ -18,57 +19,66 @@ type TokenRedisCache struct {
 }
 
 func NewTokenRedisCache() domain.TokenCache {
-	host := utils.GetEnv("REDIS_HOST")
-	port := utils.GetEnv("REDIS_PORT")
-	password := utils.GetEnv("REDIS_PASSWORD")
-	db := utils.GetEnvAsInt("REDIS_DB")
-	poolSize := utils.GetEnvAsInt("REDIS_POOL_SIZE")
-
-	addr := host + ":" + port
-	client := redis.NewClient(&redis.Options{
-		Addr:     addr,
-		DB:       db,
-		Password: password,
-		PoolSize: poolSize,
-	})
-
-	// 可选：ping 检查连接
-	if err := client.Ping(context.Background()).Err(); err != nil {
-		panic(err)
-	}
-
-	return &TokenRedisCache{client: client}
+	return &TokenRedisCache{client: getRedisClient()}
 }
 
 const (
-	keyRefreshTokenMapDuration = 30 * 24 * time.Hour
-	keyRefreshTokenMap         = "user_refresh_token_map"
+	keyRefreshTokenDuration = domain.SessionExpire
+	// keyRefreshToken 单个refresh token hash结构 只保存令牌摘要
+	// 轮换后不立即删除而是标记 rotated_at 用于识别重放 随过期时间自然清理
+	keyRefreshToken = "user:refresh_token:"
+	// keySession 会话元数据 hash结构 token_hash字段指向会话当前的refresh token
+	keySession = "user:session:"
+	// keyUserSessions 用户的会话ID集合 用于列出与批量吊销
+	keyUserSessions = "user:sessions:"
+
+	tokenFieldUserID    = "user_id"
+	tokenFieldSessionID = "session_id"
+	tokenFieldParent    = "parent"
+	tokenFieldRotatedAt = "rotated_at"
+
+	sessionFieldUserID        = "user_id"
+	sessionFieldTokenHash     = "token_hash"
+	sessionFieldIP            = "ip"
+	sessionFieldUserAgent     = "user_agent"
+	sessionFieldDeviceName    = "device_name"
+	sessionFieldCreatedAt     = "created_at"
+	sessionFieldLastRefreshAt = "last_refresh_at"
 )
 
-func (ch *TokenRedisCache) GenRefreshToken(payload *domain.JwtPayload) (string, error) {
-	refreshToken, err := utils.GenRandomHexToken()
-	if err != nil {
-		return "", errors.WithStack(err)
-	}
+func refreshTokenKey(tokenHash string) string {
+	return utils.GetRedisKey(keyRefreshToken + tokenHash)
+}
 
-	key := utils.GetRedisKey(keyRefreshTokenMap)
-	pipe := ch.client.Pipeline()
+func sessionKey(sessionID string) string {
+	return utils.GetRedisKey(keySession + sessionID)
+}
+
+func userSessionsKey(userID int64) string {
+	return utils.GetRedisKey(keyUserSessions + strconv.FormatInt(userID, 10))
+}
 
-	payloadByte, err := json.Marshal(payload)
+func (ch *TokenRedisCache) GenRefreshToken(payload *domain.JwtPayload, parentToken string) (string, error) {
+	refreshToken, err := utils.GenRandomHexToken()
 	if err != nil {
 		return "", errors.WithStack(err)
 	}
-	payloadStr := string(payloadByte)
 
-	if err := pipe.HSet(context.Background(), key, refreshToken, payloadStr).Err(); err != nil {
-		return "", errors.WithStack(err)
+	fields := map[string]any{
+		tokenFieldUserID:    payload.UserID,
+		tokenFieldSessionID: payload.SessionID,
+	}
+	if parentToken != "" {
+		fields[tokenFieldParent] = utils.HashToken(parentToken)
 	}
 
-	pipe.HExpire(context.Background(), key, keyRefreshTokenMapDuration, refreshToken)
+	ctx := context.Background()
+	key := refreshTokenKey(utils.HashToken(refreshToken))
 
-	// 执行Pipeline命令
-	_, err = pipe.Exec(context.Background())
-	if err != nil {
+	pipe := ch.client.TxPipeline()
+	pipe.HSet(ctx, key, fields)
+	pipe.Expire(ctx, key, keyRefreshTokenDuration)
+	if _, err := pipe.Exec(ctx); err != nil {
 		return "", errors.WithStack(err)
 	}
 
@@ -76,10 +86,40 @@ func (ch *TokenRedisCache) GenRefreshToken(payload *domain.JwtPayload) (string,
 }
 
 func (ch *TokenRedisCache) ValidateRefreshToken(refreshToken string) (*domain.JwtPayload, error) {
-	key := utils.GetRedisKey(keyRefreshTokenMap)
+	key := refreshTokenKey(utils.HashToken(refreshToken))
+
+	fields, err := ch.client.HGetAll(context.Background(), key).Result()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(fields) == 0 || fields[tokenFieldRotatedAt] != "" {
+		return nil, codes.ErrRefreshTokenNotFound
+	}
 
-	result, err := ch.client.HGet(context.Background(), key, refreshToken).Result()
+	record := refreshTokenRecordFromFields(fields)
+	return &domain.JwtPayload{
+		UserID:    record.UserID,
+		SessionID: record.SessionID,
+	}, nil
+}
+
+// claimRefreshTokenScript 令牌存在时写入 rotated_at 并返回写入前的全部字段
+// 判断与标记在同一脚本内完成 并发刷新时只有一个请求能成功轮换
+var claimRefreshTokenScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return false
+end
+local fields = redis.call('HGETALL', KEYS[1])
+redis.call('HSETNX', KEYS[1], ARGV[1], ARGV[2])
+return fields
+`)
+
+func (ch *TokenRedisCache) ClaimRefreshToken(refreshToken string) (*domain.RefreshTokenRecord, error) {
+	key := refreshTokenKey(utils.HashToken(refreshToken))
 
+	result, err := claimRefreshTokenScript.Run(
+		context.Background(), ch.client, []string{key}, tokenFieldRotatedAt, time.Now().Unix(),
+	).StringSlice()
 	if err != nil {
 		if errors.Is(err, redis.Nil) {
 			return nil, codes.ErrRefreshTokenNotFound
@@ -87,19 +127,180 @@ func (ch *TokenRedisCache) ValidateRefreshToken(refreshToken string) (*domain.Jw
 		return nil, errors.WithStack(err)
 	}
 
-	payload := new(domain.JwtPayload)
-	if err := json.Unmarshal([]byte(result), payload); err != nil {
-		return nil, err
+	fields := make(map[string]string, len(result)/2)
+	for i := 0; i+1 < len(result); i += 2 {
+		fields[result[i]] = result[i+1]
 	}
+	return refreshTokenRecordFromFields(fields), nil
+}
+
+func refreshTokenRecordFromFields(fields map[string]string) *domain.RefreshTokenRecord {
+	userID, _ := strconv.ParseInt(fields[tokenFieldUserID], 10, 64)
 
-	return payload, nil
+	return &domain.RefreshTokenRecord{
+		UserID:     userID,
+		SessionID:  fields[tokenFieldSessionID],
+		ParentHash: fields[tokenFieldParent],
+		Rotated:    fields[tokenFieldRotatedAt] != "",
+	}
 }
 
-func (ch *TokenRedisCache) RemoveRefreshToken(refreshToken string) error {
-	key := utils.GetRedisKey(keyRefreshTokenMap)
+func (ch *TokenRedisCache) SaveSession(session *domain.Session, refreshToken string) error {
+	ctx := context.Background()
+	key := sessionKey(session.ID)
+	indexKey := userSessionsKey(session.UserID)
 
-	if err := ch.client.HDel(context.Background(), key, refreshToken).Err(); err != nil {
+	// 会话与用户索引同时写入 过期时间随每次刷新顺延
+	pipe := ch.client.TxPipeline()
+	pipe.HSet(ctx, key, map[string]any{
+		sessionFieldUserID:        session.UserID,
+		sessionFieldTokenHash:     utils.HashToken(refreshToken),
+		sessionFieldIP:            session.IP,
+		sessionFieldUserAgent:     session.UserAgent,
+		sessionFieldDeviceName:    session.DeviceName,
+		sessionFieldCreatedAt:     session.CreatedAt.Unix(),
+		sessionFieldLastRefreshAt: session.LastRefreshAt.Unix(),
+	})
+	pipe.Expire(ctx, key, keyRefreshTokenDuration)
+	pipe.SAdd(ctx, indexKey, session.ID)
+	pipe.Expire(ctx, indexKey, keyRefreshTokenDuration)
+
+	if _, err := pipe.Exec(ctx); err != nil {
 		return errors.WithStack(err)
 	}
 	return nil
 }
+
+func (ch *TokenRedisCache) GetSession(sessionID string) (*domain.Session, error) {
+	fields, err := ch.client.HGetAll(context.Background(), sessionKey(sessionID)).Result()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(fields) == 0 {
+		return nil, codes.ErrSessionNotFound
+	}
+
+	return sessionFromFields(sessionID, fields), nil
+}
+
+// ListUserSessions 列出用户的有效会话 顺带清理索引中已过期的会话ID
+func (ch *TokenRedisCache) ListUserSessions(userID int64) ([]*domain.Session, error) {
+	ctx := context.Background()
+	indexKey := userSessionsKey(userID)
+
+	sessionIDs, err := ch.client.SMembers(ctx, indexKey).Result()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(sessionIDs) == 0 {
+		return []*domain.Session{}, nil
+	}
+
+	pipe := ch.client.Pipeline()
+	cmds := make([]*redis.MapStringStringCmd, 0, len(sessionIDs))
+	for _, sessionID := range sessionIDs {
+		cmds = append(cmds, pipe.HGetAll(ctx, sessionKey(sessionID)))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	sessions := make([]*domain.Session, 0, len(sessionIDs))
+	var expired []any
+	for i, cmd := range cmds {
+		fields := cmd.Val()
+		if len(fields) == 0 {
+			expired = append(expired, sessionIDs[i])
+			continue
+		}
+		sessions = append(sessions, sessionFromFields(sessionIDs[i], fields))
+	}
+
+	if len(expired) > 0 {
+		if err := ch.client.SRem(ctx, indexKey, expired...).Err(); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastRefreshAt.After(sessions[j].LastRefreshAt)
+	})
+	return sessions, nil
+}
+
+func (ch *TokenRedisCache) RemoveSession(sessionID string) error {
+	ctx := context.Background()
+	key := sessionKey(sessionID)
+
+	fields, err := ch.client.HMGet(ctx, key, sessionFieldUserID, sessionFieldTokenHash).Result()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	keys := []string{key}
+	if tokenHash, ok := fields[1].(string); ok {
+		keys = append(keys, refreshTokenKey(tokenHash))
+	}
+
+	pipe := ch.client.TxPipeline()
+	pipe.Del(ctx, keys...)
+	if userID, ok := fields[0].(string); ok {
+		uid, _ := strconv.ParseInt(userID, 10, 64)
+		pipe.SRem(ctx, userSessionsKey(uid), sessionID)
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+// RemoveUserSessions 通过用户索引吊销该用户的全部会话及refresh token
+func (ch *TokenRedisCache) RemoveUserSessions(userID int64) error {
+	ctx := context.Background()
+	indexKey := userSessionsKey(userID)
+
+	sessionIDs, err := ch.client.SMembers(ctx, indexKey).Result()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	pipe := ch.client.Pipeline()
+	cmds := make([]*redis.StringCmd, 0, len(sessionIDs))
+	for _, sessionID := range sessionIDs {
+		cmds = append(cmds, pipe.HGet(ctx, sessionKey(sessionID), sessionFieldTokenHash))
+	}
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return errors.WithStack(err)
+	}
+
+	keys := make([]string, 0, len(sessionIDs)*2+1)
+	for i, sessionID := range sessionIDs {
+		keys = append(keys, sessionKey(sessionID))
+		if tokenHash := cmds[i].Val(); tokenHash != "" {
+			keys = append(keys, refreshTokenKey(tokenHash))
+		}
+	}
+	keys = append(keys, indexKey)
+
+	if err := ch.client.Del(ctx, keys...).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func sessionFromFields(sessionID string, fields map[string]string) *domain.Session {
+	userID, _ := strconv.ParseInt(fields[sessionFieldUserID], 10, 64)
+	createdAt, _ := strconv.ParseInt(fields[sessionFieldCreatedAt], 10, 64)
+	lastRefreshAt, _ := strconv.ParseInt(fields[sessionFieldLastRefreshAt], 10, 64)
+
+	return &domain.Session{
+		ID:            sessionID,
+		UserID:        userID,
+		IP:            fields[sessionFieldIP],
+		UserAgent:     fields[sessionFieldUserAgent],
+		DeviceName:    fields[sessionFieldDeviceName],
+		CreatedAt:     time.Unix(createdAt, 0),
+		LastRefreshAt: time.Unix(lastRefreshAt, 0),
+	}
+}
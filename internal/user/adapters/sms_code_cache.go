@@ -0,0 +1,123 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/utils"
+	"scaffold/internal/user/domain"
+)
+
+type SmsCodeRedisCache struct {
+	client *redis.Client
+}
+
+func NewSmsCodeRedisCache() domain.SmsCodeCache {
+	return &SmsCodeRedisCache{client: getRedisClient()}
+}
+
+// 键中的手机号与IP均使用摘要 避免在缓存中明文保存
+const (
+	keySmsCode         = "user:sms_code:"
+	keySmsCodeAttempts = "user:sms_code_attempts:"
+	keySmsCooldown     = "user:sms_cooldown:"
+	keySmsPhone        = "user:sms_phone:"
+	keySmsIP           = "user:sms_ip:"
+)
+
+func (ch *SmsCodeRedisCache) SaveCode(phone, codeHash string) error {
+	phoneHash := utils.HashToken(phone)
+
+	// 重新发送时清空错误次数
+	pipe := ch.client.TxPipeline()
+	pipe.Set(context.Background(), utils.GetRedisKey(keySmsCode+phoneHash), codeHash, domain.SmsCodeExpire)
+	pipe.Del(context.Background(), utils.GetRedisKey(keySmsCodeAttempts+phoneHash))
+	if _, err := pipe.Exec(context.Background()); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (ch *SmsCodeRedisCache) GetCode(phone string) (string, error) {
+	codeHash, err := ch.client.Get(context.Background(), utils.GetRedisKey(keySmsCode+utils.HashToken(phone))).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", codes.ErrSmsCodeInvalid
+		}
+		return "", errors.WithStack(err)
+	}
+	return codeHash, nil
+}
+
+func (ch *SmsCodeRedisCache) IncrAttempts(phone string) (int64, error) {
+	key := utils.GetRedisKey(keySmsCodeAttempts + utils.HashToken(phone))
+
+	pipe := ch.client.TxPipeline()
+	incr := pipe.Incr(context.Background(), key)
+	pipe.Expire(context.Background(), key, domain.SmsCodeExpire)
+	if _, err := pipe.Exec(context.Background()); err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return incr.Val(), nil
+}
+
+func (ch *SmsCodeRedisCache) RemoveCode(phone string) error {
+	phoneHash := utils.HashToken(phone)
+
+	deleted, err := ch.client.Del(context.Background(), utils.GetRedisKey(keySmsCode+phoneHash)).Result()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if deleted == 0 {
+		return codes.ErrSmsCodeInvalid
+	}
+
+	if err := ch.client.Del(context.Background(), utils.GetRedisKey(keySmsCodeAttempts+phoneHash)).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (ch *SmsCodeRedisCache) AcquireCooldown(phone string) (bool, time.Duration, error) {
+	key := utils.GetRedisKey(keySmsCooldown + utils.HashToken(phone))
+
+	ok, err := ch.client.SetNX(context.Background(), key, 1, domain.SmsCooldown).Result()
+	if err != nil {
+		return false, 0, errors.WithStack(err)
+	}
+	if ok {
+		return true, 0, nil
+	}
+
+	ttl, err := ch.client.TTL(context.Background(), key).Result()
+	if err != nil {
+		return false, 0, errors.WithStack(err)
+	}
+	return false, ttl, nil
+}
+
+func (ch *SmsCodeRedisCache) IncrPhoneRequests(phone string) (int64, error) {
+	return ch.incrWindow(utils.GetRedisKey(keySmsPhone+utils.HashToken(phone)), domain.SmsPhoneWindow)
+}
+
+func (ch *SmsCodeRedisCache) IncrIPRequests(ip string) (int64, error) {
+	return ch.incrWindow(utils.GetRedisKey(keySmsIP+utils.HashToken(ip)), domain.SmsIPWindow)
+}
+
+// incrWindow 固定窗口 只在首次计数时设置过期时间
+func (ch *SmsCodeRedisCache) incrWindow(key string, window time.Duration) (int64, error) {
+	count, err := ch.client.Incr(context.Background(), key).Result()
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	if count == 1 {
+		if err := ch.client.Expire(context.Background(), key, window).Err(); err != nil {
+			return 0, errors.WithStack(err)
+		}
+	}
+	return count, nil
+}
@@ -0,0 +1,49 @@
+package adapters
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"resty.dev/v3"
+)
+
+// HTTPSmsSender 通过 HTTP 接口调用短信网关
+// 以 JSON 提交 {"phone": "...", "content": "..."} 返回非 2xx 状态码视为发送失败
+// 对接具体服务商时可由网关完成签名与模板转换
+type HTTPSmsSender struct {
+	url    string
+	token  string
+	client *resty.Client
+}
+
+func NewHTTPSmsSender(url, token string) *HTTPSmsSender {
+	return &HTTPSmsSender{
+		url:    url,
+		token:  token,
+		client: resty.New().SetTimeout(10 * time.Second),
+	}
+}
+
+type httpSmsRequest struct {
+	Phone   string `json:"phone"`
+	Content string `json:"content"`
+}
+
+func (s *HTTPSmsSender) Send(phone, content string) error {
+	req := s.client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(&httpSmsRequest{Phone: phone, Content: content})
+	if s.token != "" {
+		req.SetAuthToken(s.token)
+	}
+
+	res, err := req.Post(s.url)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if res.IsError() {
+		return errors.Errorf("sms gateway responded %d: %s", res.StatusCode(), res.String())
+	}
+	return nil
+}
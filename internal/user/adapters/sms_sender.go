@@ -0,0 +1,86 @@
+package adapters
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"scaffold/internal/common/utils"
+	"scaffold/internal/user/domain"
+)
+
+const (
+	SmsProviderConsole = "console"
+	SmsProviderHTTP    = "http"
+)
+
+// NewSmsSender 根据 SMS_PROVIDER 选择短信通道 必须显式配置
+// console 会把验证码写入日志 只允许在 SERVER_MODE=dev 时使用
+func NewSmsSender() domain.SmsSender {
+	switch provider := utils.GetEnv("SMS_PROVIDER"); provider {
+	case SmsProviderConsole:
+		if mode := utils.GetEnv("SERVER_MODE"); mode != "dev" {
+			panic(errors.Errorf("短信通道 console 会把验证码写入日志 不能在 SERVER_MODE=%s 时使用", mode))
+		}
+		return NewConsoleSmsSender(utils.GetEnvWithDefault("SMS_FILE_PATH", ""))
+	case SmsProviderHTTP:
+		return NewHTTPSmsSender(
+			utils.GetEnv("SMS_HTTP_URL"),
+			utils.GetEnvWithDefault("SMS_HTTP_TOKEN", ""),
+		)
+	default:
+		panic(errors.Errorf("不支持的短信通道: %s", provider))
+	}
+}
+
+// ConsoleSmsSender 不真正发送 短信内容写入日志
+// 配置 filePath 时同时按行追加 JSON 到文件 便于测试脚本读取验证码
+type ConsoleSmsSender struct {
+	filePath string
+	mu       sync.Mutex
+}
+
+func NewConsoleSmsSender(filePath string) *ConsoleSmsSender {
+	return &ConsoleSmsSender{filePath: filePath}
+}
+
+type consoleSmsRecord struct {
+	Phone   string    `json:"phone"`
+	Content string    `json:"content"`
+	SentAt  time.Time `json:"sent_at"`
+}
+
+func (s *ConsoleSmsSender) Send(phone, content string) error {
+	zap.L().Info("短信未真正发送", zap.String("phone", phone), zap.String("content", content))
+
+	if s.filePath == "" {
+		return nil
+	}
+
+	line, err := json.Marshal(&consoleSmsRecord{
+		Phone:   phone,
+		Content: content,
+		SentAt:  time.Now(),
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file, err := os.OpenFile(s.filePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package adapters
+
+import "testing"
+
+func TestNewSmsSenderRequiresProvider(t *testing.T) {
+	t.Setenv("SERVER_MODE", "dev")
+	t.Setenv("SMS_PROVIDER", "")
+
+	assertPanics(t, func() { NewSmsSender() })
+}
+
+func TestNewSmsSenderRefusesConsoleOutsideDev(t *testing.T) {
+	t.Setenv("SMS_PROVIDER", SmsProviderConsole)
+
+	// 验证码会被写入日志 生产环境误用时必须拒绝启动
+	t.Setenv("SERVER_MODE", "production")
+	assertPanics(t, func() { NewSmsSender() })
+
+	t.Setenv("SERVER_MODE", "dev")
+	if _, ok := NewSmsSender().(*ConsoleSmsSender); !ok {
+		t.Fatal("开发环境应使用 console 短信通道")
+	}
+}
+
+func assertPanics(t *testing.T, fn func()) {
+	t.Helper()
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("应拒绝该配置")
+		}
+	}()
+	fn()
+}
@@ -0,0 +1,230 @@
+package adapters
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/utils"
+	"scaffold/internal/user/domain"
+)
+
+type WebAuthnProvider struct {
+	webAuthn *webauthn.WebAuthn
+}
+
+// NewWebAuthnProvider RP ID 需为前端页面所在域名 origins 为允许发起仪式的完整源
+func NewWebAuthnProvider() domain.WebAuthnProvider {
+	var origins []string
+	for _, origin := range strings.Split(utils.GetEnvWithDefault("WEBAUTHN_RP_ORIGINS", "http://localhost:5173"), ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+
+	webAuthn, err := webauthn.New(&webauthn.Config{
+		RPID:          utils.GetEnvWithDefault("WEBAUTHN_RP_ID", "localhost"),
+		RPDisplayName: utils.GetEnvWithDefault("WEBAUTHN_RP_NAME", "scaffold"),
+		RPOrigins:     origins,
+		// 通行密钥需保存在认证器中 登录时才能由认证器提供用户句柄
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			ResidentKey:      protocol.ResidentKeyRequirementRequired,
+			UserVerification: protocol.VerificationRequired,
+		},
+	})
+	if err != nil {
+		panic(errors.WithMessage(err, "WebAuthn配置无效"))
+	}
+
+	return &WebAuthnProvider{webAuthn: webAuthn}
+}
+
+func (p *WebAuthnProvider) BeginRegistration(user *domain.PasskeyUser) (json.RawMessage, []byte, error) {
+	webAuthnUser := newWebAuthnUser(user)
+
+	// 排除已注册的凭证 避免同一认证器重复注册
+	creation, session, err := p.webAuthn.BeginRegistration(webAuthnUser,
+		webauthn.WithExclusions(webauthn.Credentials(webAuthnUser.credentials).CredentialDescriptors()),
+	)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	return marshalCeremony(creation, session)
+}
+
+func (p *WebAuthnProvider) FinishRegistration(user *domain.PasskeyUser, sessionData, response []byte) (*domain.Passkey, error) {
+	session := new(webauthn.SessionData)
+	if err := json.Unmarshal(sessionData, session); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBytes(response)
+	if err != nil {
+		return nil, verifyFailed(err)
+	}
+
+	credential, err := p.webAuthn.CreateCredential(newWebAuthnUser(user), *session, parsed)
+	if err != nil {
+		return nil, verifyFailed(err)
+	}
+
+	transports := make([]string, 0, len(credential.Transport))
+	for _, transport := range credential.Transport {
+		transports = append(transports, string(transport))
+	}
+
+	return &domain.Passkey{
+		UserID:          user.ID,
+		CredentialID:    credential.ID,
+		PublicKey:       credential.PublicKey,
+		AttestationType: credential.AttestationType,
+		Transports:      transports,
+		AAGUID:          credential.Authenticator.AAGUID,
+		SignCount:       credential.Authenticator.SignCount,
+		BackupEligible:  credential.Flags.BackupEligible,
+		BackupState:     credential.Flags.BackupState,
+	}, nil
+}
+
+func (p *WebAuthnProvider) BeginLogin() (json.RawMessage, []byte, error) {
+	assertion, session, err := p.webAuthn.BeginDiscoverableLogin()
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	return marshalCeremony(assertion, session)
+}
+
+func (p *WebAuthnProvider) FinishLogin(
+	sessionData, response []byte,
+	loadUser func(handle []byte) (*domain.PasskeyUser, error),
+) (*domain.PasskeyAssertion, error) {
+	session := new(webauthn.SessionData)
+	if err := json.Unmarshal(sessionData, session); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	parsed, err := protocol.ParseCredentialRequestResponseBytes(response)
+	if err != nil {
+		return nil, verifyFailed(err)
+	}
+
+	var user *webAuthnUser
+	handler := func(_, userHandle []byte) (webauthn.User, error) {
+		passkeyUser, err := loadUser(userHandle)
+		if err != nil {
+			return nil, err
+		}
+		user = newWebAuthnUser(passkeyUser)
+		return user, nil
+	}
+
+	credential, err := p.webAuthn.ValidateDiscoverableLogin(handler, *session, parsed)
+	if err != nil {
+		return nil, verifyFailed(err)
+	}
+
+	// 找到本次使用的凭证 返回更新后的签名计数
+	for _, passkey := range user.user.Passkeys {
+		if string(passkey.CredentialID) != string(credential.ID) {
+			continue
+		}
+		passkey.SignCount = credential.Authenticator.SignCount
+		passkey.BackupState = credential.Flags.BackupState
+		return &domain.PasskeyAssertion{
+			UserID:       user.user.ID,
+			Passkey:      passkey,
+			CloneWarning: credential.Authenticator.CloneWarning,
+		}, nil
+	}
+
+	return nil, codes.ErrPasskeyVerifyFailed
+}
+
+// webAuthnUser 适配 webauthn.User 接口
+type webAuthnUser struct {
+	user        *domain.PasskeyUser
+	credentials []webauthn.Credential
+}
+
+func newWebAuthnUser(user *domain.PasskeyUser) *webAuthnUser {
+	credentials := make([]webauthn.Credential, 0, len(user.Passkeys))
+	for _, passkey := range user.Passkeys {
+		credentials = append(credentials, domainPasskeyToCredential(passkey))
+	}
+
+	return &webAuthnUser{
+		user:        user,
+		credentials: credentials,
+	}
+}
+
+func (u *webAuthnUser) WebAuthnID() []byte {
+	return u.user.Handle
+}
+
+func (u *webAuthnUser) WebAuthnName() string {
+	return u.user.Name
+}
+
+func (u *webAuthnUser) WebAuthnDisplayName() string {
+	return u.user.DisplayName
+}
+
+func (u *webAuthnUser) WebAuthnCredentials() []webauthn.Credential {
+	return u.credentials
+}
+
+func domainPasskeyToCredential(passkey *domain.Passkey) webauthn.Credential {
+	transports := make([]protocol.AuthenticatorTransport, 0, len(passkey.Transports))
+	for _, transport := range passkey.Transports {
+		transports = append(transports, protocol.AuthenticatorTransport(transport))
+	}
+
+	return webauthn.Credential{
+		ID:              passkey.CredentialID,
+		PublicKey:       passkey.PublicKey,
+		AttestationType: passkey.AttestationType,
+		Transport:       transports,
+		Flags: webauthn.CredentialFlags{
+			UserPresent:    true,
+			UserVerified:   true,
+			BackupEligible: passkey.BackupEligible,
+			BackupState:    passkey.BackupState,
+		},
+		Authenticator: webauthn.Authenticator{
+			AAGUID:    passkey.AAGUID,
+			SignCount: passkey.SignCount,
+		},
+	}
+}
+
+// marshalCeremony options 返回给前端 session 保存在服务端 完成仪式时用于校验
+func marshalCeremony(options any, session *webauthn.SessionData) (json.RawMessage, []byte, error) {
+	optionsByte, err := json.Marshal(options)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	sessionByte, err := json.Marshal(session)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	return optionsByte, sessionByte, nil
+}
+
+// verifyFailed 认证器响应无效属于客户端错误 原因只记录日志
+func verifyFailed(err error) error {
+	var protocolErr *protocol.Error
+	if errors.As(err, &protocolErr) {
+		zap.L().Info("通行密钥校验失败", zap.String("type", protocolErr.Type), zap.String("details", protocolErr.Details), zap.String("debug", protocolErr.DevInfo))
+	}
+	return errors.WithStack(codes.ErrPasskeyVerifyFailed.WithCause(err))
+}
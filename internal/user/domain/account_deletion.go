@@ -0,0 +1,24 @@
+package domain
+
+import "time"
+
+const (
+	// AccountDeletionGracePeriod 申请注销后的宽限期 期间可登录撤销
+	AccountDeletionGracePeriod = 14 * 24 * time.Hour
+	// AccountPurgeInterval 与 AccountPurgeBatchSize 控制到期账号的清理频率与每批数量
+	AccountPurgeInterval  = time.Hour
+	AccountPurgeBatchSize = 100
+	// DeletedUserNickname 清理后账号显示的昵称
+	DeletedUserNickname = "已注销用户"
+)
+
+// UserDataExport 用户模块保存的与该用户相关的全部数据 不包含密码、密钥等凭证本身
+type UserDataExport struct {
+	User       *User
+	Identities []*UserIdentity
+	Sessions   []*Session
+	Passkeys   []*Passkey
+	APIKeys    []*APIKey
+	MFA        *MFAStatus
+	ExportedAt time.Time
+}
@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"strconv"
+	"time"
+)
+
+const (
+	// PermUserRead 与 PermUserManage 管理后台查看与管理用户所需的权限码
+	PermUserRead   = "user:read"
+	PermUserManage = "user:manage"
+	// PermUserImpersonate 模拟登录所需的权限码
+	PermUserImpersonate = "user:impersonate"
+
+	// ImpersonationTokenExpire 模拟登录令牌的有效期 不签发refresh token 过期后需重新申请
+	ImpersonationTokenExpire = 10 * time.Minute
+)
+
+// AdminPermissions 用户管理相关的权限码 拥有其中任意一项的用户不能被模拟
+var AdminPermissions = []string{PermUserRead, PermUserManage, PermUserImpersonate}
+
+// UserQuery 管理后台用户列表的筛选条件 零值字段不参与筛选
+// 按 (created_at, id) 游标分页 Prev 与 Next 游标同时传入时以 Next 为准
+type UserQuery struct {
+	// Email 与 Nickname 为模糊匹配 Nickname 同时按相似度匹配
+	Email       string
+	Nickname    string
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	Disabled    *bool
+	PrevCursor  string
+	NextCursor  string
+	PageSize    int
+}
+
+type UserList struct {
+	Items      []*User
+	PrevCursor string
+	NextCursor string
+	HasPrev    bool
+	HasNext    bool
+}
+
+// UserDetail 管理后台查看的用户详情
+type UserDetail struct {
+	User       *User
+	Identities []*UserIdentity
+	Sessions   []*Session
+	MFAEnabled bool
+}
+
+// GetCreatedAt 与 GetID 供游标分页生成游标
+func (u *User) GetCreatedAt() time.Time {
+	return u.CreatedAt
+}
+
+func (u *User) GetID() string {
+	return strconv.FormatInt(u.ID, 10)
+}
+
+// ImpersonationToken 模拟登录令牌 只能访问不涉及登录凭证的接口
+type ImpersonationToken struct {
+	User        *User
+	AccessToken string
+	ExpiresAt   time.Time
+}
+
+// PermissionChecker 由权限模块实现 用于比较模拟登录双方的权限
+type PermissionChecker interface {
+	// HasPermissions 用户需同时拥有全部给定权限
+	HasPermissions(userID int64, permCodes ...string) (bool, error)
+	ListUserPermissions(userID int64) ([]string, error)
+}
+
+type UserAdminService interface {
+	ListUsers(query *UserQuery) (*UserList, error)
+	GetUserDetail(id int64) (*UserDetail, error)
+	// DisableUser 禁用后立即结束该用户的全部会话 operatorID 为执行操作的管理员
+	DisableUser(operatorID, id int64) error
+	EnableUser(id int64) error
+	// ForceLogout 结束用户在所有设备上的会话
+	ForceLogout(id int64) error
+	// Impersonate 以目标用户身份签发短期令牌 并写入审计日志
+	Impersonate(actorID, id int64, reason string, client *ClientInfo) (*ImpersonationToken, error)
+}
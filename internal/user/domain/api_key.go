@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	// APIKeyPrefix 个人访问令牌的固定前缀 便于在请求头与日志中识别
+	APIKeyPrefix = "pat_"
+	// APIKeyMaxPerUser 每个用户可持有的API Key上限
+	APIKeyMaxPerUser = 20
+	// APIKeyLastUsedInterval 最近使用时间的写入间隔 避免每次请求都写库
+	APIKeyLastUsedInterval = time.Minute
+	// APIKeyScopeAll 不限制范围 权限与用户本人一致
+	APIKeyScopeAll = "*"
+)
+
+// APIKey 供CI与脚本使用的长期令牌 只保存哈希
+// Prefix 为明文的前几位 仅用于列表中辨认
+type APIKey struct {
+	ID         int64
+	UserID     int64
+	Name       string
+	Prefix     string
+	TokenHash  string
+	Scopes     []string
+	ExpiresAt  time.Time
+	LastUsedAt time.Time
+	CreatedAt  time.Time
+}
+
+// IsExpired 未设置过期时间的令牌长期有效
+func (k *APIKey) IsExpired(now time.Time) bool {
+	return !k.ExpiresAt.IsZero() && now.After(k.ExpiresAt)
+}
+
+// IsAPIKey 判断凭证是否为API Key 而非JWT
+func IsAPIKey(credential string) bool {
+	return strings.HasPrefix(credential, APIKeyPrefix)
+}
+
+// APIKeyWithSecret 创建结果 Secret 为明文 只在创建时返回一次
+type APIKeyWithSecret struct {
+	Key    *APIKey
+	Secret string
+}
+
+type APIKeyRepository interface {
+	FindByHash(tokenHash string) (*APIKey, error)
+	ListByUserID(userID int64) ([]*APIKey, error)
+	CountByUserID(userID int64) (int64, error)
+	Create(key *APIKey) (*APIKey, error)
+	Delete(userID, id int64) error
+	UpdateLastUsed(id int64, at time.Time) error
+}
+
+type APIKeyService interface {
+	CreateAPIKey(userID int64, name string, scopes []string, expiresAt time.Time) (*APIKeyWithSecret, error)
+	ListAPIKeys(userID int64) ([]*APIKey, error)
+	RevokeAPIKey(userID, id int64) error
+	// VerifyAPIKey 校验API Key 并转换为与access token一致的声明 供鉴权中间件使用
+	VerifyAPIKey(secret string) (*AccessTokenClaims, error)
+}
@@ -0,0 +1,23 @@
+package domain
+
+import "time"
+
+const (
+	AuditActionImpersonate = "user.impersonate"
+)
+
+// AuditLog 管理操作审计记录 只追加不修改
+type AuditLog struct {
+	ID           int64
+	ActorID      int64
+	Action       string
+	TargetUserID int64
+	Reason       string
+	IP           string
+	UserAgent    string
+	CreatedAt    time.Time
+}
+
+type AuditLogRepository interface {
+	Create(log *AuditLog) error
+}
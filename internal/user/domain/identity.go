@@ -0,0 +1,24 @@
+package domain
+
+import "time"
+
+// UserIdentity 用户关联的第三方登录身份
+// provider + subject 全局唯一 一个用户可关联多个身份
+type UserIdentity struct {
+	ID         int64
+	UserID     int64
+	Provider   string
+	Subject    string
+	Email      string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	LastUsedAt time.Time
+}
+
+type UserIdentityRepository interface {
+	FindByProviderSubject(provider, subject string) (*UserIdentity, error)
+	ListByUserID(userID int64) ([]*UserIdentity, error)
+	Create(identity *UserIdentity) (*UserIdentity, error)
+	Delete(userID, id int64) error
+	UpdateLastUsed(id int64) error
+}
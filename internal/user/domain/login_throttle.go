@@ -0,0 +1,52 @@
+package domain
+
+import "time"
+
+const (
+	// LoginFailureWindow 登录失败次数的统计窗口 窗口内无新的失败时自动清零
+	LoginFailureWindow = 15 * time.Minute
+	// LoginCaptchaThreshold 账号或IP失败次数达到后 登录需通过人机验证
+	LoginCaptchaThreshold = 3
+	// LoginDelayBase 与 LoginDelayMax 达到人机验证阈值后 每次失败的等待时间翻倍 直到上限
+	LoginDelayBase = time.Second
+	LoginDelayMax  = 30 * time.Second
+	// LoginLockThreshold 账号连续失败次数达到后锁定 LoginLockDuration
+	LoginLockThreshold = 10
+	LoginLockDuration  = 15 * time.Minute
+	// LoginMaxIPFailures 单个IP在窗口内的失败次数上限 防止撞库时轮换账号
+	LoginMaxIPFailures = 50
+)
+
+// IPThrottleRule 按IP限制接口调用频率 Scope 相同的接口共用计数
+type IPThrottleRule struct {
+	Scope  string
+	Limit  int64
+	Window time.Duration
+}
+
+var (
+	ThrottleOAuth        = IPThrottleRule{Scope: "oauth", Limit: 30, Window: time.Minute}
+	ThrottleRefreshToken = IPThrottleRule{Scope: "refresh_token", Limit: 60, Window: time.Minute}
+)
+
+// LoginThrottleCache 账号使用规范化后的邮箱 未注册的邮箱同样计数 避免泄露邮箱是否注册
+type LoginThrottleCache interface {
+	// GetFailures 返回窗口内账号与IP的登录失败次数 ip 为空时不统计IP
+	GetFailures(account, ip string) (accountFailures, ipFailures int64, err error)
+	// IncrFailures 记录一次登录失败 返回累计次数
+	IncrFailures(account, ip string) (accountFailures, ipFailures int64, err error)
+	// ResetFailures 登录成功或账号被锁定后清空账号失败次数 IP失败次数保留至窗口结束
+	ResetFailures(account string) error
+	// IPFailuresTTL 返回IP失败计数窗口的剩余时间
+	IPFailuresTTL(ip string) (time.Duration, error)
+
+	// Lock 锁定账号 期间即使密码正确也拒绝登录
+	Lock(account string, duration time.Duration) error
+	// SetDelay 设置账号下次允许尝试登录前的等待时间
+	SetDelay(account string, delay time.Duration) error
+	// Blocked 返回账号剩余的锁定与等待时间 未锁定时为0
+	Blocked(account string) (lockTTL, delayTTL time.Duration, err error)
+
+	// IncrIPRequests 固定窗口计数 返回窗口内累计次数 超出限制时同时返回窗口剩余时间
+	IncrIPRequests(rule IPThrottleRule, ip string) (count int64, ttl time.Duration, err error)
+}
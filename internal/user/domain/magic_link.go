@@ -0,0 +1,43 @@
+package domain
+
+import "time"
+
+const (
+	// MagicLinkExpire 登录链接与邮箱验证码的有效期
+	MagicLinkExpire = 10 * time.Minute
+	// MagicLinkMaxAttempts 验证码允许的错误次数 超过后需重新发送
+	MagicLinkMaxAttempts = 5
+	// MagicLinkCooldown 同一邮箱两次发送的最小间隔
+	MagicLinkCooldown = time.Minute
+	// MagicLinkIPWindow 与 MagicLinkMaxPerIP 限制单个IP在窗口内的发送次数
+	MagicLinkIPWindow = time.Hour
+	MagicLinkMaxPerIP = 20
+	// EmailOTPLen 邮箱验证码位数
+	EmailOTPLen = 6
+)
+
+// MagicLinkTicket 无密码登录凭据 同一邮箱只保留最近一次发送的链接与验证码
+// 只保存摘要 链接与验证码任意一个使用成功后整张凭据作废
+type MagicLinkTicket struct {
+	Email     string `json:"email"`
+	TokenHash string `json:"token_hash"`
+	CodeHash  string `json:"code_hash"`
+}
+
+type MagicLinkCache interface {
+	// SaveTicket 覆盖该邮箱之前的凭据
+	SaveTicket(ticket *MagicLinkTicket) error
+	// GetTicket 不存在或已过期时返回 codes.ErrMagicLinkInvalid
+	GetTicket(email string) (*MagicLinkTicket, error)
+	// GetEmailByToken 通过链接中的令牌找到对应邮箱
+	GetEmailByToken(token string) (string, error)
+	// IncrAttempts 记录一次验证码错误 返回累计错误次数
+	IncrAttempts(email string) (int64, error)
+	// RemoveTicket 凭据只能成功使用一次 已被使用时返回 codes.ErrMagicLinkInvalid
+	RemoveTicket(email string) error
+
+	// AcquireCooldown 获取邮箱发送冷却 冷却中时返回剩余时间
+	AcquireCooldown(email string) (ok bool, retryAfter time.Duration, err error)
+	// IncrIPRequests 记录一次IP发送 返回窗口内的累计次数
+	IncrIPRequests(ip string) (int64, error)
+}
@@ -0,0 +1,90 @@
+package domain
+
+import "time"
+
+const (
+	// MFAPendingExpire 密码或第三方校验通过后 完成两步验证的时限
+	MFAPendingExpire = 5 * time.Minute
+	// MFAMaxAttempts 同一个待验证令牌允许的错误次数 超过后需重新登录
+	MFAMaxAttempts = 5
+	// MFAUserMaxFailures 与 MFAUserLockWindow 同一用户在窗口内累计的错误次数上限
+	// 重新登录可获得新的待验证令牌 达到上限后在窗口到期前拒绝该用户的全部两步验证
+	MFAUserMaxFailures = 10
+	MFAUserLockWindow  = 15 * time.Minute
+	// MFACodeReplayWindow TOTP验证码使用后的锁定时间 覆盖校验允许的时间偏移
+	MFACodeReplayWindow = 90 * time.Second
+	// RecoveryCodeCount 每次生成的恢复码数量
+	RecoveryCodeCount = 10
+)
+
+// UserMFA 用户的TOTP配置 Secret 为明文 仅在仓储层加密落库
+// EnabledAt 为空表示已生成密钥但尚未确认绑定
+type UserMFA struct {
+	UserID    int64
+	Secret    string
+	EnabledAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (m *UserMFA) IsEnabled() bool {
+	return !m.EnabledAt.IsZero()
+}
+
+// MFAEnrollment 绑定信息 QRCodePNG 为 otpauth URI 对应的二维码图片
+type MFAEnrollment struct {
+	URI       string
+	Secret    string
+	QRCodePNG []byte
+}
+
+type MFAStatus struct {
+	Enabled                bool
+	RecoveryCodesRemaining int64
+}
+
+// MFAPendingTicket 待完成两步验证的登录凭据
+type MFAPendingTicket struct {
+	UserID int64 `json:"user_id"`
+}
+
+// LoginResult 登录结果 开启两步验证时只返回 MFAToken 需换取正式令牌
+type LoginResult struct {
+	Token    *User2Token
+	MFAToken string
+}
+
+func (r *LoginResult) MFARequired() bool {
+	return r.MFAToken != ""
+}
+
+type UserMFARepository interface {
+	// FindByUserID 未配置时返回 codes.ErrMFANotEnrolled
+	FindByUserID(userID int64) (*UserMFA, error)
+	// Save 按 user_id 覆盖写入
+	Save(mfa *UserMFA) error
+	// Delete 同时删除恢复码
+	Delete(userID int64) error
+
+	// ReplaceRecoveryCodes 作废旧的恢复码 写入新的恢复码摘要
+	ReplaceRecoveryCodes(userID int64, codeHashes []string) error
+	// UseRecoveryCode 将恢复码标记为已使用 不存在或已使用时返回 false
+	UseRecoveryCode(userID int64, codeHash string) (bool, error)
+	CountRecoveryCodes(userID int64) (int64, error)
+}
+
+type MFACache interface {
+	SavePendingToken(token string, ticket *MFAPendingTicket) error
+	GetPendingToken(token string) (*MFAPendingTicket, error)
+	// IncrPendingAttempts 记录一次失败 返回累计失败次数
+	IncrPendingAttempts(token string) (int64, error)
+	// RemovePendingToken 令牌只能成功消费一次 已被消费时返回 codes.ErrMFATokenInvalid
+	RemovePendingToken(token string) error
+	// IncrUserFailures 记录用户的一次失败 每次失败顺延窗口 返回累计失败次数
+	IncrUserFailures(userID int64) (int64, error)
+	// GetUserFailures 返回累计失败次数与窗口剩余时间
+	GetUserFailures(userID int64) (int64, time.Duration, error)
+	ResetUserFailures(userID int64) error
+	// MarkCodeUsed 记录已使用的TOTP验证码 同一验证码在窗口内重复使用时返回 false
+	MarkCodeUsed(userID int64, code string) (bool, error)
+}
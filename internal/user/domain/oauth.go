@@ -0,0 +1,54 @@
+package domain
+
+import "time"
+
+const (
+	OAuthProviderGithub = "github"
+	OAuthProviderGoogle = "google"
+	OAuthProviderOIDC   = "oidc"
+)
+
+const OAuthStateExpire = 10 * time.Minute
+
+// OAuthProvider 第三方登录提供商
+type OAuthProvider interface {
+	Name() string
+	// AuthCodeURL 生成授权跳转地址 codeChallenge 为 PKCE S256 摘要
+	AuthCodeURL(state, codeChallenge string) (string, error)
+	// Exchange 使用授权码和 PKCE verifier 换取第三方用户信息
+	Exchange(code, codeVerifier string) (*OAuthUserInfo, error)
+}
+
+// OAuthProviderRegistry 已启用的第三方登录提供商
+type OAuthProviderRegistry interface {
+	Get(name string) (OAuthProvider, error)
+}
+
+// 授权流程的用途 登录流程产生的 state 不能用于绑定 反之亦然
+const (
+	OAuthPurposeLogin = "login"
+	OAuthPurposeLink  = "link"
+)
+
+// OAuthState 发起授权时生成的 state 对应的凭据 回调时一次性消费
+// Nonce 同时写入发起授权的浏览器的 Cookie 回调时两者必须一致 防止他人将自己的授权码与 state 交给受害者提交
+type OAuthState struct {
+	Provider     string `json:"provider"`
+	CodeVerifier string `json:"code_verifier"`
+	Nonce        string `json:"nonce"`
+	Purpose      string `json:"purpose"`
+	// UserID 绑定流程中发起授权的用户 登录流程为 0
+	UserID int64 `json:"user_id,omitempty"`
+}
+
+// OAuthAuthorization 授权跳转信息 Nonce 由 handler 写入 Cookie 不返回给前端
+type OAuthAuthorization struct {
+	URL   string
+	State string
+	Nonce string
+}
+
+type OAuthStateCache interface {
+	SaveState(state string, oauthState *OAuthState) error
+	ConsumeState(state string) (*OAuthState, error)
+}
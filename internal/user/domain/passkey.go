@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	// PasskeyCeremonyExpire 发起注册或登录后 完成认证器交互的时限
+	PasskeyCeremonyExpire = 5 * time.Minute
+	// PasskeyMaxPerUser 每个用户可注册的通行密钥上限
+	PasskeyMaxPerUser = 10
+)
+
+const (
+	PasskeyCeremonyRegistration = "registration"
+	PasskeyCeremonyLogin        = "login"
+)
+
+// Passkey 用户注册的WebAuthn凭证 只保存公钥
+type Passkey struct {
+	ID              int64
+	UserID          int64
+	Name            string
+	CredentialID    []byte
+	PublicKey       []byte
+	AttestationType string
+	Transports      []string
+	AAGUID          []byte
+	SignCount       uint32
+	BackupEligible  bool
+	BackupState     bool
+	LastUsedAt      time.Time
+	CreatedAt       time.Time
+}
+
+// PasskeyUser WebAuthn仪式中的用户 Handle 为认证器保存的用户句柄
+type PasskeyUser struct {
+	ID          int64
+	Handle      []byte
+	Name        string
+	DisplayName string
+	Passkeys    []*Passkey
+}
+
+// PasskeyCeremony 等待认证器响应的注册或登录仪式 Session 由 WebAuthnProvider 生成与解析
+type PasskeyCeremony struct {
+	Type    string `json:"type"`
+	UserID  int64  `json:"user_id,omitempty"`
+	Session []byte `json:"session"`
+}
+
+// PasskeyChallenge 返回给前端的仪式信息 Options 原样传给 navigator.credentials
+type PasskeyChallenge struct {
+	CeremonyID string
+	Options    json.RawMessage
+}
+
+// PasskeyAssertion 登录断言的校验结果 Passkey 中为更新后的签名计数
+type PasskeyAssertion struct {
+	UserID       int64
+	Passkey      *Passkey
+	CloneWarning bool
+}
+
+// WebAuthnProvider 封装WebAuthn协议的挑战生成与响应校验
+type WebAuthnProvider interface {
+	BeginRegistration(user *PasskeyUser) (options json.RawMessage, session []byte, err error)
+	FinishRegistration(user *PasskeyUser, session, response []byte) (*Passkey, error)
+	// BeginLogin 发起可发现凭证登录 无需预先知道用户
+	BeginLogin() (options json.RawMessage, session []byte, err error)
+	// FinishLogin loadUser 根据认证器返回的用户句柄加载用户
+	FinishLogin(session, response []byte, loadUser func(handle []byte) (*PasskeyUser, error)) (*PasskeyAssertion, error)
+}
+
+type PasskeyRepository interface {
+	ListByUserID(userID int64) ([]*Passkey, error)
+	CountByUserID(userID int64) (int64, error)
+	// Create credential_id 已存在时返回 codes.ErrPasskeyAlreadyRegistered
+	Create(passkey *Passkey) (*Passkey, error)
+	Delete(userID, id int64) error
+	UpdateUsage(id int64, signCount uint32, backupState bool, at time.Time) error
+}
+
+type PasskeyCache interface {
+	SaveCeremony(ceremonyID string, ceremony *PasskeyCeremony) error
+	// ConsumeCeremony 仪式只能使用一次 不存在或已过期时返回 codes.ErrPasskeyCeremonyInvalid
+	ConsumeCeremony(ceremonyID string) (*PasskeyCeremony, error)
+}
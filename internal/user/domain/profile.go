@@ -0,0 +1,46 @@
+package domain
+
+import "time"
+
+const (
+	// AvatarMaxSize 头像文件大小上限
+	AvatarMaxSize = 2 << 20
+	// EmailChangeTokenExpire 更换邮箱确认链接的有效期
+	EmailChangeTokenExpire = time.Hour
+)
+
+// AvatarContentTypes 允许上传的头像类型及保存时使用的扩展名 类型按文件内容识别
+var AvatarContentTypes = map[string]string{
+	"image/png":  ".png",
+	"image/jpeg": ".jpg",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+// ProfileUpdate 为 nil 的字段保持不变
+type ProfileUpdate struct {
+	Nickname *string
+}
+
+// EmailChangeTicket 更换邮箱确认令牌对应的凭据
+// 记录发起时的邮箱 确认前邮箱已变更时旧链接自动失效
+type EmailChangeTicket struct {
+	UserID   int64  `json:"user_id"`
+	OldEmail string `json:"old_email"`
+	NewEmail string `json:"new_email"`
+}
+
+type EmailChangeCache interface {
+	SaveToken(token string, ticket *EmailChangeTicket) error
+	// ConsumeToken 令牌只能使用一次 不存在或已过期时返回 codes.ErrEmailChangeTokenInvalid
+	ConsumeToken(token string) (*EmailChangeTicket, error)
+	// AcquireCooldown 获取发送冷却 冷却中时返回剩余时间
+	AcquireCooldown(userID int64) (ok bool, retryAfter time.Duration, err error)
+}
+
+// AvatarStorage 保存头像文件 返回可公开访问的地址
+type AvatarStorage interface {
+	SaveAvatar(userID int64, data []byte, contentType string) (url string, err error)
+	// RemoveAvatar 删除已保存的头像 第三方登录带来的外部地址直接忽略
+	RemoveAvatar(url string) error
+}
@@ -1,22 +1,92 @@
 package domain
 
+import "time"
+
 type UserRepository interface {
 	// 基础 CRUD
 	FindByID(id int64) (*User, error)
 	FindByEmail(email string) (*User, error)
+	FindByPhone(phone string) (*User, error)
 	Create(user *User) (*User, error)
 	Update(user *User) (*User, error)
-
-	// OAuth 相关
-	FindByOAuthID(provider, oauthID string) (*User, error)
+	// CreateWithIdentity 在同一事务中创建用户及其第三方身份
+	CreateWithIdentity(user *User, identity *UserIdentity) (*User, error)
 	UpdateLastLogin(id int64) error
 
+	// 邮箱验证
+	MarkEmailVerified(id int64) error
+	// ClaimUnverifiedEmail 邮箱所有权首次得到证明时调用 在同一事务中清除密码与手机号
+	// 删除第三方身份 通行密钥 MFA与API Key 再标记邮箱已验证 邮箱已验证时不做任何修改
+	ClaimUnverifiedEmail(id int64) error
+	// UpdateEmail 更换邮箱 新邮箱已确认 同时标记为已验证
+	UpdateEmail(id int64, email string) error
+
+	// 个人资料
+	UpdateProfile(id int64, update *ProfileUpdate) error
+	UpdateAvatar(id int64, avatar string) error
+
+	// 管理后台
+	List(query *UserQuery) (*UserList, error)
+	// Disable 已禁用的用户保留原禁用时间
+	Disable(id int64, at time.Time) error
+	Enable(id int64) error
+
+	// 账号注销
+	ScheduleDeletion(id int64, at time.Time) error
+	CancelDeletion(id int64) error
+	// ListDueForDeletion 返回注销宽限期已过的用户
+	ListDueForDeletion(before time.Time, limit int) ([]*User, error)
+	// Anonymize 在同一事务中删除用户的登录凭证与角色 清除个人信息并软删除用户
+	Anonymize(id int64) error
+
+	// 密码
+	UpdatePassword(id int64, passwordHash string) error
+
 	// 辅助方法
 	EmailExists(email string) (bool, error)
 }
 
 type TokenCache interface {
-	GenRefreshToken(payload *JwtPayload) (string, error)
+	// GenRefreshToken 生成refresh token parentToken 为轮换前的令牌 首次签发时为空
+	GenRefreshToken(payload *JwtPayload, parentToken string) (string, error)
+	// ValidateRefreshToken 仅对未被轮换的令牌返回payload
 	ValidateRefreshToken(refreshToken string) (*JwtPayload, error)
-	RemoveRefreshToken(refreshToken string) error
+	// ClaimRefreshToken 原子地将令牌标记为已轮换 返回标记前的记录
+	ClaimRefreshToken(refreshToken string) (*RefreshTokenRecord, error)
+
+	// SaveSession 写入会话并将其当前refresh token指向refreshToken
+	SaveSession(session *Session, refreshToken string) error
+	GetSession(sessionID string) (*Session, error)
+	ListUserSessions(userID int64) ([]*Session, error)
+	// RemoveSession 删除会话及其当前refresh token
+	RemoveSession(sessionID string) error
+	RemoveUserSessions(userID int64) error
+}
+
+type EmailVerifyCache interface {
+	SaveToken(token string, ticket *EmailVerifyTicket) error
+	ConsumeToken(token string) (*EmailVerifyTicket, error)
+	// AcquireResendCooldown 获取重发冷却 冷却中时返回剩余时间
+	AcquireResendCooldown(userID int64) (ok bool, retryAfter time.Duration, err error)
+}
+
+type PasswordResetCache interface {
+	SaveToken(token string, ticket *PasswordResetTicket) error
+	ConsumeToken(token string) (*PasswordResetTicket, error)
+}
+
+type UserMailer interface {
+	SendEmailVerification(to, nickname, link string) error
+	SendPasswordReset(to, nickname, link string) error
+	SendMagicLink(to, link, code string) error
+	SendEmailChange(to, nickname, link string) error
+}
+
+// AccessTokenDenylist access token 吊销名单 条目只需存活到对应令牌过期
+type AccessTokenDenylist interface {
+	DenyToken(jti string, ttl time.Duration) error
+	DenySession(sessionID string, ttl time.Duration) error
+	// DenyUserTokensBefore 吊销用户在 before 及之前签发的全部令牌
+	DenyUserTokensBefore(userID int64, before time.Time, ttl time.Duration) error
+	IsDenied(claims *AccessTokenClaims) (bool, error)
 }
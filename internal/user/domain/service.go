@@ -1,18 +1,103 @@
 package domain
 
+import "time"
+
 type UserService interface {
-	AuthenticateWithOAuth(provider string, userInfo *OAuthUserInfo) (*User2Token, error)
-	RefreshUserToken(refreshToken string) (*User2Token, error)
+	Register(email, password, nickname string, client *ClientInfo) (*User2Token, error)
+	// Login 开启两步验证的用户返回待验证令牌 需调用 VerifyMFA 换取正式令牌
+	Login(email, password string, client *ClientInfo) (*LoginResult, error)
+	GetOAuthAuthorization(provider string) (*OAuthAuthorization, error)
+	AuthenticateWithOAuth(provider, code, state, nonce string, client *ClientInfo) (*LoginResult, error)
+	RefreshUserToken(refreshToken string, client *ClientInfo) (*User2Token, error)
+	Logout(refreshToken, accessToken string) error
+	LogoutAll(userID int64) error
+
+	ListSessions(userID int64) ([]*Session, error)
+	RevokeSession(userID int64, sessionID string) error
 	GetUser(id int64) (*User, error)
+
+	SendEmailVerification(userID int64) error
+	VerifyEmail(token string) error
+
+	ForgotPassword(email string) error
+	ResetPassword(token, newPassword string) error
+
+	ListIdentities(userID int64) ([]*UserIdentity, error)
+	GetOAuthLinkAuthorization(userID int64, provider string) (*OAuthAuthorization, error)
+	LinkIdentity(userID int64, provider, code, state, nonce string) (*UserIdentity, error)
+	UnlinkIdentity(userID, identityID int64) error
+
+	GetMFAStatus(userID int64) (*MFAStatus, error)
+	// EnrollTOTP 生成新的TOTP密钥 确认前不生效
+	EnrollTOTP(userID int64) (*MFAEnrollment, error)
+	// ConfirmTOTP 校验验证码后开启两步验证 返回明文恢复码
+	ConfirmTOTP(userID int64, code string) ([]string, error)
+	// DisableMFA code 可以是TOTP验证码或恢复码
+	DisableMFA(userID int64, code string) error
+	RegenerateRecoveryCodes(userID int64, code string) ([]string, error)
+	// VerifyMFA 使用待验证令牌与验证码换取正式令牌
+	VerifyMFA(mfaToken, code string, client *ClientInfo) (*User2Token, error)
+
+	ListPasskeys(userID int64) ([]*Passkey, error)
+	BeginPasskeyRegistration(userID int64) (*PasskeyChallenge, error)
+	// FinishPasskeyRegistration response 为认证器返回的 PublicKeyCredential JSON
+	FinishPasskeyRegistration(userID int64, ceremonyID, name string, response []byte) (*Passkey, error)
+	DeletePasskey(userID, id int64) error
+	BeginPasskeyLogin() (*PasskeyChallenge, error)
+	FinishPasskeyLogin(ceremonyID string, response []byte, client *ClientInfo) (*User2Token, error)
+
+	// RequestMagicLink 发送包含登录链接与验证码的邮件
+	RequestMagicLink(email string, client *ClientInfo) error
+	VerifyMagicLink(token string, client *ClientInfo) (*LoginResult, error)
+	VerifyEmailOTP(email, code string, client *ClientInfo) (*LoginResult, error)
+
+	// RequestSmsCode 发送短信登录验证码
+	RequestSmsCode(phone string, client *ClientInfo) error
+	VerifySmsCode(phone, code string, client *ClientInfo) (*LoginResult, error)
+
+	// LoginCaptchaRequired 账号或IP登录失败次数达到阈值后 密码登录需通过人机验证
+	LoginCaptchaRequired(email, ip string) (bool, error)
+	// ThrottleIP 超出限制时返回 codes.ErrTooManyRequests
+	ThrottleIP(rule IPThrottleRule, ip string) error
+
+	UpdateProfile(userID int64, update *ProfileUpdate) (*User, error)
+	// UploadAvatar data 为头像文件内容 返回更新后的用户
+	UploadAvatar(userID int64, data []byte) (*User, error)
+	// RequestEmailChange 向新邮箱发送确认链接 设置过密码的用户需提供当前密码
+	RequestEmailChange(userID int64, email, password string) error
+	ConfirmEmailChange(token string) error
+
+	// RequestAccountDeletion 宽限期后清理账号 设置过密码的用户需提供当前密码
+	RequestAccountDeletion(userID int64, password string) (*User, error)
+	CancelAccountDeletion(userID int64) error
+	// PurgeDueAccounts 清理宽限期已过的账号 返回本次清理的数量
+	PurgeDueAccounts() (int, error)
+	ExportUserData(userID int64) (*UserDataExport, error)
 }
 
 type TokenService interface {
 	GenerateAccessToken(payload *JwtPayload) (string, error)
 	ValidateAccessToken(token string) (isExpire bool, err error)
 	ParseAccessToken(token string) (payload *JwtPayload, err error)
+	// VerifyAccessToken 校验令牌并检查吊销名单 供鉴权中间件使用
+	VerifyAccessToken(token string) (*AccessTokenClaims, error)
+	RevokeAccessToken(token string) error
 
-	RefreshAccessToken(refreshToken string) (string, error)
+	// IssueSession 创建新会话并签发令牌
+	IssueSession(userID int64, client *ClientInfo) (*User2Token, error)
+	// RotateSession 使用refresh token换取新令牌 旧refresh token立即失效
+	RotateSession(refreshToken string, client *ClientInfo) (*User2Token, error)
+
+	ListSessions(userID int64) ([]*Session, error)
+	RemoveSession(userID int64, sessionID string) error
+	RemoveSessionByRefreshToken(refreshToken string) error
+	RemoveUserSessions(userID int64) error
+
+	// IssueImpersonationToken 签发携带 act 声明的短期 access token 不创建会话
+	IssueImpersonationToken(userID, actorID int64) (token string, expiresAt time.Time, err error)
+}
 
-	GenerateRefreshToken(payload *JwtPayload) (string, error)
-	RemoveRefreshToken(refreshToken string) error
+// TokenClaimsProvider 签发令牌时补充角色与权限范围等业务声明
+type TokenClaimsProvider interface {
+	FillClaims(payload *JwtPayload) error
 }
@@ -0,0 +1,80 @@
+package domain
+
+import "time"
+
+const SessionExpire = 30 * 24 * time.Hour
+
+// ClientInfo 发起登录或刷新请求的客户端信息
+type ClientInfo struct {
+	IP         string
+	UserAgent  string
+	DeviceName string
+}
+
+// Session 一次登录对应一个会话 刷新令牌轮换时会话ID保持不变
+type Session struct {
+	ID            string
+	UserID        int64
+	IP            string
+	UserAgent     string
+	DeviceName    string
+	CreatedAt     time.Time
+	LastRefreshAt time.Time
+}
+
+// RefreshTokenRecord refresh token 在缓存中的记录
+// 同一会话内轮换产生的令牌构成一个令牌族 会话ID即令牌族ID
+type RefreshTokenRecord struct {
+	UserID     int64
+	SessionID  string
+	ParentHash string
+	// Rotated 令牌已被轮换过 再次出现说明发生了重放
+	Rotated bool
+}
+
+// AccessTokenClaims access token 中用于鉴权与吊销判断的完整声明
+type AccessTokenClaims struct {
+	ID        string
+	Issuer    string
+	Subject   string
+	Audience  []string
+	UserID    int64
+	SessionID string
+	Roles     []string
+	// Scopes 通过API Key认证时为其授权范围 登录令牌为签发时的权限码
+	Scopes    []string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+	// APIKeyID 通过API Key认证时非零 此时没有会话与jti
+	APIKeyID int64
+	// ActorID 模拟登录时为实际操作的管理员 此时没有会话
+	ActorID int64
+}
+
+// IsAPIKey 当前请求是否通过API Key认证
+func (c *AccessTokenClaims) IsAPIKey() bool {
+	return c.APIKeyID != 0
+}
+
+// IsImpersonated 当前请求是否为管理员模拟登录
+func (c *AccessTokenClaims) IsImpersonated() bool {
+	return c.ActorID != 0
+}
+
+func (c *AccessTokenClaims) HasRole(role string) bool {
+	for _, r := range c.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *AccessTokenClaims) HasScope(scope string) bool {
+	for _, s := range c.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+package domain
+
+import "time"
+
+const (
+	// SmsCodeExpire 短信验证码有效期
+	SmsCodeExpire = 5 * time.Minute
+	// SmsCodeMaxAttempts 验证码允许的错误次数 超过后需重新发送
+	SmsCodeMaxAttempts = 5
+	// SmsCodeLen 短信验证码位数
+	SmsCodeLen = 6
+	// SmsCooldown 同一手机号两次发送的最小间隔
+	SmsCooldown = time.Minute
+	// SmsPhoneWindow 与 SmsMaxPerPhone 限制单个手机号在窗口内的发送次数
+	SmsPhoneWindow = 24 * time.Hour
+	SmsMaxPerPhone = 10
+	// SmsIPWindow 与 SmsMaxPerIP 限制单个IP在窗口内的发送次数
+	SmsIPWindow = time.Hour
+	SmsMaxPerIP = 20
+)
+
+// SmsSender 短信发送通道 由具体服务商实现
+type SmsSender interface {
+	Send(phone, content string) error
+}
+
+type SmsCodeCache interface {
+	// SaveCode 覆盖该手机号之前的验证码 只保存摘要
+	SaveCode(phone, codeHash string) error
+	// GetCode 返回验证码摘要 不存在或已过期时返回 codes.ErrSmsCodeInvalid
+	GetCode(phone string) (string, error)
+	// IncrAttempts 记录一次验证码错误 返回累计错误次数
+	IncrAttempts(phone string) (int64, error)
+	// RemoveCode 验证码只能成功使用一次 已被使用时返回 codes.ErrSmsCodeInvalid
+	RemoveCode(phone string) error
+
+	// AcquireCooldown 获取手机号发送冷却 冷却中时返回剩余时间
+	AcquireCooldown(phone string) (ok bool, retryAfter time.Duration, err error)
+	// IncrPhoneRequests 记录一次手机号发送 返回窗口内的累计次数
+	IncrPhoneRequests(phone string) (int64, error)
+	// IncrIPRequests 记录一次IP发送 返回窗口内的累计次数
+	IncrIPRequests(ip string) (int64, error)
+}
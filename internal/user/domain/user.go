@@ -3,19 +3,67 @@ package domain
 import "time"
 
 type User struct {
-	ID           int64
-	Email        string
-	PasswordHash string
-	Nickname     string
-	GithubID     string
-	Avatar       string
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
-	LastLoginAt  time.Time
+	ID              int64
+	Email           string
+	PasswordHash    string
+	Nickname        string
+	Avatar          string
+	EmailVerifiedAt time.Time
+	Phone           string
+	PhoneVerifiedAt time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	LastLoginAt     time.Time
+	// DisabledAt 管理员禁用账号的时间
+	DisabledAt time.Time
+	// DeletionScheduledAt 申请注销后到期清理的时间
+	DeletionScheduledAt time.Time
 }
 
+func (u *User) IsEmailVerified() bool {
+	return !u.EmailVerifiedAt.IsZero()
+}
+
+func (u *User) HasPassword() bool {
+	return u.PasswordHash != ""
+}
+
+func (u *User) IsDisabled() bool {
+	return !u.DisabledAt.IsZero()
+}
+
+func (u *User) IsDeletionScheduled() bool {
+	return !u.DeletionScheduledAt.IsZero()
+}
+
+func (u *User) IsPhoneVerified() bool {
+	return !u.PhoneVerifiedAt.IsZero()
+}
+
+// AccountName 展示给认证器等外部应用的账号名 仅绑定手机号的用户没有邮箱
+func (u *User) AccountName() string {
+	if u.Email != "" {
+		return u.Email
+	}
+	return u.Phone
+}
+
+// JwtPayload access token 与 refresh token 携带的业务声明
+// Scopes 为签发时用户拥有的权限码 与角色一样仅供展示或下游粗粒度判断
+// 用户可属于多个组织 当前组织由租户中间件按请求解析 不写入令牌
 type JwtPayload struct {
-	UserID int64 `json:"user_id"`
+	UserID    int64    `json:"user_id"`
+	SessionID string   `json:"session_id,omitempty"`
+	Roles     []string `json:"roles,omitempty"`
+	Scopes    []string `json:"scopes,omitempty"`
+	// Act 模拟登录时实际操作的管理员 其余声明均属于被模拟的用户
+	Act *Actor `json:"act,omitempty"`
+}
+
+// Actor 参照 RFC 8693 的 act 声明
+type Actor struct {
+	Subject string `json:"sub"`
+	UserID  int64  `json:"user_id"`
 }
 
 type User2Token struct {
@@ -29,5 +77,25 @@ type OAuthUserInfo struct {
 	Login    string
 	Nickname string
 	Email    string
-	Avatar   string
+	// EmailVerified 提供商确认用户拥有该邮箱 未确认的邮箱不会返回
+	EmailVerified bool
+	Avatar        string
+}
+
+const (
+	EmailVerifyTokenExpire   = 24 * time.Hour
+	PasswordResetTokenExpire = 30 * time.Minute
+)
+
+// EmailVerifyTicket 邮箱验证令牌对应的凭据
+// 记录发送时的邮箱 邮箱变更后旧链接自动失效
+type EmailVerifyTicket struct {
+	UserID int64  `json:"user_id"`
+	Email  string `json:"email"`
+}
+
+// PasswordResetTicket 密码重置令牌对应的凭据
+type PasswordResetTicket struct {
+	UserID int64  `json:"user_id"`
+	Email  string `json:"email"`
 }
@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"scaffold/internal/common/reqkit/bind"
+	"scaffold/internal/common/reskit/response"
+	"scaffold/internal/common/server"
+)
+
+// DeleteAccount godoc
+// @Summary      申请注销账号
+// @Description  14天宽限期后删除登录凭证并清除个人信息，期间账号照常可用，登录后可撤销。设置过密码的账号需提供当前密码
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body handler.DeleteAccountRequest true "请求参数"
+// @Success      200 {object} response.successResponse{data=handler.UserResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误或密码错误"
+// @Failure      401 {object} response.errorResponse
+// @Failure      403 {object} response.errorResponse "不支持使用API Key访问"
+// @Failure      409 {object} response.errorResponse "已申请注销"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/account/delete [post]
+func (h *HttpHandler) DeleteAccount(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(DeleteAccountRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	user, err := h.userService.RequestAccountDeletion(userID, req.Password)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainUserToResponse(user))
+}
+
+// CancelAccountDeletion godoc
+// @Summary      撤销注销账号
+// @Description  宽限期内撤销注销申请
+// @Tags         user
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} response.successResponse "请求成功"
+// @Failure      401 {object} response.errorResponse
+// @Failure      403 {object} response.errorResponse "不支持使用API Key访问"
+// @Failure      409 {object} response.errorResponse "未申请注销"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/account/delete/cancel [post]
+func (h *HttpHandler) CancelAccountDeletion(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	if err := h.userService.CancelAccountDeletion(userID); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// ExportUserData godoc
+// @Summary      导出个人数据
+// @Description  以附件形式下载账号资料、第三方身份、会话、通行密钥、API Key与两步验证状态，不包含密码与密钥。format 为 zip 时各部分分别保存为 JSON 文件
+// @Tags         user
+// @Produce      json
+// @Produce      application/zip
+// @Security     BearerAuth
+// @Param        format query string false "导出格式" Enums(json, zip) default(json)
+// @Success      200 {object} handler.UserDataExportResponse "导出文件"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      401 {object} response.errorResponse
+// @Failure      403 {object} response.errorResponse "不支持使用API Key访问"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/export [get]
+func (h *HttpHandler) ExportUserData(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(ExportUserDataRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	export, err := h.userService.ExportUserData(userID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+	data := domainUserDataExportToResponse(export)
+
+	filename := fmt.Sprintf("user-data-%d-%s", userID, export.ExportedAt.Format("20060102150405"))
+	if req.Format == "zip" {
+		archive, err := buildExportArchive(data)
+		if err != nil {
+			response.Error(ctx, err)
+			return
+		}
+		ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.zip"`, filename))
+		ctx.Data(http.StatusOK, "application/zip", archive)
+		return
+	}
+
+	body, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		response.Error(ctx, errors.WithStack(err))
+		return
+	}
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.json"`, filename))
+	ctx.Data(http.StatusOK, "application/json; charset=utf-8", body)
+}
+
+// buildExportArchive 每个部分单独一个 JSON 文件 便于用户按类别查看
+func buildExportArchive(data *UserDataExportResponse) ([]byte, error) {
+	files := []struct {
+		name    string
+		content any
+	}{
+		{"user.json", data.User},
+		{"identities.json", data.Identities},
+		{"sessions.json", data.Sessions},
+		{"passkeys.json", data.Passkeys},
+		{"api_keys.json", data.APIKeys},
+		{"mfa.json", data.MFA},
+	}
+
+	buf := new(bytes.Buffer)
+	zw := zip.NewWriter(buf)
+	for _, file := range files {
+		w, err := zw.Create(file.name)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(file.content); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return buf.Bytes(), nil
+}
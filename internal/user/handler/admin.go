@@ -0,0 +1,221 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"scaffold/internal/common/reqkit/bind"
+	"scaffold/internal/common/reskit/response"
+	"scaffold/internal/common/server"
+	"scaffold/internal/user/domain"
+)
+
+// AdminListUsers godoc
+// @Summary      用户列表
+// @Description  按注册时间升序游标分页，传入上一页返回的 next_cursor 或 prev_cursor 翻页。邮箱与昵称为模糊匹配，昵称同时按相似度匹配
+// @Tags         user-admin
+// @Produce      json
+// @Security     BearerAuth
+// @Param        email        query  string  false  "邮箱"
+// @Param        nickname     query  string  false  "昵称"
+// @Param        created_from query  int     false  "注册时间起 秒级时间戳 包含"
+// @Param        created_to   query  int     false  "注册时间止 秒级时间戳 不包含"
+// @Param        disabled     query  bool    false  "是否已禁用"
+// @Param        prev_cursor  query  string  false  "上一页游标"
+// @Param        next_cursor  query  string  false  "下一页游标"
+// @Param        page_size    query  int     false  "每页数量 默认20"
+// @Success      200 {object} response.successResponse{data=handler.UserListResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      401 {object} response.errorResponse
+// @Failure      403 {object} response.errorResponse "没有访问权限"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/admin/users [get]
+func (h *HttpHandler) AdminListUsers(ctx *gin.Context) {
+	req := new(ListUsersRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	query := &domain.UserQuery{
+		Email:      req.Email,
+		Nickname:   req.Nickname,
+		Disabled:   req.Disabled,
+		PrevCursor: req.PrevCursor,
+		NextCursor: req.NextCursor,
+		PageSize:   req.PageSize,
+	}
+	if req.CreatedFrom > 0 {
+		query.CreatedFrom = time.Unix(req.CreatedFrom, 0)
+	}
+	if req.CreatedTo > 0 {
+		query.CreatedTo = time.Unix(req.CreatedTo, 0)
+	}
+
+	list, err := h.userAdminService.ListUsers(query)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainUserListToResponse(list))
+}
+
+// AdminGetUser godoc
+// @Summary      用户详情
+// @Description  包含第三方身份、当前会话与两步验证状态
+// @Tags         user-admin
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "用户ID"
+// @Success      200 {object} response.successResponse{data=handler.UserDetailResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      401 {object} response.errorResponse
+// @Failure      403 {object} response.errorResponse "没有访问权限"
+// @Failure      404 {object} response.errorResponse "用户不存在"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/admin/users/{id} [get]
+func (h *HttpHandler) AdminGetUser(ctx *gin.Context) {
+	req := new(AdminUserIDRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	detail, err := h.userAdminService.GetUserDetail(req.ID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainUserDetailToResponse(detail))
+}
+
+// AdminDisableUser godoc
+// @Summary      禁用用户
+// @Description  禁用后立即结束该用户的全部会话，无法再登录、刷新令牌或使用API Key。不能禁用自己
+// @Tags         user-admin
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "用户ID"
+// @Success      200 {object} response.successResponse "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      401 {object} response.errorResponse
+// @Failure      403 {object} response.errorResponse "没有访问权限"
+// @Failure      404 {object} response.errorResponse "用户不存在"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/admin/users/{id}/disable [post]
+func (h *HttpHandler) AdminDisableUser(ctx *gin.Context) {
+	operatorID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(AdminUserIDRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.userAdminService.DisableUser(operatorID, req.ID); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// AdminEnableUser godoc
+// @Summary      启用用户
+// @Tags         user-admin
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "用户ID"
+// @Success      200 {object} response.successResponse "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      401 {object} response.errorResponse
+// @Failure      403 {object} response.errorResponse "没有访问权限"
+// @Failure      404 {object} response.errorResponse "用户不存在"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/admin/users/{id}/enable [post]
+func (h *HttpHandler) AdminEnableUser(ctx *gin.Context) {
+	req := new(AdminUserIDRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.userAdminService.EnableUser(req.ID); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// AdminForceLogout godoc
+// @Summary      强制下线
+// @Description  结束用户在所有设备上的会话，已签发的access token同时失效
+// @Tags         user-admin
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "用户ID"
+// @Success      200 {object} response.successResponse "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      401 {object} response.errorResponse
+// @Failure      403 {object} response.errorResponse "没有访问权限"
+// @Failure      404 {object} response.errorResponse "用户不存在"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/admin/users/{id}/logout [post]
+func (h *HttpHandler) AdminForceLogout(ctx *gin.Context) {
+	req := new(AdminUserIDRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.userAdminService.ForceLogout(req.ID); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// AdminImpersonate godoc
+// @Summary      模拟登录
+// @Description  以目标用户身份签发10分钟有效的access token，令牌的 act 声明记录实际操作的管理员，每次签发都写入审计日志。该令牌不能访问管理登录凭证、注销账号等敏感接口，不能模拟拥有 user:read、user:manage 或 user:impersonate 权限的用户，也不能模拟拥有调用者所没有的权限的用户，只允许登录令牌调用
+// @Tags         user-admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "用户ID"
+// @Param        request body handler.ImpersonateRequest true "请求参数"
+// @Success      200 {object} response.successResponse{data=handler.ImpersonationResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      401 {object} response.errorResponse
+// @Failure      403 {object} response.errorResponse "没有访问权限或目标用户不能被模拟"
+// @Failure      404 {object} response.errorResponse "用户不存在"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/admin/users/{id}/impersonate [post]
+func (h *HttpHandler) AdminImpersonate(ctx *gin.Context) {
+	actorID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(ImpersonateRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	result, err := h.userAdminService.Impersonate(actorID, req.ID, req.Reason, clientInfoFromContext(ctx))
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, &ImpersonationResponse{
+		User:        domainUserToResponse(result.User),
+		AccessToken: result.AccessToken,
+		ExpiresAt:   result.ExpiresAt.Unix(),
+	})
+}
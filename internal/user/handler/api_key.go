@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"scaffold/internal/common/reqkit/bind"
+	"scaffold/internal/common/reskit/response"
+	"scaffold/internal/common/server"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CreateAPIKey godoc
+// @Summary      创建API Key
+// @Description  创建供CI与脚本使用的个人访问令牌，明文只在本次响应中返回。使用时通过 Authorization: Bearer pat_... 或 X-API-Key 请求头传递。scopes 为允许使用的权限码，* 表示与本人权限一致；expires_in_days 为 0 表示永不过期
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body handler.CreateAPIKeyRequest true "请求参数"
+// @Success      200 {object} response.successResponse{data=handler.CreateAPIKeyResponse} "创建成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      401 {object} response.errorResponse
+// @Failure      403 {object} response.errorResponse "不支持使用API Key访问"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/api-keys [post]
+func (h *HttpHandler) CreateAPIKey(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(CreateAPIKeyRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	var expiresAt time.Time
+	if req.ExpiresInDays > 0 {
+		expiresAt = time.Now().AddDate(0, 0, req.ExpiresInDays)
+	}
+
+	created, err := h.apiKeyService.CreateAPIKey(userID, req.Name, req.Scopes, expiresAt)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, &CreateAPIKeyResponse{
+		APIKey: domainAPIKeyToResponse(created.Key),
+		Token:  created.Secret,
+	})
+}
+
+// ListAPIKeys godoc
+// @Summary      API Key列表
+// @Description  列出当前用户的API Key，不包含明文
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} response.successResponse{data=[]handler.APIKeyResponse} "获取成功"
+// @Failure      401 {object} response.errorResponse
+// @Failure      403 {object} response.errorResponse "不支持使用API Key访问"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/api-keys [get]
+func (h *HttpHandler) ListAPIKeys(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	keys, err := h.apiKeyService.ListAPIKeys(userID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainAPIKeysToResponse(keys))
+}
+
+// RevokeAPIKey godoc
+// @Summary      吊销API Key
+// @Description  吊销后使用该API Key的请求立即失败
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "API Key id"
+// @Success      200 {object} response.successResponse "吊销成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      401 {object} response.errorResponse
+// @Failure      403 {object} response.errorResponse "不支持使用API Key访问"
+// @Failure      404 {object} response.errorResponse "API Key不存在"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/api-keys/{id} [delete]
+func (h *HttpHandler) RevokeAPIKey(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(RevokeAPIKeyRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.apiKeyService.RevokeAPIKey(userID, req.ID); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
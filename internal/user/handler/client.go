@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"scaffold/internal/user/domain"
+)
+
+const (
+	deviceNameHeaderKey = "X-Device-Name"
+	maxUserAgentLength  = 255
+	maxDeviceNameLength = 60
+)
+
+// clientInfoFromContext 客户端可通过 X-Device-Name 自定义设备名 未传时根据 User-Agent 推断
+func clientInfoFromContext(ctx *gin.Context) *domain.ClientInfo {
+	userAgent := truncate(ctx.Request.UserAgent(), maxUserAgentLength)
+
+	deviceName := strings.TrimSpace(ctx.GetHeader(deviceNameHeaderKey))
+	if deviceName == "" {
+		deviceName = deviceNameFromUserAgent(userAgent)
+	}
+
+	return &domain.ClientInfo{
+		IP:         ctx.ClientIP(),
+		UserAgent:  userAgent,
+		DeviceName: truncate(deviceName, maxDeviceNameLength),
+	}
+}
+
+// 按顺序匹配 Edge/Opera 的 UA 中同时包含 Chrome 需排在前面
+var (
+	uaBrowsers = [][2]string{
+		{"Edg/", "Edge"},
+		{"OPR/", "Opera"},
+		{"Firefox/", "Firefox"},
+		{"Chrome/", "Chrome"},
+		{"Safari/", "Safari"},
+	}
+	uaPlatforms = [][2]string{
+		{"iPhone", "iPhone"},
+		{"iPad", "iPad"},
+		{"Android", "Android"},
+		{"Windows", "Windows"},
+		{"Mac OS X", "macOS"},
+		{"Linux", "Linux"},
+	}
+)
+
+// deviceNameFromUserAgent 粗略识别浏览器与系统 例如 "Chrome on Windows"
+func deviceNameFromUserAgent(userAgent string) string {
+	browser := matchUserAgent(userAgent, uaBrowsers)
+	platform := matchUserAgent(userAgent, uaPlatforms)
+
+	switch {
+	case browser != "" && platform != "":
+		return browser + " on " + platform
+	case browser != "":
+		return browser
+	case platform != "":
+		return platform
+	default:
+		return "Unknown device"
+	}
+}
+
+func matchUserAgent(userAgent string, rules [][2]string) string {
+	for _, rule := range rules {
+		if strings.Contains(userAgent, rule[0]) {
+			return rule[1]
+		}
+	}
+	return ""
+}
+
+func truncate(s string, maxLen int) string {
+	runes := []rune(s)
+	if len(runes) <= maxLen {
+		return s
+	}
+	return string(runes[:maxLen])
+}
@@ -9,15 +9,24 @@ func domainUserToResponse(user *domain.User) *UserResponse {
 		return nil
 	}
 
-	return &UserResponse{
-		ID:          user.ID,
-		Email:       user.Email,
-		NickName:    user.Nickname,
-		Avatar:      user.Avatar,
-		CreatedAt:   user.CreatedAt.Unix(),
-		UpdatedAt:   user.UpdatedAt.Unix(),
-		LastLoginAt: user.LastLoginAt.Unix(),
+	resp := &UserResponse{
+		ID:            user.ID,
+		Email:         user.Email,
+		NickName:      user.Nickname,
+		Avatar:        user.Avatar,
+		EmailVerified: user.IsEmailVerified(),
+		Phone:         user.Phone,
+		PhoneVerified: user.IsPhoneVerified(),
+		CreatedAt:     user.CreatedAt.Unix(),
+		UpdatedAt:     user.UpdatedAt.Unix(),
+		LastLoginAt:   user.LastLoginAt.Unix(),
 	}
+
+	if user.IsDeletionScheduled() {
+		resp.DeletionScheduledAt = user.DeletionScheduledAt.Unix()
+	}
+
+	return resp
 }
 
 func domain2TokenToAuthResponse(token2 *domain.User2Token) *AuthResponse {
@@ -27,9 +36,184 @@ func domain2TokenToAuthResponse(token2 *domain.User2Token) *AuthResponse {
 	}
 }
 
+func domainLoginResultToAuthResponse(result *domain.LoginResult) *AuthResponse {
+	if result.MFARequired() {
+		return &AuthResponse{
+			MFARequired: true,
+			MFAToken:    result.MFAToken,
+		}
+	}
+	return domain2TokenToAuthResponse(result.Token)
+}
+
 func domainSessionToRefreshResponse(token2 *domain.User2Token) *RefreshTokenResponse {
 	return &RefreshTokenResponse{
 		AccessToken:  token2.AccessToken,
 		RefreshToken: token2.RefreshToken,
 	}
 }
+
+func domainIdentityToResponse(identity *domain.UserIdentity) *IdentityResponse {
+	if identity == nil {
+		return nil
+	}
+
+	resp := &IdentityResponse{
+		ID:        identity.ID,
+		Provider:  identity.Provider,
+		Email:     identity.Email,
+		CreatedAt: identity.CreatedAt.Unix(),
+	}
+
+	if !identity.LastUsedAt.IsZero() {
+		resp.LastUsedAt = identity.LastUsedAt.Unix()
+	}
+
+	return resp
+}
+
+func domainIdentitiesToResponse(identities []*domain.UserIdentity) []*IdentityResponse {
+	list := make([]*IdentityResponse, 0, len(identities))
+	for _, identity := range identities {
+		list = append(list, domainIdentityToResponse(identity))
+	}
+	return list
+}
+
+func domainSessionsToResponse(sessions []*domain.Session, currentSessionID string) []*SessionResponse {
+	list := make([]*SessionResponse, 0, len(sessions))
+	for _, session := range sessions {
+		list = append(list, &SessionResponse{
+			ID:            session.ID,
+			IP:            session.IP,
+			UserAgent:     session.UserAgent,
+			DeviceName:    session.DeviceName,
+			Current:       session.ID == currentSessionID,
+			CreatedAt:     session.CreatedAt.Unix(),
+			LastRefreshAt: session.LastRefreshAt.Unix(),
+		})
+	}
+	return list
+}
+
+func domainAPIKeyToResponse(key *domain.APIKey) *APIKeyResponse {
+	if key == nil {
+		return nil
+	}
+
+	resp := &APIKeyResponse{
+		ID:        key.ID,
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		Scopes:    key.Scopes,
+		CreatedAt: key.CreatedAt.Unix(),
+	}
+
+	if resp.Scopes == nil {
+		resp.Scopes = []string{}
+	}
+
+	if !key.ExpiresAt.IsZero() {
+		resp.ExpiresAt = key.ExpiresAt.Unix()
+	}
+
+	if !key.LastUsedAt.IsZero() {
+		resp.LastUsedAt = key.LastUsedAt.Unix()
+	}
+
+	return resp
+}
+
+func domainAPIKeysToResponse(keys []*domain.APIKey) []*APIKeyResponse {
+	list := make([]*APIKeyResponse, 0, len(keys))
+	for _, key := range keys {
+		list = append(list, domainAPIKeyToResponse(key))
+	}
+	return list
+}
+
+func domainPasskeyToResponse(passkey *domain.Passkey) *PasskeyResponse {
+	if passkey == nil {
+		return nil
+	}
+
+	resp := &PasskeyResponse{
+		ID:         passkey.ID,
+		Name:       passkey.Name,
+		Transports: passkey.Transports,
+		Synced:     passkey.BackupState,
+		CreatedAt:  passkey.CreatedAt.Unix(),
+	}
+
+	if resp.Transports == nil {
+		resp.Transports = []string{}
+	}
+
+	if !passkey.LastUsedAt.IsZero() {
+		resp.LastUsedAt = passkey.LastUsedAt.Unix()
+	}
+
+	return resp
+}
+
+func domainPasskeysToResponse(passkeys []*domain.Passkey) []*PasskeyResponse {
+	list := make([]*PasskeyResponse, 0, len(passkeys))
+	for _, passkey := range passkeys {
+		list = append(list, domainPasskeyToResponse(passkey))
+	}
+	return list
+}
+
+func domainMFAStatusToResponse(status *domain.MFAStatus) *MFAStatusResponse {
+	return &MFAStatusResponse{
+		Enabled:                status.Enabled,
+		RecoveryCodesRemaining: status.RecoveryCodesRemaining,
+	}
+}
+
+func domainUserDataExportToResponse(export *domain.UserDataExport) *UserDataExportResponse {
+	return &UserDataExportResponse{
+		User:       domainUserToResponse(export.User),
+		Identities: domainIdentitiesToResponse(export.Identities),
+		Sessions:   domainSessionsToResponse(export.Sessions, ""),
+		Passkeys:   domainPasskeysToResponse(export.Passkeys),
+		APIKeys:    domainAPIKeysToResponse(export.APIKeys),
+		MFA:        domainMFAStatusToResponse(export.MFA),
+		ExportedAt: export.ExportedAt.Unix(),
+	}
+}
+
+func domainUserToAdminResponse(user *domain.User) *AdminUserResponse {
+	resp := &AdminUserResponse{
+		UserResponse: *domainUserToResponse(user),
+		Disabled:     user.IsDisabled(),
+	}
+	if user.IsDisabled() {
+		resp.DisabledAt = user.DisabledAt.Unix()
+	}
+	return resp
+}
+
+func domainUserListToResponse(list *domain.UserList) *UserListResponse {
+	items := make([]*AdminUserResponse, 0, len(list.Items))
+	for _, user := range list.Items {
+		items = append(items, domainUserToAdminResponse(user))
+	}
+
+	return &UserListResponse{
+		Items:      items,
+		PrevCursor: list.PrevCursor,
+		NextCursor: list.NextCursor,
+		HasPrev:    list.HasPrev,
+		HasNext:    list.HasNext,
+	}
+}
+
+func domainUserDetailToResponse(detail *domain.UserDetail) *UserDetailResponse {
+	return &UserDetailResponse{
+		User:       domainUserToAdminResponse(detail.User),
+		Identities: domainIdentitiesToResponse(detail.Identities),
+		Sessions:   domainSessionsToResponse(detail.Sessions, ""),
+		MFAEnabled: detail.MFAEnabled,
+	}
+}
@@ -1,7 +1,155 @@
 package handler
 
-type GithubAuthRequest struct {
-	Code string `json:"code" binding:"required"`
+import "encoding/json"
+
+type OAuthAuthRequest struct {
+	Code  string `json:"code" binding:"required"`
+	State string `json:"state" binding:"required"`
+}
+
+type OAuthAuthorizeResponse struct {
+	URL   string `json:"url"`
+	State string `json:"state"`
+}
+
+type RegisterRequest struct {
+	Email    string `json:"email" binding:"required,email,max=80"`
+	Password string `json:"password" binding:"required,min=8,max=64"`
+	Nickname string `json:"nickname" binding:"required,max=20"`
+}
+
+type LoginRequest struct {
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required"`
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Password string `json:"password" binding:"required,min=8,max=64"`
+}
+
+type MagicLinkRequest struct {
+	Email string `json:"email" binding:"required,email,max=80"`
+}
+
+type VerifyMagicLinkRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+type VerifyEmailOTPRequest struct {
+	Email string `json:"email" binding:"required,email"`
+	Code  string `json:"code" binding:"required,len=6,numeric"`
+}
+
+type UpdateProfileRequest struct {
+	Nickname *string `json:"nickname" binding:"omitempty,min=1,max=20"`
+}
+
+type ChangeEmailRequest struct {
+	Email    string `json:"email" binding:"required,email,max=80"`
+	Password string `json:"password" binding:"omitempty,max=64"`
+}
+
+type ConfirmEmailChangeRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+type DeleteAccountRequest struct {
+	Password string `json:"password" binding:"omitempty,max=64"`
+}
+
+type ExportUserDataRequest struct {
+	Format string `json:"-" form:"format" binding:"omitempty,oneof=json zip"`
+}
+
+type SmsCodeRequest struct {
+	Phone string `json:"phone" binding:"required,mobile_cn"`
+}
+
+type VerifySmsCodeRequest struct {
+	Phone string `json:"phone" binding:"required,mobile_cn"`
+	Code  string `json:"code" binding:"required,len=6,numeric"`
+}
+
+type LinkIdentityRequest struct {
+	Provider string `json:"-" uri:"provider" binding:"required"`
+	Code     string `json:"code" binding:"required"`
+	State    string `json:"state" binding:"required"`
+}
+
+type UnlinkIdentityRequest struct {
+	ID int64 `json:"-" uri:"id" binding:"required"`
+}
+
+type RevokeSessionRequest struct {
+	ID string `json:"-" uri:"id" binding:"required,max=64"`
+}
+
+type CreateAPIKeyRequest struct {
+	Name          string   `json:"name" binding:"required,max=50"`
+	Scopes        []string `json:"scopes" binding:"max=20,dive,required,max=100"`
+	ExpiresInDays int      `json:"expires_in_days" binding:"min=0,max=3650"`
+}
+
+type VerifyMFARequest struct {
+	MFAToken string `json:"mfa_token" binding:"required"`
+	Code     string `json:"code" binding:"required,max=32"`
+}
+
+// MFACodeRequest Code 可以是TOTP验证码或恢复码
+type MFACodeRequest struct {
+	Code string `json:"code" binding:"required,max=32"`
+}
+
+type FinishPasskeyRegistrationRequest struct {
+	CeremonyID string `json:"ceremony_id" binding:"required"`
+	Name       string `json:"name" binding:"max=50"`
+	// Credential navigator.credentials.create 返回的 PublicKeyCredential
+	Credential json.RawMessage `json:"credential" binding:"required" swaggertype:"object"`
+}
+
+type FinishPasskeyLoginRequest struct {
+	CeremonyID string `json:"ceremony_id" binding:"required"`
+	// Credential navigator.credentials.get 返回的 PublicKeyCredential
+	Credential json.RawMessage `json:"credential" binding:"required" swaggertype:"object"`
+}
+
+type DeletePasskeyRequest struct {
+	ID int64 `json:"-" uri:"id" binding:"required"`
+}
+
+type RevokeAPIKeyRequest struct {
+	ID int64 `json:"-" uri:"id" binding:"required"`
+}
+
+type ListUsersRequest struct {
+	Email    string `form:"email" binding:"max=80"`
+	Nickname string `form:"nickname" binding:"max=20"`
+	// CreatedFrom 与 CreatedTo 为秒级时间戳 左闭右开
+	CreatedFrom int64  `form:"created_from" binding:"min=0"`
+	CreatedTo   int64  `form:"created_to" binding:"min=0"`
+	Disabled    *bool  `form:"disabled"`
+	PrevCursor  string `form:"prev_cursor" binding:"max=200"`
+	NextCursor  string `form:"next_cursor" binding:"max=200"`
+	PageSize    int    `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+type AdminUserIDRequest struct {
+	ID int64 `json:"-" uri:"id" binding:"required"`
+}
+
+type ImpersonateRequest struct {
+	ID int64 `json:"-" uri:"id" binding:"required"`
+	// Reason 记录到审计日志 如工单编号
+	Reason string `json:"reason" binding:"required,max=200"`
 }
 
 type UserResponse struct {
@@ -10,15 +158,51 @@ type UserResponse struct {
 	NickName      string `json:"username"`
 	Avatar        string `json:"avatar_url,omitempty"`
 	EmailVerified bool   `json:"email_verified"`
+	Phone         string `json:"phone,omitempty"`
+	PhoneVerified bool   `json:"phone_verified"`
 	CreatedAt     int64  `json:"created_at"`
 	UpdatedAt     int64  `json:"updated_at"`
 	LastLoginAt   int64  `json:"last_login_at"`
+	// DeletionScheduledAt 已申请注销时为到期清理的时间 此前登录可撤销
+	DeletionScheduledAt int64 `json:"deletion_scheduled_at,omitempty"`
+}
+
+// AdminUserResponse 管理后台展示的用户 额外包含禁用状态
+type AdminUserResponse struct {
+	UserResponse
+	Disabled   bool  `json:"disabled"`
+	DisabledAt int64 `json:"disabled_at,omitempty"`
+}
+
+type UserListResponse struct {
+	Items      []*AdminUserResponse `json:"items"`
+	PrevCursor string               `json:"prev_cursor"`
+	NextCursor string               `json:"next_cursor"`
+	HasPrev    bool                 `json:"has_prev"`
+	HasNext    bool                 `json:"has_next"`
+}
+
+type UserDetailResponse struct {
+	User       *AdminUserResponse  `json:"user"`
+	Identities []*IdentityResponse `json:"identities"`
+	Sessions   []*SessionResponse  `json:"sessions"`
+	MFAEnabled bool                `json:"mfa_enabled"`
+}
+
+type ImpersonationResponse struct {
+	User *UserResponse `json:"user"`
+	// AccessToken 不附带refresh token 过期后需重新申请
+	AccessToken string `json:"access_token"`
+	ExpiresAt   int64  `json:"expires_at"`
 }
 
 type AuthResponse struct {
 	User         *UserResponse `json:"user"`
 	AccessToken  string        `json:"access_token"`
 	RefreshToken string        `json:"refresh_token"`
+	// MFARequired 为 true 时不返回令牌 需使用 MFAToken 调用 /v1/user/mfa/verify
+	MFARequired bool   `json:"mfa_required"`
+	MFAToken    string `json:"mfa_token,omitempty"`
 }
 
 type RefreshTokenResponse struct {
@@ -26,16 +210,81 @@ type RefreshTokenResponse struct {
 	RefreshToken string `json:"refresh_token"`
 }
 
-type GithubUser struct {
-	ID        int64  `json:"id"`
-	Login     string `json:"login"`
-	Name      string `json:"name"`
-	Email     string `json:"email"`
-	AvatarURL string `json:"avatar_url"`
+type IdentityResponse struct {
+	ID         int64  `json:"id"`
+	Provider   string `json:"provider"`
+	Email      string `json:"email,omitempty"`
+	CreatedAt  int64  `json:"created_at"`
+	LastUsedAt int64  `json:"last_used_at,omitempty"`
 }
 
-type GithubAccessTokenResponse struct {
-	AccessToken string `json:"access_token"`
-	TokenType   string `json:"token_type"`
-	Scope       string `json:"scope"`
+type SessionResponse struct {
+	ID            string `json:"id"`
+	IP            string `json:"ip"`
+	UserAgent     string `json:"user_agent"`
+	DeviceName    string `json:"device_name"`
+	Current       bool   `json:"current"`
+	CreatedAt     int64  `json:"created_at"`
+	LastRefreshAt int64  `json:"last_refresh_at"`
+}
+
+type APIKeyResponse struct {
+	ID         int64    `json:"id"`
+	Name       string   `json:"name"`
+	Prefix     string   `json:"prefix"`
+	Scopes     []string `json:"scopes"`
+	ExpiresAt  int64    `json:"expires_at,omitempty"`
+	LastUsedAt int64    `json:"last_used_at,omitempty"`
+	CreatedAt  int64    `json:"created_at"`
+}
+
+type CreateAPIKeyResponse struct {
+	APIKey *APIKeyResponse `json:"api_key"`
+	// Token 明文只返回这一次
+	Token string `json:"token"`
+}
+
+type MFAStatusResponse struct {
+	Enabled                bool  `json:"enabled"`
+	RecoveryCodesRemaining int64 `json:"recovery_codes_remaining"`
+}
+
+// UserDataExportResponse 个人数据导出 zip 格式时各字段分别保存为同名 JSON 文件
+type UserDataExportResponse struct {
+	User       *UserResponse       `json:"user"`
+	Identities []*IdentityResponse `json:"identities"`
+	Sessions   []*SessionResponse  `json:"sessions"`
+	Passkeys   []*PasskeyResponse  `json:"passkeys"`
+	APIKeys    []*APIKeyResponse   `json:"api_keys"`
+	MFA        *MFAStatusResponse  `json:"mfa"`
+	ExportedAt int64               `json:"exported_at"`
+}
+
+type MFAEnrollResponse struct {
+	OTPAuthURI string `json:"otpauth_uri"`
+	// Secret 供无法扫码时手动输入
+	Secret string `json:"secret"`
+	// QRCode base64编码的PNG图片
+	QRCode string `json:"qr_code"`
+}
+
+type RecoveryCodesResponse struct {
+	// RecoveryCodes 明文只返回这一次
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type PasskeyChallengeResponse struct {
+	CeremonyID string `json:"ceremony_id"`
+	// Options 原样传给 navigator.credentials.create 或 navigator.credentials.get
+	Options json.RawMessage `json:"options" swaggertype:"object"`
+}
+
+type PasskeyResponse struct {
+	ID         int64    `json:"id"`
+	Name       string   `json:"name"`
+	Transports []string `json:"transports"`
+	// Synced 凭证已在多个设备间同步
+	Synced     bool  `json:"synced"`
+	LastUsedAt int64 `json:"last_used_at,omitempty"`
+	CreatedAt  int64 `json:"created_at"`
 }
@@ -1,72 +1,188 @@
 package handler
 
 import (
+	"scaffold/internal/common/reqkit/bind"
 	"scaffold/internal/common/reskit/codes"
 	"scaffold/internal/common/reskit/response"
 	"scaffold/internal/common/server"
-	"scaffold/internal/common/utils"
-	"strconv"
-
-	"github.com/pkg/errors"
+	"strings"
 
 	"github.com/gin-gonic/gin"
-	"resty.dev/v3"
+	"github.com/gin-gonic/gin/binding"
+	"go.uber.org/zap"
 
 	"scaffold/internal/user/domain"
 )
 
 type HttpHandler struct {
-	userService  domain.UserService
-	clientID     string
-	clientSecret string
+	userService      domain.UserService
+	apiKeyService    domain.APIKeyService
+	userAdminService domain.UserAdminService
 }
 
-func NewHttpHandler(userService domain.UserService) *HttpHandler {
-	clientID := utils.GetEnv("GITHUB_CLIENT_ID")
-	clientSecret := utils.GetEnv("GITHUB_CLIENT_SECRET")
-
+func NewHttpHandler(
+	userService domain.UserService,
+	apiKeyService domain.APIKeyService,
+	userAdminService domain.UserAdminService,
+) *HttpHandler {
 	return &HttpHandler{
-		userService:  userService,
-		clientID:     clientID,
-		clientSecret: clientSecret,
+		userService:      userService,
+		apiKeyService:    apiKeyService,
+		userAdminService: userAdminService,
+	}
+}
+
+// OAuthAuthorize godoc
+// @Summary      获取第三方授权地址
+// @Description  生成第三方授权跳转地址与 state，state 短时有效且只能使用一次，回调后需原样提交。同时下发 HttpOnly 的 oauth_nonce Cookie，回调请求需携带该 Cookie
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        provider path string true "第三方登录提供商"
+// @Success      200 {object} response.successResponse{data=handler.OAuthAuthorizeResponse} "请求成功"
+// @Failure      400 {object} response.errorResponse "不支持的提供商"
+// @Failure      502 {object} response.errorResponse "第三方接口调用失败"
+// @Failure      429 {object} response.errorResponse "请求过于频繁"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/auth/{provider}/authorize [get]
+func (h *HttpHandler) OAuthAuthorize(ctx *gin.Context) {
+	authorization, err := h.userService.GetOAuthAuthorization(ctx.Param("provider"))
+	if err != nil {
+		response.Error(ctx, err)
+		return
 	}
+
+	setOAuthNonceCookie(ctx, authorization.Nonce)
+	response.Success(ctx, &OAuthAuthorizeResponse{
+		URL:   authorization.URL,
+		State: authorization.State,
+	})
 }
 
-// GithubAuth godoc
-// @Summary      GitHub 授权登录
-// @Description  使用 GitHub OAuth 登录，返回用户信息和令牌
+// OAuthAuth godoc
+// @Summary      第三方授权登录
+// @Description  使用第三方 OAuth 授权码与授权地址下发的 state 登录，需携带授权接口下发的 oauth_nonce Cookie，返回令牌，provider 可选 github、google、oidc。已开启两步验证时 mfa_required 为 true，需使用 mfa_token 调用 /v1/user/mfa/verify 换取令牌
 // @Tags         user
 // @Accept       json
 // @Produce      json
-// @Param        request body handler.GithubAuthRequest true "GitHub 授权码"
+// @Param        provider path string true "第三方登录提供商"
+// @Param        X-Device-Name header string false "设备名称，未传时根据User-Agent推断"
+// @Param        request body handler.OAuthAuthRequest true "授权码与state"
 // @Success      200 {object} response.successResponse{data=handler.AuthResponse} "请求成功"
 // @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      409 {object} response.errorResponse "该邮箱已注册但未验证"
+// @Failure      502 {object} response.errorResponse "第三方接口调用失败"
+// @Failure      429 {object} response.errorResponse "请求过于频繁"
 // @Failure      500 {object} response.errorResponse "服务器错误"
-// @Router       /v1/user/auth/github [post]
-func (h *HttpHandler) GithubAuth(ctx *gin.Context) {
-	req := new(GithubAuthRequest)
+// @Router       /v1/user/auth/{provider} [post]
+func (h *HttpHandler) OAuthAuth(ctx *gin.Context) {
+	req := new(OAuthAuthRequest)
 	if err := ctx.ShouldBindJSON(req); err != nil {
 		response.InvalidParams(ctx, err)
 		return
 	}
 
-	// 1. 获取 GitHub 用户信息
-	userInfo, err := h.getGithubUserInfo(req.Code)
+	nonce := takeOAuthNonceCookie(ctx)
+	result, err := h.userService.AuthenticateWithOAuth(ctx.Param("provider"), req.Code, req.State, nonce, clientInfoFromContext(ctx))
 	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainLoginResultToAuthResponse(result))
+}
+
+// Register godoc
+// @Summary      邮箱注册
+// @Description  使用邮箱和密码注册账号，返回令牌
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        X-Device-Name header string false "设备名称，未传时根据User-Agent推断"
+// @Param        request body handler.RegisterRequest true "注册信息"
+// @Success      200 {object} response.successResponse{data=handler.AuthResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      409 {object} response.errorResponse "邮箱已被使用"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/register [post]
+func (h *HttpHandler) Register(ctx *gin.Context) {
+	req := new(RegisterRequest)
+	if err := ctx.ShouldBindJSON(req); err != nil {
 		response.InvalidParams(ctx, err)
 		return
 	}
 
-	// 2. 调用业务逻辑
-	session, err := h.userService.AuthenticateWithOAuth("github", userInfo)
+	session, err := h.userService.Register(req.Email, req.Password, req.Nickname, clientInfoFromContext(ctx))
 	if err != nil {
 		response.Error(ctx, err)
 		return
 	}
-	// 3. 转换为响应格式
+
 	response.Success(ctx, domain2TokenToAuthResponse(session))
 }
 
+// Login godoc
+// @Summary      邮箱密码登录
+// @Description  使用邮箱和密码登录，返回令牌。已开启两步验证时 mfa_required 为 true，需使用 mfa_token 调用 /v1/user/mfa/verify 换取令牌。
+// @Description  账号或IP连续失败3次后需携带人机验证，错误响应 details 中 captcha_required 为 true；之后每次失败的等待时间翻倍，失败10次后账号锁定15分钟，等待与锁定时长见 details 中的 retry_after(秒)
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        X-Device-Name header string false "设备名称，未传时根据User-Agent推断"
+// @Param        captcha-verify-way header string false "验证方式，失败次数过多后必填"
+// @Param        captcha-verify-id header string false "验证码id，失败次数过多后必填"
+// @Param        captcha-verify-value header string false "验证值，失败次数过多后必填"
+// @Param        request body handler.LoginRequest true "登录信息"
+// @Success      200 {object} response.successResponse{data=handler.AuthResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      401 {object} response.errorResponse "邮箱或密码错误或人机验证失败"
+// @Failure      429 {object} response.errorResponse "登录尝试过于频繁或账号已锁定"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/login [post]
+func (h *HttpHandler) Login(ctx *gin.Context) {
+	req := new(LoginRequest)
+	// 请求体可能已被 LoginCaptchaRequired 读取 需从缓存中绑定
+	if err := ctx.ShouldBindBodyWith(req, binding.JSON); err != nil {
+		response.InvalidParams(ctx, err)
+		return
+	}
+
+	result, err := h.userService.Login(req.Email, req.Password, clientInfoFromContext(ctx))
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainLoginResultToAuthResponse(result))
+}
+
+// LoginCaptchaRequired 供人机验证中间件判断本次登录是否需要验证
+func (h *HttpHandler) LoginCaptchaRequired(ctx *gin.Context) bool {
+	req := new(LoginRequest)
+	if err := ctx.ShouldBindBodyWith(req, binding.JSON); err != nil {
+		// 参数错误交由 Login 响应
+		return false
+	}
+
+	required, err := h.userService.LoginCaptchaRequired(req.Email, ctx.ClientIP())
+	if err != nil {
+		zap.L().Error("查询登录失败次数失败", zap.Error(err))
+		return true
+	}
+	return required
+}
+
+// ThrottleByIP 按IP限制接口调用频率
+func (h *HttpHandler) ThrottleByIP(rule domain.IPThrottleRule) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if err := h.userService.ThrottleIP(rule, ctx.ClientIP()); err != nil {
+			response.Error(ctx, err)
+			return
+		}
+		ctx.Next()
+	}
+}
+
 func (h *HttpHandler) getRefreshToke(ctx *gin.Context) (string, error) {
 	refreshToken := ctx.GetHeader("X-Refresh-Token")
 	if refreshToken == "" {
@@ -75,9 +191,18 @@ func (h *HttpHandler) getRefreshToke(ctx *gin.Context) (string, error) {
 	return refreshToken, nil
 }
 
+// getBearerToken 读取可选的 Authorization 头部 格式不符时视为未携带
+func getBearerToken(ctx *gin.Context) string {
+	authHeader := ctx.GetHeader("Authorization")
+	if !strings.HasPrefix(authHeader, "Bearer ") {
+		return ""
+	}
+	return strings.TrimPrefix(authHeader, "Bearer ")
+}
+
 // RefreshToken godoc
 // @Summary      刷新令牌
-// @Description  使用刷新令牌获取新的访问令牌
+// @Description  使用刷新令牌获取新的访问令牌，刷新令牌每次使用后轮换，已轮换的令牌被重放时整个会话失效
 // @Tags         user
 // @Accept       json
 // @Produce      json
@@ -85,6 +210,7 @@ func (h *HttpHandler) getRefreshToke(ctx *gin.Context) (string, error) {
 // @Success      200 {object} response.successResponse{data=handler.RefreshTokenResponse} "请求成功"
 // @Failure      400 {object} response.errorResponse "参数错误"
 // @Failure      401 {object} response.errorResponse
+// @Failure      429 {object} response.errorResponse "请求过于频繁"
 // @Failure      500 {object} response.errorResponse "服务器错误"
 // @Router       /v1/user/refresh_token [post]
 func (h *HttpHandler) RefreshToken(ctx *gin.Context) {
@@ -94,7 +220,7 @@ func (h *HttpHandler) RefreshToken(ctx *gin.Context) {
 		return
 	}
 
-	session, err := h.userService.RefreshUserToken(refreshToken)
+	session, err := h.userService.RefreshUserToken(refreshToken, clientInfoFromContext(ctx))
 	if err != nil {
 		response.Error(ctx, err)
 		return
@@ -104,71 +230,57 @@ func (h *HttpHandler) RefreshToken(ctx *gin.Context) {
 	response.Success(ctx, res)
 }
 
-// GitHub API 调用逻辑 - 返回包装好的领域错误
-func (h *HttpHandler) getGithubUserInfo(code string) (*domain.OAuthUserInfo, error) {
-	accessToken, err := h.getGithubAccessToken(code)
+// Logout godoc
+// @Summary      退出登录
+// @Description  结束请求头中刷新令牌所属的会话，携带访问令牌时一并吊销，令牌不存在时同样返回成功
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        X-Refresh-Token header string true "refresh_token刷新令牌"
+// @Param        Authorization header string false "Bearer 访问令牌"
+// @Success      200 {object} response.successResponse "请求成功"
+// @Failure      400 {object} response.errorResponse "参数错误"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/logout [post]
+func (h *HttpHandler) Logout(ctx *gin.Context) {
+	refreshToken, err := h.getRefreshToke(ctx)
 	if err != nil {
-		return nil, errors.WithStack(codes.ErrGitHubAPIError.WithSlug("get_access_token 获取失败").WithCause(err))
+		response.Error(ctx, err)
+		return
 	}
 
-	userInfo, err := h.fetchGithubUserInfo(accessToken)
-	if err != nil {
-		return nil, errors.WithStack(codes.ErrGitHubAPIError.WithSlug("get_user_info 获取失败").WithCause(err))
+	if err := h.userService.Logout(refreshToken, getBearerToken(ctx)); err != nil {
+		response.Error(ctx, err)
+		return
 	}
 
-	return userInfo, nil
+	response.Success(ctx)
 }
 
-// todo 待优化
-func (h *HttpHandler) getGithubAccessToken(code string) (string, error) {
-	client := resty.New()
-	var result GithubAccessTokenResponse
-
-	_, err := client.R().
-		SetHeader("Accept", "application/json").
-		SetFormData(map[string]string{
-			"client_id":     h.clientID,
-			"client_secret": h.clientSecret,
-			"code":          code,
-		}).
-		SetResult(&result).
-		Post("https://github.com/login/oauth/access_token")
-
+// LogoutAll godoc
+// @Summary      退出所有设备
+// @Description  结束当前用户在所有设备上的会话
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} response.successResponse "请求成功"
+// @Failure      401 {object} response.errorResponse
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/logout/all [post]
+func (h *HttpHandler) LogoutAll(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
 	if err != nil {
-		return "", err
-	}
-
-	if result.AccessToken == "" {
-		return "", codes.ErrOAuthInvalidCode.WithDetail(map[string]any{
-			"reason": "empty_access_token",
-		})
+		response.Error(ctx, err)
+		return
 	}
 
-	return result.AccessToken, nil
-}
-
-func (h *HttpHandler) fetchGithubUserInfo(accessToken string) (*domain.OAuthUserInfo, error) {
-	client := resty.New()
-	var githubUser GithubUser
-
-	_, err := client.R().
-		SetHeader("Authorization", "Bearer "+accessToken).
-		SetHeader("Accept", "application/vnd.github+json").
-		SetResult(&githubUser).
-		Get("https://api.github.com/user")
-
-	if err != nil {
-		return nil, err // 这里的错误会在上层被包装
+	if err := h.userService.LogoutAll(userID); err != nil {
+		response.Error(ctx, err)
+		return
 	}
 
-	return &domain.OAuthUserInfo{
-		Provider: "github",
-		ID:       strconv.FormatInt(githubUser.ID, 10),
-		Login:    githubUser.Login,
-		Nickname: githubUser.Name,
-		Email:    githubUser.Email,
-		Avatar:   githubUser.AvatarURL,
-	}, nil
+	response.Success(ctx)
 }
 
 // ValidateAuth godoc
@@ -208,3 +320,308 @@ func (h *HttpHandler) GetProfile(ctx *gin.Context) {
 
 	response.Success(ctx, domainUserToResponse(user))
 }
+
+// VerifyEmail godoc
+// @Summary      验证邮箱
+// @Description  使用邮件中的一次性令牌完成邮箱验证
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        request body handler.VerifyEmailRequest true "验证令牌"
+// @Success      200 {object} response.successResponse "验证成功"
+// @Failure      400 {object} response.errorResponse "令牌无效或已过期"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/email/verify [post]
+func (h *HttpHandler) VerifyEmail(ctx *gin.Context) {
+	req := new(VerifyEmailRequest)
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		response.InvalidParams(ctx, err)
+		return
+	}
+
+	if err := h.userService.VerifyEmail(req.Token); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// ResendEmailVerification godoc
+// @Summary      重发验证邮件
+// @Description  向当前用户邮箱重新发送验证邮件，存在冷却时间
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} response.successResponse "发送成功"
+// @Failure      401 {object} response.errorResponse
+// @Failure      409 {object} response.errorResponse "邮箱已验证"
+// @Failure      429 {object} response.errorResponse "发送过于频繁"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/email/verify/resend [post]
+func (h *HttpHandler) ResendEmailVerification(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	if err := h.userService.SendEmailVerification(userID); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// ForgotPassword godoc
+// @Summary      忘记密码
+// @Description  向邮箱发送密码重置链接，无论邮箱是否注册均返回成功，需通过人机验证
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        captcha-verify-way header string true "验证方式"
+// @Param        captcha-verify-id header string true "验证码id"
+// @Param        captcha-verify-value header string true "验证值"
+// @Param        request body handler.ForgotPasswordRequest true "邮箱"
+// @Success      200 {object} response.successResponse "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      401 {object} response.errorResponse "人机验证失败"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/password/forgot [post]
+func (h *HttpHandler) ForgotPassword(ctx *gin.Context) {
+	req := new(ForgotPasswordRequest)
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		response.InvalidParams(ctx, err)
+		return
+	}
+
+	if err := h.userService.ForgotPassword(req.Email); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// ResetPassword godoc
+// @Summary      重置密码
+// @Description  使用邮件中的一次性令牌设置新密码，成功后所有refresh token失效；邮箱未验证时同时完成验证，并与无密码登录一样清除该账号原有的手机号、第三方身份、通行密钥、两步验证与API Key
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        request body handler.ResetPasswordRequest true "令牌与新密码"
+// @Success      200 {object} response.successResponse "重置成功"
+// @Failure      400 {object} response.errorResponse "令牌无效或已过期"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/password/reset [post]
+func (h *HttpHandler) ResetPassword(ctx *gin.Context) {
+	req := new(ResetPasswordRequest)
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		response.InvalidParams(ctx, err)
+		return
+	}
+
+	if err := h.userService.ResetPassword(req.Token, req.Password); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// ListIdentities godoc
+// @Summary      已绑定的第三方身份
+// @Description  获取当前用户已绑定的第三方登录身份列表
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} response.successResponse{data=[]handler.IdentityResponse} "获取成功"
+// @Failure      401 {object} response.errorResponse
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/identities [get]
+func (h *HttpHandler) ListIdentities(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	identities, err := h.userService.ListIdentities(userID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainIdentitiesToResponse(identities))
+}
+
+// LinkIdentityAuthorize godoc
+// @Summary      获取绑定第三方身份的授权地址
+// @Description  生成绑定用的授权跳转地址与 state，state 只能由当前用户用于绑定，不能用于登录。同时下发 HttpOnly 的 oauth_nonce Cookie
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        provider path string true "第三方登录提供商"
+// @Success      200 {object} response.successResponse{data=handler.OAuthAuthorizeResponse} "请求成功"
+// @Failure      400 {object} response.errorResponse "不支持的提供商"
+// @Failure      401 {object} response.errorResponse
+// @Failure      502 {object} response.errorResponse "第三方接口调用失败"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/identities/{provider}/authorize [get]
+func (h *HttpHandler) LinkIdentityAuthorize(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	authorization, err := h.userService.GetOAuthLinkAuthorization(userID, ctx.Param("provider"))
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	setOAuthNonceCookie(ctx, authorization.Nonce)
+	response.Success(ctx, &OAuthAuthorizeResponse{
+		URL:   authorization.URL,
+		State: authorization.State,
+	})
+}
+
+// LinkIdentity godoc
+// @Summary      绑定第三方身份
+// @Description  使用第三方 OAuth 授权码与绑定授权接口下发的 state 为当前用户绑定登录身份，需携带 oauth_nonce Cookie，provider 可选 github、google、oidc
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        provider path string true "第三方登录提供商"
+// @Param        request body handler.LinkIdentityRequest true "授权码与state"
+// @Success      200 {object} response.successResponse{data=handler.IdentityResponse} "绑定成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      401 {object} response.errorResponse
+// @Failure      409 {object} response.errorResponse "第三方账号已被绑定"
+// @Failure      502 {object} response.errorResponse "第三方接口调用失败"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/identities/{provider} [post]
+func (h *HttpHandler) LinkIdentity(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(LinkIdentityRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	nonce := takeOAuthNonceCookie(ctx)
+	identity, err := h.userService.LinkIdentity(userID, req.Provider, req.Code, req.State, nonce)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainIdentityToResponse(identity))
+}
+
+// UnlinkIdentity godoc
+// @Summary      解绑第三方身份
+// @Description  解绑当前用户的第三方登录身份，不允许移除最后一种登录方式
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "身份id"
+// @Success      200 {object} response.successResponse "解绑成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      401 {object} response.errorResponse
+// @Failure      403 {object} response.errorResponse "不能移除最后一种登录方式"
+// @Failure      404 {object} response.errorResponse "身份不存在"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/identities/{id} [delete]
+func (h *HttpHandler) UnlinkIdentity(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(UnlinkIdentityRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.userService.UnlinkIdentity(userID, req.ID); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// ListSessions godoc
+// @Summary      登录会话列表
+// @Description  获取当前用户在各设备上的登录会话，current 标记当前请求所属会话
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} response.successResponse{data=[]handler.SessionResponse} "获取成功"
+// @Failure      401 {object} response.errorResponse
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/sessions [get]
+func (h *HttpHandler) ListSessions(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	sessions, err := h.userService.ListSessions(userID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainSessionsToResponse(sessions, server.GetSessionID(ctx)))
+}
+
+// RevokeSession godoc
+// @Summary      移除登录会话
+// @Description  结束指定会话，该会话的刷新令牌立即失效
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "会话id"
+// @Success      200 {object} response.successResponse "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      401 {object} response.errorResponse
+// @Failure      404 {object} response.errorResponse "会话不存在"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/sessions/{id} [delete]
+func (h *HttpHandler) RevokeSession(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(RevokeSessionRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.userService.RevokeSession(userID, req.ID); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"scaffold/internal/common/reqkit/bind"
+	"scaffold/internal/common/reskit/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequestMagicLink godoc
+// @Summary      发送无密码登录邮件
+// @Description  向邮箱发送登录链接与6位验证码，10分钟内有效且只能使用一次，邮箱未注册时首次登录自动创建账号；邮箱已注册但未验证时，登录即完成验证，同时清除该账号原有的密码、手机号、第三方身份、通行密钥、两步验证与API Key并退出全部设备；已关联同一邮箱的第三方身份的账号只完成验证。同一邮箱每分钟只能发送一次，需通过人机验证
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        captcha-verify-way header string true "验证方式"
+// @Param        captcha-verify-id header string true "验证码id"
+// @Param        captcha-verify-value header string true "验证值"
+// @Param        request body handler.MagicLinkRequest true "邮箱"
+// @Success      200 {object} response.successResponse "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      401 {object} response.errorResponse "人机验证失败"
+// @Failure      429 {object} response.errorResponse "发送过于频繁"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/magic-link [post]
+func (h *HttpHandler) RequestMagicLink(ctx *gin.Context) {
+	req := new(MagicLinkRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.userService.RequestMagicLink(req.Email, clientInfoFromContext(ctx)); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// VerifyMagicLink godoc
+// @Summary      登录链接登录
+// @Description  使用邮件中登录链接附带的 token 登录，返回令牌。已开启两步验证时 mfa_required 为 true，需使用 mfa_token 调用 /v1/user/mfa/verify 换取令牌
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        X-Device-Name header string false "设备名称，未传时根据User-Agent推断"
+// @Param        request body handler.VerifyMagicLinkRequest true "请求参数"
+// @Success      200 {object} response.successResponse{data=handler.AuthResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误或链接已失效"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/magic-link/verify [post]
+func (h *HttpHandler) VerifyMagicLink(ctx *gin.Context) {
+	req := new(VerifyMagicLinkRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	result, err := h.userService.VerifyMagicLink(req.Token, clientInfoFromContext(ctx))
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainLoginResultToAuthResponse(result))
+}
+
+// VerifyEmailOTP godoc
+// @Summary      邮箱验证码登录
+// @Description  使用邮件中的6位验证码登录，返回令牌，连续错误5次后需重新发送。已开启两步验证时 mfa_required 为 true，需使用 mfa_token 调用 /v1/user/mfa/verify 换取令牌
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        X-Device-Name header string false "设备名称，未传时根据User-Agent推断"
+// @Param        request body handler.VerifyEmailOTPRequest true "请求参数"
+// @Success      200 {object} response.successResponse{data=handler.AuthResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误或验证码错误"
+// @Failure      429 {object} response.errorResponse "错误次数过多"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/magic-link/otp [post]
+func (h *HttpHandler) VerifyEmailOTP(ctx *gin.Context) {
+	req := new(VerifyEmailOTPRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	result, err := h.userService.VerifyEmailOTP(req.Email, req.Code, clientInfoFromContext(ctx))
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainLoginResultToAuthResponse(result))
+}
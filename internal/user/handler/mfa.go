@@ -0,0 +1,209 @@
+package handler
+
+import (
+	"encoding/base64"
+	"scaffold/internal/common/reqkit/bind"
+	"scaffold/internal/common/reskit/response"
+	"scaffold/internal/common/server"
+
+	"github.com/gin-gonic/gin"
+)
+
+// VerifyMFA godoc
+// @Summary      完成两步验证登录
+// @Description  使用登录接口返回的 mfa_token 与验证器中的6位验证码或恢复码换取令牌，mfa_token 5分钟内有效，连续错误5次后需重新登录；同一账号15分钟内累计错误10次后暂停两步验证，响应中返回重试等待时间
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        X-Device-Name header string false "设备名称，未传时根据User-Agent推断"
+// @Param        request body handler.VerifyMFARequest true "请求参数"
+// @Success      200 {object} response.successResponse{data=handler.AuthResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误或验证码错误"
+// @Failure      401 {object} response.errorResponse "mfa_token已失效"
+// @Failure      429 {object} response.errorResponse "错误次数过多"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/mfa/verify [post]
+func (h *HttpHandler) VerifyMFA(ctx *gin.Context) {
+	req := new(VerifyMFARequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	session, err := h.userService.VerifyMFA(req.MFAToken, req.Code, clientInfoFromContext(ctx))
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domain2TokenToAuthResponse(session))
+}
+
+// GetMFAStatus godoc
+// @Summary      两步验证状态
+// @Description  查询当前用户是否开启两步验证及剩余可用的恢复码数量
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} response.successResponse{data=handler.MFAStatusResponse} "请求成功"
+// @Failure      401 {object} response.errorResponse
+// @Failure      403 {object} response.errorResponse "不支持使用API Key访问"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/mfa [get]
+func (h *HttpHandler) GetMFAStatus(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	status, err := h.userService.GetMFAStatus(userID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainMFAStatusToResponse(status))
+}
+
+// EnrollTOTP godoc
+// @Summary      获取TOTP绑定信息
+// @Description  生成新的TOTP密钥，返回 otpauth URI 与二维码（base64编码的PNG），使用验证器扫码后调用确认接口开启两步验证，确认前重复调用会重新生成密钥
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} response.successResponse{data=handler.MFAEnrollResponse} "请求成功"
+// @Failure      401 {object} response.errorResponse
+// @Failure      403 {object} response.errorResponse "不支持使用API Key访问"
+// @Failure      409 {object} response.errorResponse "已开启两步验证"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/mfa/totp/enroll [post]
+func (h *HttpHandler) EnrollTOTP(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	enrollment, err := h.userService.EnrollTOTP(userID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, &MFAEnrollResponse{
+		OTPAuthURI: enrollment.URI,
+		Secret:     enrollment.Secret,
+		QRCode:     base64.StdEncoding.EncodeToString(enrollment.QRCodePNG),
+	})
+}
+
+// ConfirmTOTP godoc
+// @Summary      确认开启两步验证
+// @Description  提交验证器中的6位验证码开启两步验证，返回一次性恢复码，明文只在本次响应中返回
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body handler.MFACodeRequest true "请求参数"
+// @Success      200 {object} response.successResponse{data=handler.RecoveryCodesResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误或验证码错误"
+// @Failure      401 {object} response.errorResponse
+// @Failure      403 {object} response.errorResponse "不支持使用API Key访问"
+// @Failure      409 {object} response.errorResponse "已开启两步验证"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/mfa/totp/confirm [post]
+func (h *HttpHandler) ConfirmTOTP(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(MFACodeRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	recoveryCodes, err := h.userService.ConfirmTOTP(userID, req.Code)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, &RecoveryCodesResponse{RecoveryCodes: recoveryCodes})
+}
+
+// DisableMFA godoc
+// @Summary      关闭两步验证
+// @Description  提交验证码或恢复码关闭两步验证，同时删除全部恢复码
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body handler.MFACodeRequest true "请求参数"
+// @Success      200 {object} response.successResponse "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误或验证码错误"
+// @Failure      401 {object} response.errorResponse
+// @Failure      403 {object} response.errorResponse "不支持使用API Key访问"
+// @Failure      409 {object} response.errorResponse "未开启两步验证"
+// @Failure      429 {object} response.errorResponse "错误次数过多"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/mfa/disable [post]
+func (h *HttpHandler) DisableMFA(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(MFACodeRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.userService.DisableMFA(userID, req.Code); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// RegenerateRecoveryCodes godoc
+// @Summary      重新生成恢复码
+// @Description  提交验证码或恢复码后重新生成恢复码，旧恢复码全部作废，明文只在本次响应中返回
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body handler.MFACodeRequest true "请求参数"
+// @Success      200 {object} response.successResponse{data=handler.RecoveryCodesResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误或验证码错误"
+// @Failure      401 {object} response.errorResponse
+// @Failure      403 {object} response.errorResponse "不支持使用API Key访问"
+// @Failure      409 {object} response.errorResponse "未开启两步验证"
+// @Failure      429 {object} response.errorResponse "错误次数过多"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/mfa/recovery-codes [post]
+func (h *HttpHandler) RegenerateRecoveryCodes(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(MFACodeRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	recoveryCodes, err := h.userService.RegenerateRecoveryCodes(userID, req.Code)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, &RecoveryCodesResponse{RecoveryCodes: recoveryCodes})
+}
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"scaffold/internal/common/server"
+	"scaffold/internal/user/domain"
+)
+
+const (
+	oauthNonceCookie = "oauth_nonce"
+	// 登录与绑定的回调接口都在该路径下
+	oauthNonceCookiePath = "/api/v1/user"
+)
+
+// setOAuthNonceCookie 将 state 对应的 nonce 写入发起授权的浏览器 前端无法读取
+func setOAuthNonceCookie(ctx *gin.Context, nonce string) {
+	ctx.SetSameSite(http.SameSiteLaxMode)
+	ctx.SetCookie(oauthNonceCookie, nonce, int(domain.OAuthStateExpire.Seconds()),
+		oauthNonceCookiePath, "", server.IsHTTPS(ctx), true)
+}
+
+// takeOAuthNonceCookie 读取后立即清除 与 state 一样只能使用一次
+func takeOAuthNonceCookie(ctx *gin.Context) string {
+	nonce, err := ctx.Cookie(oauthNonceCookie)
+	if err != nil {
+		return ""
+	}
+
+	ctx.SetSameSite(http.SameSiteLaxMode)
+	ctx.SetCookie(oauthNonceCookie, "", -1, oauthNonceCookiePath, "", server.IsHTTPS(ctx), true)
+	return nonce
+}
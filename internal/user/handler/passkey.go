@@ -0,0 +1,190 @@
+package handler
+
+import (
+	"scaffold/internal/common/reqkit/bind"
+	"scaffold/internal/common/reskit/response"
+	"scaffold/internal/common/server"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListPasskeys godoc
+// @Summary      通行密钥列表
+// @Description  列出当前用户已注册的通行密钥
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} response.successResponse{data=[]handler.PasskeyResponse} "请求成功"
+// @Failure      401 {object} response.errorResponse
+// @Failure      403 {object} response.errorResponse "不支持使用API Key访问"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/passkeys [get]
+func (h *HttpHandler) ListPasskeys(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	passkeys, err := h.userService.ListPasskeys(userID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainPasskeysToResponse(passkeys))
+}
+
+// BeginPasskeyRegistration godoc
+// @Summary      发起通行密钥注册
+// @Description  返回 ceremony_id 与注册选项，options 原样传给 navigator.credentials.create，完成后连同 ceremony_id 提交到注册完成接口，5分钟内有效
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} response.successResponse{data=handler.PasskeyChallengeResponse} "请求成功"
+// @Failure      400 {object} response.errorResponse "通行密钥数量已达上限"
+// @Failure      401 {object} response.errorResponse
+// @Failure      403 {object} response.errorResponse "不支持使用API Key访问"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/passkeys/register/begin [post]
+func (h *HttpHandler) BeginPasskeyRegistration(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	challenge, err := h.userService.BeginPasskeyRegistration(userID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, &PasskeyChallengeResponse{
+		CeremonyID: challenge.CeremonyID,
+		Options:    challenge.Options,
+	})
+}
+
+// FinishPasskeyRegistration godoc
+// @Summary      完成通行密钥注册
+// @Description  提交认证器返回的凭证完成注册，每个 ceremony_id 只能使用一次
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body handler.FinishPasskeyRegistrationRequest true "请求参数"
+// @Success      200 {object} response.successResponse{data=handler.PasskeyResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误或注册已过期"
+// @Failure      401 {object} response.errorResponse "凭证校验失败"
+// @Failure      403 {object} response.errorResponse "不支持使用API Key访问"
+// @Failure      409 {object} response.errorResponse "该通行密钥已注册"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/passkeys/register/finish [post]
+func (h *HttpHandler) FinishPasskeyRegistration(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(FinishPasskeyRegistrationRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	passkey, err := h.userService.FinishPasskeyRegistration(userID, req.CeremonyID, req.Name, req.Credential)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainPasskeyToResponse(passkey))
+}
+
+// DeletePasskey godoc
+// @Summary      删除通行密钥
+// @Description  删除指定的通行密钥，不能删除最后一种登录方式
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "通行密钥ID"
+// @Success      200 {object} response.successResponse "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      401 {object} response.errorResponse
+// @Failure      403 {object} response.errorResponse "不能移除最后一种登录方式"
+// @Failure      404 {object} response.errorResponse "通行密钥不存在"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/passkeys/{id} [delete]
+func (h *HttpHandler) DeletePasskey(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(DeletePasskeyRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.userService.DeletePasskey(userID, req.ID); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// BeginPasskeyLogin godoc
+// @Summary      发起通行密钥登录
+// @Description  返回 ceremony_id 与登录选项，options 原样传给 navigator.credentials.get，由认证器选择账号，5分钟内有效
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} response.successResponse{data=handler.PasskeyChallengeResponse} "请求成功"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/passkeys/login/begin [post]
+func (h *HttpHandler) BeginPasskeyLogin(ctx *gin.Context) {
+	challenge, err := h.userService.BeginPasskeyLogin()
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, &PasskeyChallengeResponse{
+		CeremonyID: challenge.CeremonyID,
+		Options:    challenge.Options,
+	})
+}
+
+// FinishPasskeyLogin godoc
+// @Summary      完成通行密钥登录
+// @Description  提交认证器返回的断言，校验通过后返回令牌，每个 ceremony_id 只能使用一次
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        X-Device-Name header string false "设备名称，未传时根据User-Agent推断"
+// @Param        request body handler.FinishPasskeyLoginRequest true "请求参数"
+// @Success      200 {object} response.successResponse{data=handler.AuthResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误或登录已过期"
+// @Failure      401 {object} response.errorResponse "断言校验失败"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/passkeys/login/finish [post]
+func (h *HttpHandler) FinishPasskeyLogin(ctx *gin.Context) {
+	req := new(FinishPasskeyLoginRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	session, err := h.userService.FinishPasskeyLogin(req.CeremonyID, req.Credential, clientInfoFromContext(ctx))
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domain2TokenToAuthResponse(session))
+}
@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"scaffold/internal/common/reqkit/bind"
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/reskit/response"
+	"scaffold/internal/common/server"
+	"scaffold/internal/user/domain"
+)
+
+// avatarFormField 上传头像时文件所在的表单字段
+const avatarFormField = "file"
+
+// UpdateProfile godoc
+// @Summary      修改个人资料
+// @Description  只更新请求中携带的字段
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body handler.UpdateProfileRequest true "请求参数"
+// @Success      200 {object} response.successResponse{data=handler.UserResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      401 {object} response.errorResponse
+// @Failure      403 {object} response.errorResponse "不支持使用API Key访问"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/profile [patch]
+func (h *HttpHandler) UpdateProfile(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(UpdateProfileRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	user, err := h.userService.UpdateProfile(userID, &domain.ProfileUpdate{
+		Nickname: req.Nickname,
+	})
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainUserToResponse(user))
+}
+
+// UploadAvatar godoc
+// @Summary      上传头像
+// @Description  multipart/form-data 上传，文件字段为 file，支持PNG、JPEG、GIF、WebP，大小不超过2MB，类型按文件内容识别
+// @Tags         user
+// @Accept       multipart/form-data
+// @Produce      json
+// @Security     BearerAuth
+// @Param        file formData file true "头像文件"
+// @Success      200 {object} response.successResponse{data=handler.UserResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误或文件格式不支持"
+// @Failure      401 {object} response.errorResponse
+// @Failure      403 {object} response.errorResponse "不支持使用API Key访问"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/avatar [post]
+func (h *HttpHandler) UploadAvatar(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	// 预留表单其他部分的空间 超出时不再继续读取请求体
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, domain.AvatarMaxSize+1<<20)
+
+	fileHeader, err := ctx.FormFile(avatarFormField)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			response.Error(ctx, codes.ErrAvatarTooLarge)
+			return
+		}
+		response.InvalidParams(ctx, err)
+		return
+	}
+	if fileHeader.Size > domain.AvatarMaxSize {
+		response.Error(ctx, codes.ErrAvatarTooLarge)
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		response.Error(ctx, errors.WithStack(err))
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, domain.AvatarMaxSize+1))
+	if err != nil {
+		response.Error(ctx, errors.WithStack(err))
+		return
+	}
+
+	user, err := h.userService.UploadAvatar(userID, data)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainUserToResponse(user))
+}
+
+// RequestEmailChange godoc
+// @Summary      更换邮箱
+// @Description  向新邮箱发送确认链接，确认后才会更换，链接1小时内有效。设置过密码的用户需提供当前密码，未绑定邮箱的用户可用于绑定邮箱，每分钟只能发送一次
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body handler.ChangeEmailRequest true "请求参数"
+// @Success      200 {object} response.successResponse "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误或当前密码错误"
+// @Failure      401 {object} response.errorResponse
+// @Failure      403 {object} response.errorResponse "不支持使用API Key访问"
+// @Failure      409 {object} response.errorResponse "邮箱已被使用"
+// @Failure      429 {object} response.errorResponse "发送过于频繁"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/email/change [post]
+func (h *HttpHandler) RequestEmailChange(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(ChangeEmailRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.userService.RequestEmailChange(userID, req.Email, req.Password); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// ConfirmEmailChange godoc
+// @Summary      确认更换邮箱
+// @Description  使用新邮箱收到的一次性令牌完成更换，新邮箱同时视为已验证
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        request body handler.ConfirmEmailChangeRequest true "请求参数"
+// @Success      200 {object} response.successResponse "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误或链接已失效"
+// @Failure      409 {object} response.errorResponse "邮箱已被使用"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/email/change/confirm [post]
+func (h *HttpHandler) ConfirmEmailChange(ctx *gin.Context) {
+	req := new(ConfirmEmailChangeRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.userService.ConfirmEmailChange(req.Token); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"scaffold/internal/common/reqkit/bind"
+	"scaffold/internal/common/reskit/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequestSmsCode godoc
+// @Summary      发送短信验证码
+// @Description  向手机号发送6位登录验证码，5分钟内有效且只能使用一次，手机号未注册时首次登录自动创建账号。同一手机号每分钟只能发送一次且每天最多10次，需通过人机验证
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        captcha-verify-way header string true "验证方式"
+// @Param        captcha-verify-id header string true "验证码id"
+// @Param        captcha-verify-value header string true "验证值"
+// @Param        request body handler.SmsCodeRequest true "手机号"
+// @Success      200 {object} response.successResponse "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      401 {object} response.errorResponse "人机验证失败"
+// @Failure      429 {object} response.errorResponse "发送过于频繁"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/sms/send [post]
+func (h *HttpHandler) RequestSmsCode(ctx *gin.Context) {
+	req := new(SmsCodeRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.userService.RequestSmsCode(req.Phone, clientInfoFromContext(ctx)); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// VerifySmsCode godoc
+// @Summary      短信验证码登录
+// @Description  使用短信中的6位验证码登录，返回令牌，连续错误5次后需重新发送。已开启两步验证时 mfa_required 为 true，需使用 mfa_token 调用 /v1/user/mfa/verify 换取令牌
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        X-Device-Name header string false "设备名称，未传时根据User-Agent推断"
+// @Param        request body handler.VerifySmsCodeRequest true "请求参数"
+// @Success      200 {object} response.successResponse{data=handler.AuthResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误或验证码错误"
+// @Failure      429 {object} response.errorResponse "错误次数过多"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/sms/verify [post]
+func (h *HttpHandler) VerifySmsCode(ctx *gin.Context) {
+	req := new(VerifySmsCodeRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	result, err := h.userService.VerifySmsCode(req.Phone, req.Code, clientInfoFromContext(ctx))
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainLoginResultToAuthResponse(result))
+}
@@ -0,0 +1,36 @@
+package user
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+
+	"scaffold/internal/user/domain"
+)
+
+// startAccountPurgeJob 定期清理注销宽限期已过的账号 返回的函数用于停止任务
+func startAccountPurgeJob(userService domain.UserService) func() {
+	done := make(chan struct{})
+
+	go func() {
+		ticker := time.NewTicker(domain.AccountPurgeInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				purged, err := userService.PurgeDueAccounts()
+				if err != nil {
+					zap.L().Error("清理注销账号失败", zap.Error(err))
+					continue
+				}
+				if purged > 0 {
+					zap.L().Info("已清理注销账号", zap.Int("count", purged))
+				}
+			}
+		}
+	}()
+
+	return func() { close(done) }
+}
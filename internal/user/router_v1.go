@@ -3,26 +3,120 @@ package user
 import (
 	"github.com/gin-gonic/gin"
 	"scaffold/internal/common/middleware/auth"
+	"scaffold/internal/common/middleware/verify"
+	"scaffold/internal/user/domain"
 	"scaffold/internal/user/handler"
 )
 
-func RegisterV1(r *gin.RouterGroup, handler *handler.HttpHandler) func() {
+func RegisterV1(r *gin.RouterGroup, handler *handler.HttpHandler, userService domain.UserService) func() {
 	userGroup := r.Group("/v1/user")
 
 	{
-		// 登录相关路由
-		userGroup.POST("/auth/github", handler.GithubAuth)
+		// 登录相关路由 密码登录失败次数过多后需通过人机验证
+		userGroup.POST("/register", handler.Register)
+		userGroup.POST("/login", verify.VerifyWhen(handler.LoginCaptchaRequired), handler.Login)
+		userGroup.GET("/auth/:provider/authorize", handler.ThrottleByIP(domain.ThrottleOAuth), handler.OAuthAuthorize)
+		userGroup.POST("/auth/:provider", handler.ThrottleByIP(domain.ThrottleOAuth), handler.OAuthAuth)
 
 		// 令牌管理
-		userGroup.POST("/refresh_token", handler.RefreshToken)
+		userGroup.POST("/refresh_token", handler.ThrottleByIP(domain.ThrottleRefreshToken), handler.RefreshToken)
+		userGroup.POST("/logout", handler.Logout)
 
-		// 需要token的路由
+		// 邮箱验证
+		userGroup.POST("/email/verify", handler.VerifyEmail)
+		userGroup.POST("/email/change/confirm", handler.ConfirmEmailChange)
+
+		// 找回密码
+		userGroup.POST("/password/forgot", verify.Verify(), handler.ForgotPassword)
+		userGroup.POST("/password/reset", handler.ResetPassword)
+
+		// 无密码登录 发送邮件需通过人机验证
+		userGroup.POST("/magic-link", verify.Verify(), handler.RequestMagicLink)
+		userGroup.POST("/magic-link/verify", handler.VerifyMagicLink)
+		userGroup.POST("/magic-link/otp", handler.VerifyEmailOTP)
+		userGroup.POST("/sms/send", verify.Verify(), handler.RequestSmsCode)
+		userGroup.POST("/sms/verify", handler.VerifySmsCode)
+
+		// 两步验证登录 使用登录接口返回的mfa_token换取令牌
+		userGroup.POST("/mfa/verify", handler.VerifyMFA)
+
+		// 通行密钥登录
+		userGroup.POST("/passkeys/login/begin", handler.BeginPasskeyLogin)
+		userGroup.POST("/passkeys/login/finish", handler.FinishPasskeyLogin)
+
+		// 需要token的路由 同时接受API Key
 		protected := userGroup.Group("")
 		protected.Use(auth.JWTValidate())
 		{
 			protected.POST("/auth", handler.ValidateAuth)
 			protected.GET("/profile", handler.GetProfile)
+			protected.POST("/email/verify/resend", handler.ResendEmailVerification)
+		}
+
+		// 管理登录凭证的路由 只允许登录令牌访问 防止API Key泄露后被用于扩大权限
+		// 管理员模拟登录的令牌同样不能访问
+		account := userGroup.Group("")
+		account.Use(auth.JWTValidate(auth.RejectAPIKey(), auth.RejectImpersonation()))
+		{
+			account.POST("/logout/all", handler.LogoutAll)
+
+			// 个人资料
+			account.PATCH("/profile", handler.UpdateProfile)
+			account.POST("/avatar", handler.UploadAvatar)
+			account.POST("/email/change", handler.RequestEmailChange)
+
+			// 注销账号与个人数据导出
+			account.POST("/account/delete", handler.DeleteAccount)
+			account.POST("/account/delete/cancel", handler.CancelAccountDeletion)
+			account.GET("/export", handler.ExportUserData)
+
+			// 会话管理
+			account.GET("/sessions", handler.ListSessions)
+			account.DELETE("/sessions/:id", handler.RevokeSession)
+
+			// 第三方身份绑定
+			account.GET("/identities", handler.ListIdentities)
+			account.GET("/identities/:provider/authorize", handler.LinkIdentityAuthorize)
+			account.POST("/identities/:provider", handler.LinkIdentity)
+			account.DELETE("/identities/:id", handler.UnlinkIdentity)
+
+			// API Key
+			account.GET("/api-keys", handler.ListAPIKeys)
+			account.POST("/api-keys", handler.CreateAPIKey)
+			account.DELETE("/api-keys/:id", handler.RevokeAPIKey)
+
+			// 两步验证
+			account.GET("/mfa", handler.GetMFAStatus)
+			account.POST("/mfa/totp/enroll", handler.EnrollTOTP)
+			account.POST("/mfa/totp/confirm", handler.ConfirmTOTP)
+			account.POST("/mfa/disable", handler.DisableMFA)
+			account.POST("/mfa/recovery-codes", handler.RegenerateRecoveryCodes)
+
+			// 通行密钥
+			account.GET("/passkeys", handler.ListPasskeys)
+			account.POST("/passkeys/register/begin", handler.BeginPasskeyRegistration)
+			account.POST("/passkeys/register/finish", handler.FinishPasskeyRegistration)
+			account.DELETE("/passkeys/:id", handler.DeletePasskey)
+		}
+
+		// 管理后台 查看与管理分别需要 user:read 与 user:manage 权限 模拟登录的令牌不能访问
+		admin := userGroup.Group("/admin/users")
+		admin.Use(auth.JWTValidate(auth.RejectImpersonation()))
+		{
+			admin.GET("", auth.Require(domain.PermUserRead), handler.AdminListUsers)
+			admin.GET("/:id", auth.Require(domain.PermUserRead), handler.AdminGetUser)
+			admin.POST("/:id/disable", auth.Require(domain.PermUserManage), handler.AdminDisableUser)
+			admin.POST("/:id/enable", auth.Require(domain.PermUserManage), handler.AdminEnableUser)
+			admin.POST("/:id/logout", auth.Require(domain.PermUserManage), handler.AdminForceLogout)
+		}
+
+		// 模拟登录 只允许管理员本人的登录令牌发起
+		impersonate := userGroup.Group("/admin/users")
+		impersonate.Use(auth.JWTValidate(auth.RejectAPIKey(), auth.RejectImpersonation()))
+		{
+			impersonate.POST("/:id/impersonate", auth.Require(domain.PermUserImpersonate), handler.AdminImpersonate)
 		}
 	}
-	return nil
+
+	return startAccountPurgeJob(userService)
 }
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/utils"
+	"scaffold/internal/user/domain"
+)
+
+// RequestAccountDeletion 宽限期内账号照常可用 用户登录后可撤销
+// 设置过密码的用户需验证当前密码 防止会话被盗用后账号被注销
+func (s *userService) RequestAccountDeletion(userID int64, password string) (*domain.User, error) {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.IsDeletionScheduled() {
+		return nil, codes.ErrAccountDeletionScheduled
+	}
+
+	if user.HasPassword() && !utils.ComparePassword(user.PasswordHash, password) {
+		return nil, codes.ErrPasswordIncorrect
+	}
+
+	at := time.Now().Add(domain.AccountDeletionGracePeriod)
+	if err := s.userRepo.ScheduleDeletion(userID, at); err != nil {
+		return nil, err
+	}
+
+	user.DeletionScheduledAt = at
+	return user, nil
+}
+
+func (s *userService) CancelAccountDeletion(userID int64) error {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return err
+	}
+
+	if !user.IsDeletionScheduled() {
+		return codes.ErrAccountDeletionNotScheduled
+	}
+
+	return s.userRepo.CancelDeletion(userID)
+}
+
+// PurgeDueAccounts 逐个清理 单个账号失败只记录日志 下一轮重试
+func (s *userService) PurgeDueAccounts() (int, error) {
+	users, err := s.userRepo.ListDueForDeletion(time.Now(), domain.AccountPurgeBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, user := range users {
+		if err := s.userRepo.Anonymize(user.ID); err != nil {
+			// 多实例同时清理时可能已被其他实例处理
+			if !errors.Is(err, codes.ErrUserNotFound) {
+				zap.L().Error("清理注销账号失败", zap.Int64("user_id", user.ID), zap.Error(err))
+			}
+			continue
+		}
+		purged++
+
+		if err := s.tokenService.RemoveUserSessions(user.ID); err != nil {
+			zap.L().Error("清理注销账号会话失败", zap.Int64("user_id", user.ID), zap.Error(err))
+		}
+
+		if user.Avatar != "" {
+			if err := s.avatarStorage.RemoveAvatar(user.Avatar); err != nil {
+				zap.L().Error("删除注销账号头像失败", zap.Int64("user_id", user.ID), zap.Error(err))
+			}
+		}
+	}
+	return purged, nil
+}
+
+// ExportUserData 汇总用户模块保存的个人数据 密码哈希 TOTP密钥等凭证不会导出
+func (s *userService) ExportUserData(userID int64) (*domain.UserDataExport, error) {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	identities, err := s.identityRepo.ListByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.tokenService.ListSessions(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	passkeys, err := s.passkeyRepo.ListByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys, err := s.apiKeyRepo.ListByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	mfa, err := s.GetMFAStatus(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.UserDataExport{
+		User:       user,
+		Identities: identities,
+		Sessions:   sessions,
+		Passkeys:   passkeys,
+		APIKeys:    apiKeys,
+		MFA:        mfa,
+		ExportedAt: time.Now(),
+	}, nil
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/user/domain"
+)
+
+type userAdminService struct {
+	userRepo          domain.UserRepository
+	identityRepo      domain.UserIdentityRepository
+	mfaRepo           domain.UserMFARepository
+	tokenService      domain.TokenService
+	auditLogRepo      domain.AuditLogRepository
+	permissionChecker domain.PermissionChecker
+}
+
+func NewUserAdminService(
+	userRepo domain.UserRepository,
+	identityRepo domain.UserIdentityRepository,
+	mfaRepo domain.UserMFARepository,
+	tokenService domain.TokenService,
+	auditLogRepo domain.AuditLogRepository,
+	permissionChecker domain.PermissionChecker,
+) domain.UserAdminService {
+	return &userAdminService{
+		userRepo:          userRepo,
+		identityRepo:      identityRepo,
+		mfaRepo:           mfaRepo,
+		tokenService:      tokenService,
+		auditLogRepo:      auditLogRepo,
+		permissionChecker: permissionChecker,
+	}
+}
+
+func (s *userAdminService) ListUsers(query *domain.UserQuery) (*domain.UserList, error) {
+	query.Email = normalizeEmail(query.Email)
+	return s.userRepo.List(query)
+}
+
+func (s *userAdminService) GetUserDetail(id int64) (*domain.UserDetail, error) {
+	user, err := s.userRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	identities, err := s.identityRepo.ListByUserID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.tokenService.ListSessions(id)
+	if err != nil {
+		return nil, err
+	}
+
+	mfaEnabled := false
+	mfa, err := s.mfaRepo.FindByUserID(id)
+	if err == nil {
+		mfaEnabled = mfa.IsEnabled()
+	} else if !errors.Is(err, codes.ErrMFANotEnrolled) {
+		return nil, err
+	}
+
+	return &domain.UserDetail{
+		User:       user,
+		Identities: identities,
+		Sessions:   sessions,
+		MFAEnabled: mfaEnabled,
+	}, nil
+}
+
+// DisableUser 先写库再结束会话 结束会话后签发的令牌都会经过禁用检查
+func (s *userAdminService) DisableUser(operatorID, id int64) error {
+	if operatorID == id {
+		return codes.ErrCannotDisableSelf
+	}
+
+	if err := s.userRepo.Disable(id, time.Now()); err != nil {
+		return err
+	}
+	return s.tokenService.RemoveUserSessions(id)
+}
+
+func (s *userAdminService) EnableUser(id int64) error {
+	return s.userRepo.Enable(id)
+}
+
+func (s *userAdminService) ForceLogout(id int64) error {
+	if _, err := s.userRepo.FindByID(id); err != nil {
+		return err
+	}
+	return s.tokenService.RemoveUserSessions(id)
+}
+
+// Impersonate 先写审计日志再签发令牌 写入失败时不签发
+// 拥有用户管理权限或权限超出操作人的用户不能被模拟 避免借此获得更高的权限
+func (s *userAdminService) Impersonate(actorID, id int64, reason string, client *domain.ClientInfo) (*domain.ImpersonationToken, error) {
+	if actorID == id {
+		return nil, codes.ErrCannotImpersonateSelf
+	}
+
+	user, err := s.userRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if user.IsDisabled() {
+		return nil, codes.ErrUserDisabled
+	}
+
+	if err := s.checkImpersonationTarget(actorID, id); err != nil {
+		return nil, err
+	}
+
+	log := &domain.AuditLog{
+		ActorID:      actorID,
+		Action:       domain.AuditActionImpersonate,
+		TargetUserID: id,
+		Reason:       reason,
+	}
+	if client != nil {
+		log.IP = client.IP
+		log.UserAgent = client.UserAgent
+	}
+	if err := s.auditLogRepo.Create(log); err != nil {
+		return nil, err
+	}
+
+	token, expiresAt, err := s.tokenService.IssueImpersonationToken(id, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	zap.L().Info("管理员模拟登录",
+		zap.Int64("actor_id", actorID),
+		zap.Int64("user_id", id),
+		zap.Int64("audit_log_id", log.ID))
+
+	return &domain.ImpersonationToken{
+		User:        user,
+		AccessToken: token,
+		ExpiresAt:   expiresAt,
+	}, nil
+}
+
+// checkImpersonationTarget 目标的权限需是操作人权限的子集 且不含用户管理权限
+func (s *userAdminService) checkImpersonationTarget(actorID, id int64) error {
+	targetPerms, err := s.permissionChecker.ListUserPermissions(id)
+	if err != nil {
+		return err
+	}
+
+	for _, perm := range targetPerms {
+		if slices.Contains(domain.AdminPermissions, perm) {
+			return codes.ErrCannotImpersonatePrivileged
+		}
+	}
+	if len(targetPerms) == 0 {
+		return nil
+	}
+
+	covered, err := s.permissionChecker.HasPermissions(actorID, targetPerms...)
+	if err != nil {
+		return err
+	}
+	if !covered {
+		return codes.ErrCannotImpersonatePrivileged
+	}
+	return nil
+}
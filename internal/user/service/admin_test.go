@@ -0,0 +1,135 @@
+package service
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/user/domain"
+)
+
+// fakePermissionChecker 按用户保存权限码
+type fakePermissionChecker map[int64][]string
+
+func (c fakePermissionChecker) HasPermissions(userID int64, permCodes ...string) (bool, error) {
+	for _, perm := range permCodes {
+		if !slices.Contains(c[userID], perm) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (c fakePermissionChecker) ListUserPermissions(userID int64) ([]string, error) {
+	return c[userID], nil
+}
+
+type fakeAuditLogRepo struct {
+	logs []*domain.AuditLog
+}
+
+func (r *fakeAuditLogRepo) Create(log *domain.AuditLog) error {
+	log.ID = int64(len(r.logs) + 1)
+	r.logs = append(r.logs, log)
+	return nil
+}
+
+func (s *fakeTokenService) IssueImpersonationToken(userID, actorID int64) (string, time.Time, error) {
+	s.issued = append(s.issued, userID)
+	return "impersonation", time.Now().Add(domain.ImpersonationTokenExpire), nil
+}
+
+const (
+	impersonateActorID  int64 = 1
+	impersonateTargetID int64 = 2
+)
+
+func newImpersonateService(actorPerms, targetPerms []string) (*userAdminService, *fakeTokenService, *fakeAuditLogRepo) {
+	tokenService := &fakeTokenService{}
+	auditLogRepo := &fakeAuditLogRepo{}
+	service := &userAdminService{
+		userRepo: newFakeUserRepo(
+			&domain.User{ID: impersonateActorID, Email: "admin@example.com"},
+			&domain.User{ID: impersonateTargetID, Email: "target@example.com"},
+		),
+		tokenService: tokenService,
+		auditLogRepo: auditLogRepo,
+		permissionChecker: fakePermissionChecker{
+			impersonateActorID:  actorPerms,
+			impersonateTargetID: targetPerms,
+		},
+	}
+	return service, tokenService, auditLogRepo
+}
+
+func TestImpersonate(t *testing.T) {
+	service, tokenService, auditLogRepo := newImpersonateService(
+		[]string{domain.PermUserImpersonate, "order:read"},
+		[]string{"order:read"},
+	)
+
+	result, err := service.Impersonate(impersonateActorID, impersonateTargetID, "排查订单问题", nil)
+	if err != nil {
+		t.Fatalf("模拟登录失败: %v", err)
+	}
+	if result.User.ID != impersonateTargetID || len(tokenService.issued) != 1 {
+		t.Fatalf("应以目标用户身份签发令牌: %+v", result)
+	}
+	if len(auditLogRepo.logs) != 1 || auditLogRepo.logs[0].ActorID != impersonateActorID {
+		t.Fatalf("模拟登录应写入审计日志: %+v", auditLogRepo.logs)
+	}
+}
+
+func TestImpersonateRefusesPrivilegedTargets(t *testing.T) {
+	tests := []struct {
+		name        string
+		actorPerms  []string
+		targetPerms []string
+	}{
+		{
+			name:        "目标拥有用户管理权限",
+			actorPerms:  []string{domain.PermUserImpersonate, domain.PermUserManage},
+			targetPerms: []string{domain.PermUserManage},
+		},
+		{
+			name:        "目标拥有模拟登录权限",
+			actorPerms:  []string{domain.PermUserImpersonate},
+			targetPerms: []string{domain.PermUserImpersonate},
+		},
+		{
+			// 模拟拥有角色管理权限的用户后可为自己分配任意角色
+			name:        "目标拥有操作人没有的权限",
+			actorPerms:  []string{domain.PermUserImpersonate},
+			targetPerms: []string{"rbac:manage"},
+		},
+		{
+			name:        "目标权限部分超出操作人",
+			actorPerms:  []string{domain.PermUserImpersonate, "order:read"},
+			targetPerms: []string{"order:read", "order:refund"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, tokenService, auditLogRepo := newImpersonateService(tt.actorPerms, tt.targetPerms)
+
+			_, err := service.Impersonate(impersonateActorID, impersonateTargetID, "排查问题", nil)
+			assertErrCode(t, err, codes.ErrCannotImpersonatePrivileged)
+
+			if len(tokenService.issued) != 0 || len(auditLogRepo.logs) != 0 {
+				t.Fatal("拒绝模拟登录时不应签发令牌或写入审计日志")
+			}
+		})
+	}
+}
+
+func TestImpersonateRefusesSelfAndDisabledUsers(t *testing.T) {
+	service, _, _ := newImpersonateService([]string{domain.PermUserImpersonate}, nil)
+
+	_, err := service.Impersonate(impersonateActorID, impersonateActorID, "排查问题", nil)
+	assertErrCode(t, err, codes.ErrCannotImpersonateSelf)
+
+	service.userRepo.(*fakeUserRepo).users[impersonateTargetID].DisabledAt = time.Now()
+	_, err = service.Impersonate(impersonateActorID, impersonateTargetID, "排查问题", nil)
+	assertErrCode(t, err, codes.ErrUserDisabled)
+}
@@ -0,0 +1,18 @@
+package service
+
+import (
+	"scaffold/internal/user/domain"
+)
+
+// userClaimsProvider 用户模块自身不管理角色与权限 默认不附加额外声明
+// 接入权限模块后 通过 wire 替换为对应实现
+type userClaimsProvider struct {
+}
+
+func NewTokenClaimsProvider() domain.TokenClaimsProvider {
+	return &userClaimsProvider{}
+}
+
+func (p *userClaimsProvider) FillClaims(payload *domain.JwtPayload) error {
+	return nil
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"net/url"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/utils"
+	"scaffold/internal/user/domain"
+)
+
+func (s *userService) SendEmailVerification(userID int64) error {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return err
+	}
+
+	if user.Email == "" {
+		return codes.ErrEmailNotBound
+	}
+
+	if user.IsEmailVerified() {
+		return codes.ErrEmailAlreadyVerified
+	}
+
+	// 重发冷却 防止被用于邮件轰炸
+	ok, retryAfter, err := s.emailVerifyCache.AcquireResendCooldown(userID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return codes.ErrEmailVerifyTooFrequent.WithDetail(map[string]any{
+			"retry_after": int(retryAfter.Seconds()),
+		})
+	}
+
+	return s.sendVerificationEmail(user)
+}
+
+func (s *userService) VerifyEmail(token string) error {
+	ticket, err := s.emailVerifyCache.ConsumeToken(token)
+	if err != nil {
+		return err
+	}
+
+	user, err := s.userRepo.FindByID(ticket.UserID)
+	if err != nil {
+		return err
+	}
+
+	// 发送之后邮箱已变更 旧链接作废
+	if user.Email != ticket.Email {
+		return codes.ErrEmailVerifyTokenInvalid
+	}
+
+	if user.IsEmailVerified() {
+		return nil
+	}
+
+	return s.userRepo.MarkEmailVerified(user.ID)
+}
+
+func (s *userService) sendVerificationOnRegister(user *domain.User) {
+	// 占用冷却 避免注册后立刻被重复触发
+	if _, _, err := s.emailVerifyCache.AcquireResendCooldown(user.ID); err != nil {
+		zap.L().Error("设置验证邮件冷却失败", zap.Int64("user_id", user.ID), zap.Error(err))
+	}
+
+	if err := s.sendVerificationEmail(user); err != nil {
+		zap.L().Error("发送注册验证邮件失败", zap.Int64("user_id", user.ID), zap.Error(err))
+	}
+}
+
+func (s *userService) sendVerificationEmail(user *domain.User) error {
+	token, err := utils.GenRandomHexToken()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	ticket := &domain.EmailVerifyTicket{
+		UserID: user.ID,
+		Email:  user.Email,
+	}
+	if err := s.emailVerifyCache.SaveToken(token, ticket); err != nil {
+		return err
+	}
+
+	link, err := buildLink(emailVerifyURL, token)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := s.mailer.SendEmailVerification(user.Email, user.Nickname, link); err != nil {
+		return errors.WithStack(codes.ErrEmailSendFailed.WithCause(err))
+	}
+	return nil
+}
+
+// confirmEmailOwner 邮箱所有者已证明身份 标记账号邮箱已验证
+// 未验证的账号可能由他人抢先用该邮箱注册 此前设置的凭证与会话都不可信 需清除这些凭证并吊销全部会话
+// 已关联同一邮箱的第三方身份时 邮箱已由提供商验证 账号本就属于邮箱所有者 不做清除
+func (s *userService) confirmEmailOwner(user *domain.User) error {
+	if user.IsEmailVerified() {
+		return nil
+	}
+
+	identities, err := s.identityRepo.ListByUserID(user.ID)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	for _, identity := range identities {
+		if identity.Email != "" && identity.Email == user.Email {
+			return s.userRepo.MarkEmailVerified(user.ID)
+		}
+	}
+
+	if err := s.userRepo.ClaimUnverifiedEmail(user.ID); err != nil {
+		return err
+	}
+	return s.tokenService.RemoveUserSessions(user.ID)
+}
+
+// buildLink 在前端页面地址上拼接 token 参数
+func buildLink(baseURL, token string) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", err
+	}
+
+	query := u.Query()
+	query.Set("token", token)
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
@@ -0,0 +1,248 @@
+package service
+
+import (
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/user/domain"
+)
+
+// 包级变量先于 init 初始化 服务包的 init 会读取 JWT 配置 需在此之前写入测试用的环境变量
+var _ = setTestEnv()
+
+func setTestEnv() bool {
+	env := map[string]string{
+		"JWT_SECRET":          "scaffold-test-secret",
+		"JWT_KEYS":            "",
+		"JWT_ISSUER":          "scaffold-test",
+		"JWT_AUDIENCE":        "",
+		"JWT_EXPIRE_MINUTE":   "15",
+		"WEBAUTHN_RP_ID":      "localhost",
+		"WEBAUTHN_RP_NAME":    "scaffold",
+		"WEBAUTHN_RP_ORIGINS": "http://localhost:5173",
+	}
+	for key, val := range env {
+		if err := os.Setenv(key, val); err != nil {
+			panic(err)
+		}
+	}
+	return true
+}
+
+// assertErrCode 服务层返回的错误可能附带详情或原因 按错误码比较
+func assertErrCode(t *testing.T, err error, want error) {
+	t.Helper()
+
+	wantCode, ok := errCode(want)
+	if !ok {
+		t.Fatalf("%v 不是错误码", want)
+	}
+	if got, ok := errCode(err); !ok || got != wantCode {
+		t.Fatalf("期望错误 %d(%v) 实际为 %v", wantCode, want, err)
+	}
+}
+
+func errCode(err error) (int, bool) {
+	var (
+		code       codes.ErrCode
+		withDetail codes.ErrCodeWithDetail
+		withCause  codes.ErrCodeWithCause
+	)
+	switch {
+	case errors.As(err, &code):
+		return code.Code, true
+	case errors.As(err, &withDetail):
+		return withDetail.Code, true
+	case errors.As(err, &withCause):
+		return withCause.Code, true
+	default:
+		return 0, false
+	}
+}
+
+// fakeUserRepo 内存中的用户仓储 只实现测试用到的方法
+type fakeUserRepo struct {
+	domain.UserRepository
+
+	mu    sync.Mutex
+	users map[int64]*domain.User
+	// identities 不为空时 CreateWithIdentity 同时写入身份
+	identities *fakeIdentityRepo
+	// claimed 被认领邮箱的用户
+	claimed []int64
+}
+
+func newFakeUserRepo(users ...*domain.User) *fakeUserRepo {
+	repo := &fakeUserRepo{users: make(map[int64]*domain.User)}
+	for _, user := range users {
+		repo.users[user.ID] = user
+	}
+	return repo
+}
+
+func (r *fakeUserRepo) FindByID(id int64) (*domain.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	user, ok := r.users[id]
+	if !ok {
+		return nil, codes.ErrUserNotFound
+	}
+	copied := *user
+	return &copied, nil
+}
+
+func (r *fakeUserRepo) FindByEmail(email string) (*domain.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, user := range r.users {
+		if user.Email == email {
+			copied := *user
+			return &copied, nil
+		}
+	}
+	return nil, codes.ErrUserNotFound
+}
+
+// CreateWithIdentity 身份需另外保存到 identities
+func (r *fakeUserRepo) CreateWithIdentity(user *domain.User, identity *domain.UserIdentity) (*domain.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	created := *user
+	created.ID = int64(len(r.users) + 1)
+	r.users[created.ID] = &created
+	if r.identities != nil {
+		identity.UserID = created.ID
+		if _, err := r.identities.Create(identity); err != nil {
+			return nil, err
+		}
+	}
+	copied := created
+	return &copied, nil
+}
+
+func (r *fakeUserRepo) MarkEmailVerified(id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if user, ok := r.users[id]; ok {
+		user.EmailVerifiedAt = time.Now()
+	}
+	return nil
+}
+
+// ClaimUnverifiedEmail 只清除用户表中的凭证 其他表中的凭证由数据库实现在同一事务中删除
+func (r *fakeUserRepo) ClaimUnverifiedEmail(id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	user, ok := r.users[id]
+	if !ok {
+		return codes.ErrUserNotFound
+	}
+	if user.IsEmailVerified() {
+		return nil
+	}
+	r.claimed = append(r.claimed, id)
+	user.PasswordHash = ""
+	user.Phone = ""
+	user.PhoneVerifiedAt = time.Time{}
+	user.EmailVerifiedAt = time.Now()
+	return nil
+}
+
+func (r *fakeUserRepo) UpdatePassword(id int64, hash string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	user, ok := r.users[id]
+	if !ok {
+		return codes.ErrUserNotFound
+	}
+	user.PasswordHash = hash
+	return nil
+}
+
+func (r *fakeUserRepo) UpdateLastLogin(id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if user, ok := r.users[id]; ok {
+		user.LastLoginAt = time.Now()
+	}
+	return nil
+}
+
+// fakeIdentityRepo 内存中的第三方身份仓储
+type fakeIdentityRepo struct {
+	domain.UserIdentityRepository
+
+	mu         sync.Mutex
+	identities []*domain.UserIdentity
+}
+
+func (r *fakeIdentityRepo) FindByProviderSubject(provider, subject string) (*domain.UserIdentity, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, identity := range r.identities {
+		if identity.Provider == provider && identity.Subject == subject {
+			copied := *identity
+			return &copied, nil
+		}
+	}
+	return nil, codes.ErrIdentityNotFound
+}
+
+func (r *fakeIdentityRepo) ListByUserID(userID int64) ([]*domain.UserIdentity, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var result []*domain.UserIdentity
+	for _, identity := range r.identities {
+		if identity.UserID == userID {
+			copied := *identity
+			result = append(result, &copied)
+		}
+	}
+	return result, nil
+}
+
+func (r *fakeIdentityRepo) Create(identity *domain.UserIdentity) (*domain.UserIdentity, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	created := *identity
+	created.ID = int64(len(r.identities) + 1)
+	r.identities = append(r.identities, &created)
+	return &created, nil
+}
+
+func (r *fakeIdentityRepo) UpdateLastUsed(int64) error {
+	return nil
+}
+
+// fakeTokenService 只记录签发会话与吊销全部会话的用户
+type fakeTokenService struct {
+	domain.TokenService
+
+	issued  []int64
+	revoked []int64
+}
+
+func (s *fakeTokenService) RemoveUserSessions(userID int64) error {
+	s.revoked = append(s.revoked, userID)
+	return nil
+}
+
+func (s *fakeTokenService) IssueSession(userID int64, _ *domain.ClientInfo) (*domain.User2Token, error) {
+	s.issued = append(s.issued, userID)
+	return &domain.User2Token{AccessToken: "access", RefreshToken: "refresh"}, nil
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"scaffold/internal/common/reskit/codes"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"scaffold/internal/user/domain"
+)
+
+func (s *userService) ListIdentities(userID int64) ([]*domain.UserIdentity, error) {
+	return s.identityRepo.ListByUserID(userID)
+}
+
+func (s *userService) LinkIdentity(userID int64, provider, code, state, nonce string) (*domain.UserIdentity, error) {
+	// 1. 校验 state 并获取第三方用户信息 state 必须由当前用户通过绑定授权接口发起
+	userInfo, err := s.exchangeOAuthCode(provider, code, state, nonce, &domain.OAuthState{
+		Purpose: domain.OAuthPurposeLink,
+		UserID:  userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 第三方账号已被绑定 绑定在自己名下时直接返回
+	identity, err := s.identityRepo.FindByProviderSubject(provider, userInfo.ID)
+	if err == nil {
+		if identity.UserID != userID {
+			return nil, codes.ErrIdentityAlreadyLinked
+		}
+		return identity, nil
+	}
+	if !errors.Is(err, codes.ErrIdentityNotFound) {
+		return nil, errors.WithStack(err)
+	}
+
+	// 3. 每个提供商只允许绑定一个账号
+	identities, err := s.identityRepo.ListByUserID(userID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, item := range identities {
+		if item.Provider == provider {
+			return nil, codes.ErrIdentityProviderLinked
+		}
+	}
+
+	// 4. 创建绑定
+	identity, err = s.identityRepo.Create(newIdentity(userID, provider, userInfo))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return identity, nil
+}
+
+func (s *userService) UnlinkIdentity(userID, identityID int64) error {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return err
+	}
+
+	identities, err := s.identityRepo.ListByUserID(userID)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	found := false
+	for _, item := range identities {
+		if item.ID == identityID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return codes.ErrIdentityNotFound
+	}
+
+	passkeyCount, err := s.passkeyRepo.CountByUserID(userID)
+	if err != nil {
+		return err
+	}
+
+	// 移除后至少保留一种登录方式
+	if countLoginMethods(user, identities, int(passkeyCount)) <= 1 {
+		return codes.ErrIdentityLastLoginMethod
+	}
+
+	return s.identityRepo.Delete(userID, identityID)
+}
+
+// findOrCreateUserByOAuth 通过第三方身份解析用户
+// 依次按 身份表 -> 邮箱 -> 新建用户 的顺序查找
+func (s *userService) findOrCreateUserByOAuth(provider string, userInfo *domain.OAuthUserInfo) (
+	user *domain.User, isNew bool, err error,
+) {
+	// 1. 先通过身份表查找
+	identity, err := s.identityRepo.FindByProviderSubject(provider, userInfo.ID)
+	if err == nil {
+		if err := s.identityRepo.UpdateLastUsed(identity.ID); err != nil {
+			zap.L().Error("更新身份最后使用时间失败", zap.Int64("identity_id", identity.ID), zap.Error(err))
+		}
+
+		user, err = s.userRepo.FindByID(identity.UserID)
+		return user, false, err
+	}
+
+	if !errors.Is(err, codes.ErrIdentityNotFound) {
+		return nil, false, errors.WithStack(err)
+	}
+
+	// 2. 通过邮箱查找现有用户 找到且邮箱已验证时绑定身份 否则创建新用户
+	user, isNew, err = s.findOrCreateUserByEmail(userInfo.Email, func() (*domain.User, error) {
+		return s.createUserFromOAuth(provider, userInfo)
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !isNew {
+		// 邮箱未验证的账号可能是他人抢先注册的 自动绑定会让受害者登录进对方控制的账号
+		if !user.IsEmailVerified() {
+			return nil, false, codes.ErrOAuthEmailUnverified
+		}
+		if _, err := s.identityRepo.Create(newIdentity(user.ID, provider, userInfo)); err != nil {
+			return nil, false, errors.WithStack(err)
+		}
+	}
+	return user, isNew, nil
+}
+
+// findOrCreateUserByEmail 按邮箱查找用户 不存在时调用 create 创建
+// 第三方登录与无密码登录共用 保证同一邮箱只对应一个账号
+func (s *userService) findOrCreateUserByEmail(email string, create func() (*domain.User, error)) (
+	user *domain.User, isNew bool, err error,
+) {
+	if email != "" {
+		user, err = s.userRepo.FindByEmail(normalizeEmail(email))
+		if err == nil {
+			return user, false, nil
+		}
+
+		if !errors.Is(err, codes.ErrUserNotFound) {
+			return nil, false, errors.WithStack(err)
+		}
+	}
+
+	user, err = create()
+	return user, true, err
+}
+
+func (s *userService) createUserFromOAuth(provider string, userInfo *domain.OAuthUserInfo) (*domain.User, error) {
+	user := &domain.User{
+		Email:       normalizeEmail(userInfo.Email),
+		Nickname:    userInfo.Nickname,
+		Avatar:      userInfo.Avatar,
+		LastLoginAt: time.Now(),
+	}
+
+	if user.Nickname == "" {
+		user.Nickname = userInfo.Login
+	}
+
+	// 提供商已验证邮箱时无需再发送验证邮件 之后可使用其他方式登录同一邮箱
+	if user.Email != "" && userInfo.EmailVerified {
+		user.EmailVerifiedAt = time.Now()
+	}
+
+	// 超出字段长度的头像地址直接丢弃
+	if len(user.Avatar) > maxAvatarLength {
+		user.Avatar = ""
+	}
+
+	user, err := s.userRepo.CreateWithIdentity(user, newIdentity(0, provider, userInfo))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return user, nil
+}
+
+func newIdentity(userID int64, provider string, userInfo *domain.OAuthUserInfo) *domain.UserIdentity {
+	return &domain.UserIdentity{
+		UserID:     userID,
+		Provider:   provider,
+		Subject:    userInfo.ID,
+		Email:      normalizeEmail(userInfo.Email),
+		LastUsedAt: time.Now(),
+	}
+}
+
+// countLoginMethods 统计用户可用的登录方式数量 每个通行密钥单独计数 已验证的手机号可用短信登录
+func countLoginMethods(user *domain.User, identities []*domain.UserIdentity, passkeyCount int) int {
+	count := len(identities) + passkeyCount
+	if user.HasPassword() {
+		count++
+	}
+	if user.IsPhoneVerified() {
+		count++
+	}
+	return count
+}
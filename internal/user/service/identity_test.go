@@ -0,0 +1,85 @@
+package service
+
+import (
+	"testing"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/user/domain"
+)
+
+func newOAuthTestService(users ...*domain.User) (*userService, *fakeUserRepo, *fakeIdentityRepo) {
+	identityRepo := &fakeIdentityRepo{}
+	userRepo := newFakeUserRepo(users...)
+	userRepo.identities = identityRepo
+	return &userService{userRepo: userRepo, identityRepo: identityRepo}, userRepo, identityRepo
+}
+
+func TestOAuthSignupTrustsProviderVerifiedEmail(t *testing.T) {
+	service, _, identityRepo := newOAuthTestService()
+
+	user, isNew, err := service.findOrCreateUserByOAuth(domain.OAuthProviderGithub, &domain.OAuthUserInfo{
+		ID:            "gh-1",
+		Login:         "octocat",
+		Email:         "Octo@Example.com",
+		EmailVerified: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !isNew || user.Email != "octo@example.com" || !user.IsEmailVerified() {
+		t.Fatalf("提供商已验证的邮箱应标记为已验证: %+v", user)
+	}
+
+	// 使用同一邮箱的其他提供商登录时绑定到该账号
+	linked, isNew, err := service.findOrCreateUserByOAuth(domain.OAuthProviderGoogle, &domain.OAuthUserInfo{
+		ID:            "google-1",
+		Email:         "octo@example.com",
+		EmailVerified: true,
+	})
+	if err != nil {
+		t.Fatalf("第二个提供商登录失败: %v", err)
+	}
+	if isNew || linked.ID != user.ID {
+		t.Fatalf("应绑定到已有账号 %d 实际为 %d", user.ID, linked.ID)
+	}
+
+	identities, err := identityRepo.ListByUserID(user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(identities) != 2 {
+		t.Fatalf("账号应关联两个身份 实际为 %d", len(identities))
+	}
+}
+
+func TestOAuthSignupWithoutVerifiedEmail(t *testing.T) {
+	service, _, _ := newOAuthTestService()
+
+	user, _, err := service.findOrCreateUserByOAuth(domain.OAuthProviderOIDC, &domain.OAuthUserInfo{
+		ID:    "oidc-1",
+		Login: "someone",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.IsEmailVerified() {
+		t.Fatalf("没有邮箱的账号不应标记为已验证: %+v", user)
+	}
+}
+
+func TestOAuthLoginRefusesUnverifiedLocalAccount(t *testing.T) {
+	// 他人抢先用该邮箱注册但未验证
+	squatter := &domain.User{ID: 1, Email: "victim@example.com", PasswordHash: "hash"}
+	service, _, identityRepo := newOAuthTestService(squatter)
+
+	_, _, err := service.findOrCreateUserByOAuth(domain.OAuthProviderGithub, &domain.OAuthUserInfo{
+		ID:            "gh-2",
+		Email:         "victim@example.com",
+		EmailVerified: true,
+	})
+	assertErrCode(t, err, codes.ErrOAuthEmailUnverified)
+
+	if identities, _ := identityRepo.ListByUserID(squatter.ID); len(identities) != 0 {
+		t.Fatalf("不应绑定到未验证的账号: %+v", identities)
+	}
+}
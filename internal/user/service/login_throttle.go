@@ -0,0 +1,123 @@
+package service
+
+import (
+	"math"
+	"time"
+
+	"go.uber.org/zap"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/utils"
+	"scaffold/internal/user/domain"
+)
+
+func (s *userService) LoginCaptchaRequired(email, ip string) (bool, error) {
+	accountFailures, ipFailures, err := s.loginThrottleCache.GetFailures(normalizeEmail(email), ip)
+	if err != nil {
+		return false, err
+	}
+	return accountFailures >= domain.LoginCaptchaThreshold || ipFailures >= domain.LoginCaptchaThreshold, nil
+}
+
+func (s *userService) ThrottleIP(rule domain.IPThrottleRule, ip string) error {
+	count, ttl, err := s.loginThrottleCache.IncrIPRequests(rule, ip)
+	if err != nil {
+		return err
+	}
+	if count > rule.Limit {
+		return codes.ErrTooManyRequests.WithDetail(retryAfterDetail(ttl))
+	}
+	return nil
+}
+
+// checkLoginAllowed 校验密码前检查账号锁定、失败等待与IP失败次数
+func (s *userService) checkLoginAllowed(account, ip string) error {
+	lockTTL, delayTTL, err := s.loginThrottleCache.Blocked(account)
+	if err != nil {
+		return err
+	}
+	if lockTTL > 0 {
+		return codes.ErrAccountLocked.WithDetail(retryAfterDetail(lockTTL))
+	}
+	if delayTTL > 0 {
+		return codes.ErrLoginTooFrequent.WithDetail(retryAfterDetail(delayTTL))
+	}
+
+	if ip == "" {
+		return nil
+	}
+
+	_, ipFailures, err := s.loginThrottleCache.GetFailures(account, ip)
+	if err != nil {
+		return err
+	}
+	if ipFailures >= domain.LoginMaxIPFailures {
+		ttl, err := s.loginThrottleCache.IPFailuresTTL(ip)
+		if err != nil {
+			return err
+		}
+		return codes.ErrLoginTooFrequent.WithDetail(retryAfterDetail(ttl))
+	}
+	return nil
+}
+
+// recordLoginFailure 记录失败并返回应响应的错误
+// 达到人机验证阈值后逐次延长等待时间 达到锁定阈值后锁定账号
+func (s *userService) recordLoginFailure(account, ip string) error {
+	accountFailures, ipFailures, err := s.loginThrottleCache.IncrFailures(account, ip)
+	if err != nil {
+		zap.L().Error("记录登录失败次数失败", zap.Error(err))
+		return codes.ErrInvalidCredentials
+	}
+
+	if accountFailures >= domain.LoginLockThreshold {
+		if err := s.loginThrottleCache.Lock(account, domain.LoginLockDuration); err != nil {
+			zap.L().Error("锁定账号失败", zap.Error(err))
+			return codes.ErrInvalidCredentials
+		}
+		if err := s.loginThrottleCache.ResetFailures(account); err != nil {
+			zap.L().Error("清空登录失败次数失败", zap.Error(err))
+		}
+
+		zap.L().Warn("登录失败次数过多 账号已临时锁定",
+			zap.String("event", "login_account_locked"),
+			zap.String("account_hash", utils.HashToken(account)),
+			zap.String("ip", ip),
+		)
+		return codes.ErrAccountLocked.WithDetail(retryAfterDetail(domain.LoginLockDuration))
+	}
+
+	if accountFailures >= domain.LoginCaptchaThreshold {
+		if err := s.loginThrottleCache.SetDelay(account, loginDelay(accountFailures)); err != nil {
+			zap.L().Error("设置登录等待时间失败", zap.Error(err))
+		}
+	}
+
+	// 告知前端下次登录需携带人机验证
+	if accountFailures >= domain.LoginCaptchaThreshold || ipFailures >= domain.LoginCaptchaThreshold {
+		return codes.ErrInvalidCredentials.WithDetail(map[string]any{"captcha_required": true})
+	}
+	return codes.ErrInvalidCredentials
+}
+
+func (s *userService) resetLoginFailures(account string) {
+	if err := s.loginThrottleCache.ResetFailures(account); err != nil {
+		zap.L().Error("清空登录失败次数失败", zap.Error(err))
+	}
+}
+
+// loginDelay 从人机验证阈值开始 每次失败等待时间翻倍
+func loginDelay(failures int64) time.Duration {
+	shift := failures - domain.LoginCaptchaThreshold
+	if shift >= 16 {
+		return domain.LoginDelayMax
+	}
+	return min(domain.LoginDelayBase<<shift, domain.LoginDelayMax)
+}
+
+// retryAfterDetail 剩余时间向上取整到秒 避免客户端提前重试
+func retryAfterDetail(retryAfter time.Duration) map[string]any {
+	return map[string]any{
+		"retry_after": int(math.Ceil(retryAfter.Seconds())),
+	}
+}
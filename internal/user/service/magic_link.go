@@ -0,0 +1,180 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/utils"
+	"scaffold/internal/user/domain"
+)
+
+// maxNicknameLength 与 users.nickname 字段长度一致
+const maxNicknameLength = 20
+
+// RequestMagicLink 邮箱未注册时同样发送 首次登录时自动创建账号
+func (s *userService) RequestMagicLink(email string, client *domain.ClientInfo) error {
+	email = normalizeEmail(email)
+
+	// 1. 限制单个IP与单个邮箱的发送频率 防止被用于邮件轰炸
+	if client != nil && client.IP != "" {
+		count, err := s.magicLinkCache.IncrIPRequests(client.IP)
+		if err != nil {
+			return err
+		}
+		if count > domain.MagicLinkMaxPerIP {
+			return codes.ErrMagicLinkTooFrequent
+		}
+	}
+
+	ok, retryAfter, err := s.magicLinkCache.AcquireCooldown(email)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return codes.ErrMagicLinkTooFrequent.WithDetail(map[string]any{
+			"retry_after": int(retryAfter.Seconds()),
+		})
+	}
+
+	// 2. 生成链接令牌与验证码 新凭据覆盖之前发送的凭据
+	token, err := utils.GenRandomHexToken()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	code, err := genNumericCode(domain.EmailOTPLen)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := s.magicLinkCache.SaveTicket(&domain.MagicLinkTicket{
+		Email:     email,
+		TokenHash: utils.HashToken(token),
+		CodeHash:  utils.HashToken(code),
+	}); err != nil {
+		return err
+	}
+
+	// 3. 发送邮件
+	link, err := buildLink(magicLinkURL, token)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := s.mailer.SendMagicLink(email, link, code); err != nil {
+		return errors.WithStack(codes.ErrEmailSendFailed.WithCause(err))
+	}
+	return nil
+}
+
+func (s *userService) VerifyMagicLink(token string, client *domain.ClientInfo) (*domain.LoginResult, error) {
+	email, err := s.magicLinkCache.GetEmailByToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	// 之后重新发送过 旧链接作废
+	ticket, err := s.magicLinkCache.GetTicket(email)
+	if err != nil {
+		return nil, err
+	}
+	if ticket.TokenHash != utils.HashToken(token) {
+		return nil, codes.ErrMagicLinkInvalid
+	}
+
+	if err := s.magicLinkCache.RemoveTicket(email); err != nil {
+		return nil, err
+	}
+
+	return s.loginByEmail(email, client)
+}
+
+func (s *userService) VerifyEmailOTP(email, code string, client *domain.ClientInfo) (*domain.LoginResult, error) {
+	email = normalizeEmail(email)
+
+	ticket, err := s.magicLinkCache.GetTicket(email)
+	if err != nil {
+		return nil, err
+	}
+
+	codeHash := utils.HashToken(strings.TrimSpace(code))
+	if subtle.ConstantTimeCompare([]byte(ticket.CodeHash), []byte(codeHash)) != 1 {
+		// 错误次数达到上限后作废凭据 防止暴力枚举验证码
+		attempts, err := s.magicLinkCache.IncrAttempts(email)
+		if err != nil {
+			return nil, err
+		}
+		if attempts >= domain.MagicLinkMaxAttempts {
+			_ = s.magicLinkCache.RemoveTicket(email)
+			return nil, codes.ErrMagicLinkTooManyAttempts
+		}
+		return nil, codes.ErrEmailOTPInvalid
+	}
+
+	if err := s.magicLinkCache.RemoveTicket(email); err != nil {
+		return nil, err
+	}
+
+	return s.loginByEmail(email, client)
+}
+
+// loginByEmail 邮箱所有权已确认 查找或创建用户并标记邮箱已验证
+func (s *userService) loginByEmail(email string, client *domain.ClientInfo) (*domain.LoginResult, error) {
+	user, isNew, err := s.findOrCreateUserByEmail(email, func() (*domain.User, error) {
+		return s.createUserFromEmail(email)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !isNew {
+		if err := s.confirmEmailOwner(user); err != nil {
+			return nil, err
+		}
+
+		if err := s.userRepo.UpdateLastLogin(user.ID); err != nil {
+			zap.L().Error("更新用户最后登录时间失败", zap.Int64("user_id", user.ID), zap.Error(err))
+		}
+	}
+
+	return s.beginLogin(user.ID, client)
+}
+
+func (s *userService) createUserFromEmail(email string) (*domain.User, error) {
+	now := time.Now()
+
+	// 默认昵称取邮箱@之前的部分 超出昵称长度时截断
+	nickname, _, _ := strings.Cut(email, "@")
+	if runes := []rune(nickname); len(runes) > maxNicknameLength {
+		nickname = string(runes[:maxNicknameLength])
+	}
+
+	user, err := s.userRepo.Create(&domain.User{
+		Email:           email,
+		Nickname:        nickname,
+		EmailVerifiedAt: now,
+		LastLoginAt:     now,
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return user, nil
+}
+
+// genNumericCode 生成定长数字验证码
+func genNumericCode(length int) (string, error) {
+	limit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(length)), nil)
+	n, err := rand.Int(rand.Reader, limit)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", length, n), nil
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"scaffold/internal/user/domain"
+)
+
+func newEmailLoginService(user *domain.User, identities ...*domain.UserIdentity) (*userService, *fakeUserRepo, *fakeTokenService) {
+	userRepo := newFakeUserRepo(user)
+	tokenService := &fakeTokenService{}
+	return &userService{
+		userRepo:     userRepo,
+		identityRepo: &fakeIdentityRepo{identities: identities},
+		tokenService: tokenService,
+		mfaRepo:      newFakeMFARepo(),
+	}, userRepo, tokenService
+}
+
+func TestLoginByEmailClaimsUnverifiedAccount(t *testing.T) {
+	// 他人抢先用该邮箱注册 并绑定了自己的手机号与第三方账号
+	squatter := &domain.User{
+		ID:              1,
+		Email:           "victim@example.com",
+		PasswordHash:    "hash",
+		Phone:           "+8613800000000",
+		PhoneVerifiedAt: time.Now(),
+	}
+	service, userRepo, tokenService := newEmailLoginService(squatter, &domain.UserIdentity{
+		ID:       1,
+		UserID:   squatter.ID,
+		Provider: domain.OAuthProviderGithub,
+		Subject:  "squatter",
+		Email:    "squatter@example.com",
+	})
+
+	if _, err := service.loginByEmail("victim@example.com", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(userRepo.claimed, []int64{squatter.ID}) {
+		t.Fatalf("未验证的账号应被认领: %v", userRepo.claimed)
+	}
+	if !slices.Equal(tokenService.revoked, []int64{squatter.ID}) {
+		t.Fatalf("认领后应吊销抢注者的全部会话: %v", tokenService.revoked)
+	}
+
+	user, _ := userRepo.FindByID(squatter.ID)
+	if user.HasPassword() || user.Phone != "" || user.IsPhoneVerified() || !user.IsEmailVerified() {
+		t.Fatalf("认领后应清除密码与手机号并标记邮箱已验证: %+v", user)
+	}
+	if len(tokenService.issued) != 1 {
+		t.Fatal("认领后应为邮箱所有者签发会话")
+	}
+}
+
+func TestLoginByEmailKeepsAccountProvenByIdentity(t *testing.T) {
+	// 第三方登录创建的旧账号未记录邮箱验证时间 但身份的邮箱已由提供商验证
+	owner := &domain.User{ID: 1, Email: "owner@example.com"}
+	service, userRepo, tokenService := newEmailLoginService(owner, &domain.UserIdentity{
+		ID:       1,
+		UserID:   owner.ID,
+		Provider: domain.OAuthProviderGithub,
+		Subject:  "owner",
+		Email:    "owner@example.com",
+	})
+
+	if _, err := service.loginByEmail("owner@example.com", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(userRepo.claimed) != 0 || len(tokenService.revoked) != 0 {
+		t.Fatal("邮箱已由第三方身份证明的账号不应被清除凭证")
+	}
+	if user, _ := userRepo.FindByID(owner.ID); !user.IsEmailVerified() {
+		t.Fatal("登录后应标记邮箱已验证")
+	}
+}
+
+func TestLoginByEmailLeavesVerifiedAccount(t *testing.T) {
+	owner := &domain.User{ID: 1, Email: "owner@example.com", PasswordHash: "hash", EmailVerifiedAt: time.Now()}
+	service, userRepo, tokenService := newEmailLoginService(owner)
+
+	if _, err := service.loginByEmail("owner@example.com", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(userRepo.claimed) != 0 || len(tokenService.revoked) != 0 {
+		t.Fatal("邮箱已验证的账号不应被清除凭证")
+	}
+	if user, _ := userRepo.FindByID(owner.ID); !user.HasPassword() {
+		t.Fatal("邮箱已验证的账号应保留密码")
+	}
+}
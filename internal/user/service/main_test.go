@@ -0,0 +1,13 @@
+package service
+
+import (
+	"testing"
+
+	"scaffold/internal/common/testkit"
+)
+
+// TestMain 会话与吊销名单使用真实的 Redis 缓存实现 以 miniredis 代替 Redis 服务
+// 各测试使用不同的用户与会话互不影响
+func TestMain(m *testing.M) {
+	testkit.RunWithRedis(m)
+}
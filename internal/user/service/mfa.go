@@ -0,0 +1,313 @@
+package service
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/pquerna/otp/totp"
+	"go.uber.org/zap"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/utils"
+	"scaffold/internal/user/domain"
+)
+
+const (
+	// mfaQRCodeSize 二维码图片边长 单位像素
+	mfaQRCodeSize = 256
+	// totpCodeLen TOTP验证码位数 其余长度的输入按恢复码处理
+	totpCodeLen = 6
+)
+
+// beginLogin 第一因素校验通过后调用 未开启两步验证时直接签发令牌
+func (s *userService) beginLogin(userID int64, client *domain.ClientInfo) (*domain.LoginResult, error) {
+	mfa, err := s.mfaRepo.FindByUserID(userID)
+	if err != nil && !errors.Is(err, codes.ErrMFANotEnrolled) {
+		return nil, err
+	}
+
+	if mfa == nil || !mfa.IsEnabled() {
+		token, err := s.tokenService.IssueSession(userID, client)
+		if err != nil {
+			return nil, err
+		}
+		return &domain.LoginResult{Token: token}, nil
+	}
+
+	mfaToken, err := utils.GenRandomHexToken()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err := s.mfaCache.SavePendingToken(mfaToken, &domain.MFAPendingTicket{UserID: userID}); err != nil {
+		return nil, err
+	}
+	return &domain.LoginResult{MFAToken: mfaToken}, nil
+}
+
+func (s *userService) VerifyMFA(mfaToken, code string, client *domain.ClientInfo) (*domain.User2Token, error) {
+	ticket, err := s.mfaCache.GetPendingToken(mfaToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// 登录过程中两步验证被关闭 要求重新登录
+	mfa, err := s.findEnabledMFA(ticket.UserID)
+	if err != nil {
+		if errors.Is(err, codes.ErrMFANotEnabled) {
+			return nil, codes.ErrMFATokenInvalid
+		}
+		return nil, err
+	}
+
+	if err := s.checkMFACode(mfa, code); err != nil {
+		if !errors.Is(err, codes.ErrMFACodeInvalid) {
+			return nil, err
+		}
+
+		// 错误次数达到上限后作废待验证令牌 防止暴力枚举验证码
+		attempts, err := s.mfaCache.IncrPendingAttempts(mfaToken)
+		if err != nil {
+			return nil, err
+		}
+		if attempts >= domain.MFAMaxAttempts {
+			_ = s.mfaCache.RemovePendingToken(mfaToken)
+			return nil, codes.ErrMFATooManyAttempts
+		}
+		return nil, codes.ErrMFACodeInvalid
+	}
+
+	// 待验证令牌只能换取一次正式令牌
+	if err := s.mfaCache.RemovePendingToken(mfaToken); err != nil {
+		return nil, err
+	}
+
+	return s.tokenService.IssueSession(ticket.UserID, client)
+}
+
+func (s *userService) GetMFAStatus(userID int64) (*domain.MFAStatus, error) {
+	mfa, err := s.mfaRepo.FindByUserID(userID)
+	if err != nil {
+		if errors.Is(err, codes.ErrMFANotEnrolled) {
+			return &domain.MFAStatus{}, nil
+		}
+		return nil, err
+	}
+	if !mfa.IsEnabled() {
+		return &domain.MFAStatus{}, nil
+	}
+
+	remaining, err := s.mfaRepo.CountRecoveryCodes(userID)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.MFAStatus{
+		Enabled:                true,
+		RecoveryCodesRemaining: remaining,
+	}, nil
+}
+
+// EnrollTOTP 重复调用会覆盖尚未确认的密钥 已开启时需先关闭
+func (s *userService) EnrollTOTP(userID int64) (*domain.MFAEnrollment, error) {
+	mfa, err := s.mfaRepo.FindByUserID(userID)
+	if err != nil && !errors.Is(err, codes.ErrMFANotEnrolled) {
+		return nil, err
+	}
+	if mfa != nil && mfa.IsEnabled() {
+		return nil, codes.ErrMFAAlreadyEnabled
+	}
+
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      mfaIssuer,
+		AccountName: user.AccountName(),
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	img, err := key.Image(mfaQRCodeSize, mfaQRCodeSize)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	var qrCode bytes.Buffer
+	if err := png.Encode(&qrCode, img); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if err := s.mfaRepo.Save(&domain.UserMFA{
+		UserID: userID,
+		Secret: key.Secret(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &domain.MFAEnrollment{
+		URI:       key.URL(),
+		Secret:    key.Secret(),
+		QRCodePNG: qrCode.Bytes(),
+	}, nil
+}
+
+// ConfirmTOTP 只接受TOTP验证码 证明验证器已正确绑定
+func (s *userService) ConfirmTOTP(userID int64, code string) ([]string, error) {
+	mfa, err := s.mfaRepo.FindByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if mfa.IsEnabled() {
+		return nil, codes.ErrMFAAlreadyEnabled
+	}
+
+	ok, err := s.verifyTOTP(mfa, normalizeMFACode(code))
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, codes.ErrMFACodeInvalid
+	}
+
+	mfa.EnabledAt = time.Now()
+	if err := s.mfaRepo.Save(mfa); err != nil {
+		return nil, err
+	}
+
+	return s.issueRecoveryCodes(userID)
+}
+
+func (s *userService) DisableMFA(userID int64, code string) error {
+	mfa, err := s.findEnabledMFA(userID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.checkMFACode(mfa, code); err != nil {
+		return err
+	}
+
+	return s.mfaRepo.Delete(userID)
+}
+
+// RegenerateRecoveryCodes 生成新的恢复码 旧恢复码全部作废
+func (s *userService) RegenerateRecoveryCodes(userID int64, code string) ([]string, error) {
+	mfa, err := s.findEnabledMFA(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkMFACode(mfa, code); err != nil {
+		return nil, err
+	}
+
+	return s.issueRecoveryCodes(userID)
+}
+
+func (s *userService) findEnabledMFA(userID int64) (*domain.UserMFA, error) {
+	mfa, err := s.mfaRepo.FindByUserID(userID)
+	if err != nil {
+		if errors.Is(err, codes.ErrMFANotEnrolled) {
+			return nil, codes.ErrMFANotEnabled
+		}
+		return nil, err
+	}
+	if !mfa.IsEnabled() {
+		return nil, codes.ErrMFANotEnabled
+	}
+	return mfa, nil
+}
+
+// checkMFACode 校验验证码并按用户累计错误次数 达到上限后在窗口内拒绝校验
+// 待验证令牌的次数限制可通过重新登录绕过 按用户计数才能阻止暴力枚举
+func (s *userService) checkMFACode(mfa *domain.UserMFA, code string) error {
+	failures, ttl, err := s.mfaCache.GetUserFailures(mfa.UserID)
+	if err != nil {
+		return err
+	}
+	if failures >= domain.MFAUserMaxFailures {
+		return codes.ErrMFALocked.WithDetail(retryAfterDetail(ttl))
+	}
+
+	ok, err := s.verifyMFACode(mfa, code)
+	if err != nil {
+		return err
+	}
+	if ok {
+		if err := s.mfaCache.ResetUserFailures(mfa.UserID); err != nil {
+			zap.L().Error("清空两步验证失败次数失败", zap.Int64("user_id", mfa.UserID), zap.Error(err))
+		}
+		return nil
+	}
+
+	failures, err = s.mfaCache.IncrUserFailures(mfa.UserID)
+	if err != nil {
+		return err
+	}
+	if failures >= domain.MFAUserMaxFailures {
+		zap.L().Warn("两步验证失败次数过多 已临时锁定",
+			zap.String("event", "mfa_locked"),
+			zap.Int64("user_id", mfa.UserID),
+		)
+		return codes.ErrMFALocked.WithDetail(retryAfterDetail(domain.MFAUserLockWindow))
+	}
+	return codes.ErrMFACodeInvalid
+}
+
+// verifyMFACode 6位数字按TOTP验证码校验 其余按恢复码校验
+func (s *userService) verifyMFACode(mfa *domain.UserMFA, code string) (bool, error) {
+	code = normalizeMFACode(code)
+	if isTOTPCode(code) {
+		return s.verifyTOTP(mfa, code)
+	}
+	return s.mfaRepo.UseRecoveryCode(mfa.UserID, utils.HashToken(code))
+}
+
+// verifyTOTP 校验通过的验证码在有效窗口内不能再次使用 防止被截获后重放
+func (s *userService) verifyTOTP(mfa *domain.UserMFA, code string) (bool, error) {
+	if !isTOTPCode(code) || !totp.Validate(code, mfa.Secret) {
+		return false, nil
+	}
+	return s.mfaCache.MarkCodeUsed(mfa.UserID, code)
+}
+
+func (s *userService) issueRecoveryCodes(userID int64) ([]string, error) {
+	recoveryCodes := make([]string, 0, domain.RecoveryCodeCount)
+	codeHashes := make([]string, 0, domain.RecoveryCodeCount)
+	for i := 0; i < domain.RecoveryCodeCount; i++ {
+		random, err := utils.GenRandomHex(5)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		recoveryCode := random[:5] + "-" + random[5:]
+		recoveryCodes = append(recoveryCodes, recoveryCode)
+		codeHashes = append(codeHashes, utils.HashToken(normalizeMFACode(recoveryCode)))
+	}
+
+	if err := s.mfaRepo.ReplaceRecoveryCodes(userID, codeHashes); err != nil {
+		return nil, err
+	}
+	return recoveryCodes, nil
+}
+
+// normalizeMFACode 忽略用户输入中的空格 连字符与大小写
+func normalizeMFACode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
+
+func isTOTPCode(code string) bool {
+	if len(code) != totpCodeLen {
+		return false
+	}
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
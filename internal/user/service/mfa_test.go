@@ -0,0 +1,314 @@
+package service
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pquerna/otp/totp"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/user/adapters"
+	"scaffold/internal/user/domain"
+)
+
+// fakeMFARepo 内存中的两步验证仓储 恢复码只保存摘要 与数据库实现一致
+type fakeMFARepo struct {
+	domain.UserMFARepository
+
+	mu            sync.Mutex
+	mfas          map[int64]domain.UserMFA
+	recoveryCodes map[int64]map[string]bool
+}
+
+func newFakeMFARepo() *fakeMFARepo {
+	return &fakeMFARepo{
+		mfas:          make(map[int64]domain.UserMFA),
+		recoveryCodes: make(map[int64]map[string]bool),
+	}
+}
+
+func (r *fakeMFARepo) FindByUserID(userID int64) (*domain.UserMFA, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	mfa, ok := r.mfas[userID]
+	if !ok {
+		return nil, codes.ErrMFANotEnrolled
+	}
+	return &mfa, nil
+}
+
+func (r *fakeMFARepo) Save(mfa *domain.UserMFA) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.mfas[mfa.UserID] = *mfa
+	return nil
+}
+
+func (r *fakeMFARepo) ReplaceRecoveryCodes(userID int64, codeHashes []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// 值表示是否已使用
+	r.recoveryCodes[userID] = make(map[string]bool, len(codeHashes))
+	for _, hash := range codeHashes {
+		r.recoveryCodes[userID][hash] = false
+	}
+	return nil
+}
+
+func (r *fakeMFARepo) UseRecoveryCode(userID int64, codeHash string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	used, ok := r.recoveryCodes[userID][codeHash]
+	if !ok || used {
+		return false, nil
+	}
+	r.recoveryCodes[userID][codeHash] = true
+	return true, nil
+}
+
+func (r *fakeMFARepo) CountRecoveryCodes(userID int64) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var remaining int64
+	for _, used := range r.recoveryCodes[userID] {
+		if !used {
+			remaining++
+		}
+	}
+	return remaining, nil
+}
+
+type mfaFixture struct {
+	service       *userService
+	tokenService  *fakeTokenService
+	userID        int64
+	secret        string
+	confirmCode   string
+	recoveryCodes []string
+}
+
+var mfaUserSeq atomic.Int64
+
+// newMFAFixture 完成TOTP绑定 TOTP验证码的重放记录保存在 Redis 中 每个用例使用不同的用户
+func newMFAFixture(t *testing.T) *mfaFixture {
+	t.Helper()
+
+	// 发行方名称通常由 NewUserService 从环境变量读取
+	mfaIssuer = "scaffold"
+
+	userID := 4000 + mfaUserSeq.Add(1)
+	f := &mfaFixture{
+		tokenService: &fakeTokenService{},
+		userID:       userID,
+	}
+	f.service = &userService{
+		userRepo:     newFakeUserRepo(&domain.User{ID: userID, Email: "mfa@example.com"}),
+		tokenService: f.tokenService,
+		mfaRepo:      newFakeMFARepo(),
+		mfaCache:     adapters.NewMFARedisCache(),
+	}
+
+	enrollment, err := f.service.EnrollTOTP(userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.secret = enrollment.Secret
+
+	f.confirmCode = f.totpCode(t, time.Now())
+	f.recoveryCodes, err = f.service.ConfirmTOTP(userID, f.confirmCode)
+	if err != nil {
+		t.Fatalf("确认TOTP绑定失败: %v", err)
+	}
+	if len(f.recoveryCodes) != domain.RecoveryCodeCount {
+		t.Fatalf("应生成 %d 个恢复码 实际为 %d", domain.RecoveryCodeCount, len(f.recoveryCodes))
+	}
+	return f
+}
+
+func (f *mfaFixture) totpCode(t *testing.T, at time.Time) string {
+	t.Helper()
+
+	code, err := totp.GenerateCode(f.secret, at)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return code
+}
+
+// pendingLogin 模拟第一因素校验通过 返回待验证令牌
+func (f *mfaFixture) pendingLogin(t *testing.T) string {
+	t.Helper()
+
+	result, err := f.service.beginLogin(f.userID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.MFARequired() {
+		t.Fatal("开启两步验证后登录应要求验证码")
+	}
+	return result.MFAToken
+}
+
+// wrongCode 与正确验证码只差最后一位
+func wrongCode(code string) string {
+	last := code[len(code)-1]
+	return code[:len(code)-1] + string('0'+(last-'0'+1)%10)
+}
+
+func TestVerifyMFAWithTOTP(t *testing.T) {
+	f := newMFAFixture(t)
+
+	// 允许一个周期的时间偏移 与确认绑定时使用的验证码不同
+	mfaToken := f.pendingLogin(t)
+	if _, err := f.service.VerifyMFA(mfaToken, f.totpCode(t, time.Now().Add(30*time.Second)), nil); err != nil {
+		t.Fatalf("两步验证失败: %v", err)
+	}
+	if len(f.tokenService.issued) != 1 || f.tokenService.issued[0] != f.userID {
+		t.Fatalf("应为用户 %d 签发会话 实际为 %v", f.userID, f.tokenService.issued)
+	}
+
+	// 待验证令牌只能换取一次正式令牌
+	_, err := f.service.VerifyMFA(mfaToken, f.totpCode(t, time.Now().Add(-30*time.Second)), nil)
+	assertErrCode(t, err, codes.ErrMFATokenInvalid)
+}
+
+func TestVerifyMFARejectsReplayedTOTPCode(t *testing.T) {
+	f := newMFAFixture(t)
+	code := f.totpCode(t, time.Now().Add(30*time.Second))
+
+	if _, err := f.service.VerifyMFA(f.pendingLogin(t), code, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// 截获的验证码在有效期内也不能再次使用
+	_, err := f.service.VerifyMFA(f.pendingLogin(t), code, nil)
+	assertErrCode(t, err, codes.ErrMFACodeInvalid)
+
+	// 确认绑定时使用过的验证码同样不能用于登录
+	_, err = f.service.VerifyMFA(f.pendingLogin(t), f.confirmCode, nil)
+	assertErrCode(t, err, codes.ErrMFACodeInvalid)
+
+	if len(f.tokenService.issued) != 1 {
+		t.Fatalf("重放的验证码不应签发会话 实际签发 %d 次", len(f.tokenService.issued))
+	}
+}
+
+func TestVerifyMFALimitsAttempts(t *testing.T) {
+	f := newMFAFixture(t)
+	mfaToken := f.pendingLogin(t)
+	code := f.totpCode(t, time.Now().Add(30*time.Second))
+
+	for i := 1; i < domain.MFAMaxAttempts; i++ {
+		_, err := f.service.VerifyMFA(mfaToken, wrongCode(code), nil)
+		assertErrCode(t, err, codes.ErrMFACodeInvalid)
+	}
+	_, err := f.service.VerifyMFA(mfaToken, wrongCode(code), nil)
+	assertErrCode(t, err, codes.ErrMFATooManyAttempts)
+
+	// 令牌已作废 正确的验证码也无法完成登录
+	_, err = f.service.VerifyMFA(mfaToken, code, nil)
+	assertErrCode(t, err, codes.ErrMFATokenInvalid)
+}
+
+func TestVerifyMFAWithRecoveryCode(t *testing.T) {
+	f := newMFAFixture(t)
+	recoveryCode := f.recoveryCodes[0]
+
+	// 输入时忽略大小写 空格与连字符
+	input := " " + strings.ToUpper(strings.ReplaceAll(recoveryCode, "-", " ")) + " "
+	if _, err := f.service.VerifyMFA(f.pendingLogin(t), input, nil); err != nil {
+		t.Fatalf("恢复码登录失败: %v", err)
+	}
+
+	// 恢复码只能使用一次
+	_, err := f.service.VerifyMFA(f.pendingLogin(t), recoveryCode, nil)
+	assertErrCode(t, err, codes.ErrMFACodeInvalid)
+
+	status, err := f.service.GetMFAStatus(f.userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !status.Enabled || status.RecoveryCodesRemaining != domain.RecoveryCodeCount-1 {
+		t.Fatalf("两步验证状态错误: %+v", status)
+	}
+}
+
+func TestRegenerateRecoveryCodesInvalidatesOldCodes(t *testing.T) {
+	f := newMFAFixture(t)
+	oldCode := f.recoveryCodes[1]
+
+	newCodes, err := f.service.RegenerateRecoveryCodes(f.userID, f.recoveryCodes[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = f.service.VerifyMFA(f.pendingLogin(t), oldCode, nil)
+	assertErrCode(t, err, codes.ErrMFACodeInvalid)
+
+	if _, err := f.service.VerifyMFA(f.pendingLogin(t), newCodes[0], nil); err != nil {
+		t.Fatalf("新恢复码登录失败: %v", err)
+	}
+
+	status, err := f.service.GetMFAStatus(f.userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.RecoveryCodesRemaining != domain.RecoveryCodeCount-1 {
+		t.Fatalf("重新生成后剩余恢复码应为 %d 实际为 %d", domain.RecoveryCodeCount-1, status.RecoveryCodesRemaining)
+	}
+}
+
+func TestVerifyMFALocksUserAcrossLogins(t *testing.T) {
+	f := newMFAFixture(t)
+	code := f.totpCode(t, time.Now().Add(30*time.Second))
+
+	// 每次重新登录都能获得新的待验证令牌 错误次数仍按用户累计
+	mfaToken := f.pendingLogin(t)
+	for i := 1; i < domain.MFAUserMaxFailures; i++ {
+		if i%(domain.MFAMaxAttempts-1) == 0 {
+			mfaToken = f.pendingLogin(t)
+		}
+		_, err := f.service.VerifyMFA(mfaToken, wrongCode(code), nil)
+		assertErrCode(t, err, codes.ErrMFACodeInvalid)
+	}
+	_, err := f.service.VerifyMFA(f.pendingLogin(t), wrongCode(code), nil)
+	assertErrCode(t, err, codes.ErrMFALocked)
+
+	// 锁定期间正确的验证码与恢复码同样被拒绝
+	_, err = f.service.VerifyMFA(f.pendingLogin(t), code, nil)
+	assertErrCode(t, err, codes.ErrMFALocked)
+	_, err = f.service.VerifyMFA(f.pendingLogin(t), f.recoveryCodes[0], nil)
+	assertErrCode(t, err, codes.ErrMFALocked)
+
+	err = f.service.DisableMFA(f.userID, code)
+	assertErrCode(t, err, codes.ErrMFALocked)
+
+	if len(f.tokenService.issued) != 0 {
+		t.Fatalf("锁定期间不应签发会话 实际签发 %d 次", len(f.tokenService.issued))
+	}
+}
+
+func TestVerifyMFASuccessResetsUserFailures(t *testing.T) {
+	f := newMFAFixture(t)
+	code := f.totpCode(t, time.Now().Add(30*time.Second))
+
+	for range domain.MFAUserMaxFailures - 1 {
+		_, err := f.service.VerifyMFA(f.pendingLogin(t), wrongCode(code), nil)
+		assertErrCode(t, err, codes.ErrMFACodeInvalid)
+	}
+	if _, err := f.service.VerifyMFA(f.pendingLogin(t), code, nil); err != nil {
+		t.Fatalf("未达到上限时应允许登录: %v", err)
+	}
+
+	// 成功后重新计数
+	_, err := f.service.VerifyMFA(f.pendingLogin(t), wrongCode(code), nil)
+	assertErrCode(t, err, codes.ErrMFACodeInvalid)
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"crypto/subtle"
+
+	"github.com/pkg/errors"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/utils"
+	"scaffold/internal/user/domain"
+)
+
+func (s *userService) GetOAuthAuthorization(provider string) (*domain.OAuthAuthorization, error) {
+	return s.newOAuthAuthorization(provider, domain.OAuthPurposeLogin, 0)
+}
+
+// GetOAuthLinkAuthorization 绑定流程的 state 记录发起绑定的用户 只能由该用户完成绑定
+func (s *userService) GetOAuthLinkAuthorization(userID int64, provider string) (*domain.OAuthAuthorization, error) {
+	return s.newOAuthAuthorization(provider, domain.OAuthPurposeLink, userID)
+}
+
+func (s *userService) newOAuthAuthorization(provider, purpose string, userID int64) (*domain.OAuthAuthorization, error) {
+	oauthProvider, err := s.oauthProviders.Get(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. 生成 state PKCE verifier 与绑定浏览器的 nonce
+	state, err := utils.GenRandomHexToken()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	codeVerifier, err := utils.GenRandomHexToken()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	nonce, err := utils.GenRandomHexToken()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// 2. 生成授权地址
+	authURL, err := oauthProvider.AuthCodeURL(state, utils.PKCECodeChallenge(codeVerifier))
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 保存 state 回调时校验
+	if err := s.oauthStateCache.SaveState(state, &domain.OAuthState{
+		Provider:     provider,
+		CodeVerifier: codeVerifier,
+		Nonce:        nonce,
+		Purpose:      purpose,
+		UserID:       userID,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &domain.OAuthAuthorization{
+		URL:   authURL,
+		State: state,
+		Nonce: nonce,
+	}, nil
+}
+
+// exchangeOAuthCode 消费 state 后使用授权码换取第三方用户信息
+// state 只能使用一次 必须由同一提供商 同一浏览器(nonce) 以相同用途发起 绑定时还须是同一用户
+// 防止登录 CSRF 以及将他人的第三方账号绑定到受害者名下
+func (s *userService) exchangeOAuthCode(provider, code, state, nonce string, expected *domain.OAuthState) (*domain.OAuthUserInfo, error) {
+	oauthProvider, err := s.oauthProviders.Get(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	oauthState, err := s.oauthStateCache.ConsumeState(state)
+	if err != nil {
+		return nil, err
+	}
+	if oauthState.Provider != provider ||
+		oauthState.Purpose != expected.Purpose ||
+		oauthState.UserID != expected.UserID {
+		return nil, codes.ErrOAuthStateInvalid
+	}
+	if nonce == "" || subtle.ConstantTimeCompare([]byte(oauthState.Nonce), []byte(nonce)) != 1 {
+		return nil, codes.ErrOAuthStateInvalid
+	}
+
+	return oauthProvider.Exchange(code, oauthState.CodeVerifier)
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/utils"
+	"scaffold/internal/user/domain"
+)
+
+func (s *userService) ListPasskeys(userID int64) ([]*domain.Passkey, error) {
+	return s.passkeyRepo.ListByUserID(userID)
+}
+
+func (s *userService) BeginPasskeyRegistration(userID int64) (*domain.PasskeyChallenge, error) {
+	passkeyUser, err := s.loadPasskeyUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(passkeyUser.Passkeys) >= domain.PasskeyMaxPerUser {
+		return nil, codes.ErrPasskeyLimitExceeded
+	}
+
+	options, session, err := s.webAuthn.BeginRegistration(passkeyUser)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.saveCeremony(options, &domain.PasskeyCeremony{
+		Type:    domain.PasskeyCeremonyRegistration,
+		UserID:  userID,
+		Session: session,
+	})
+}
+
+func (s *userService) FinishPasskeyRegistration(userID int64, ceremonyID, name string, response []byte) (*domain.Passkey, error) {
+	ceremony, err := s.passkeyCache.ConsumeCeremony(ceremonyID)
+	if err != nil {
+		return nil, err
+	}
+	// 仪式必须由当前用户发起
+	if ceremony.Type != domain.PasskeyCeremonyRegistration || ceremony.UserID != userID {
+		return nil, codes.ErrPasskeyCeremonyInvalid
+	}
+
+	passkeyUser, err := s.loadPasskeyUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(passkeyUser.Passkeys) >= domain.PasskeyMaxPerUser {
+		return nil, codes.ErrPasskeyLimitExceeded
+	}
+
+	passkey, err := s.webAuthn.FinishRegistration(passkeyUser, ceremony.Session, response)
+	if err != nil {
+		return nil, err
+	}
+	passkey.Name = name
+
+	return s.passkeyRepo.Create(passkey)
+}
+
+func (s *userService) DeletePasskey(userID, id int64) error {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return err
+	}
+
+	passkeys, err := s.passkeyRepo.ListByUserID(userID)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for _, passkey := range passkeys {
+		if passkey.ID == id {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return codes.ErrPasskeyNotFound
+	}
+
+	identities, err := s.identityRepo.ListByUserID(userID)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// 移除后至少保留一种登录方式
+	if countLoginMethods(user, identities, len(passkeys)) <= 1 {
+		return codes.ErrIdentityLastLoginMethod
+	}
+
+	return s.passkeyRepo.Delete(userID, id)
+}
+
+func (s *userService) BeginPasskeyLogin() (*domain.PasskeyChallenge, error) {
+	options, session, err := s.webAuthn.BeginLogin()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.saveCeremony(options, &domain.PasskeyCeremony{
+		Type:    domain.PasskeyCeremonyLogin,
+		Session: session,
+	})
+}
+
+// FinishPasskeyLogin 通行密钥本身同时校验持有与用户验证 登录时不再要求两步验证
+func (s *userService) FinishPasskeyLogin(ceremonyID string, response []byte, client *domain.ClientInfo) (*domain.User2Token, error) {
+	ceremony, err := s.passkeyCache.ConsumeCeremony(ceremonyID)
+	if err != nil {
+		return nil, err
+	}
+	if ceremony.Type != domain.PasskeyCeremonyLogin {
+		return nil, codes.ErrPasskeyCeremonyInvalid
+	}
+
+	assertion, err := s.webAuthn.FinishLogin(ceremony.Session, response, func(handle []byte) (*domain.PasskeyUser, error) {
+		userID, err := strconv.ParseInt(string(handle), 10, 64)
+		if err != nil {
+			return nil, codes.ErrPasskeyNotFound
+		}
+		return s.loadPasskeyUser(userID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 签名计数未递增 说明凭证可能已被复制 拒绝登录且不更新计数
+	if assertion.CloneWarning {
+		zap.L().Warn("通行密钥签名计数异常 可能存在被克隆的认证器",
+			zap.String("event", "passkey_clone_warning"),
+			zap.Int64("user_id", assertion.UserID),
+			zap.Int64("passkey_id", assertion.Passkey.ID),
+		)
+		return nil, codes.ErrPasskeyCloneDetected
+	}
+
+	passkey := assertion.Passkey
+	if err := s.passkeyRepo.UpdateUsage(passkey.ID, passkey.SignCount, passkey.BackupState, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := s.userRepo.UpdateLastLogin(assertion.UserID); err != nil {
+		zap.L().Error("更新用户最后登录时间失败", zap.Int64("user_id", assertion.UserID), zap.Error(err))
+	}
+
+	return s.tokenService.IssueSession(assertion.UserID, client)
+}
+
+// loadPasskeyUser 用户句柄使用用户ID 不包含邮箱等个人信息
+func (s *userService) loadPasskeyUser(userID int64) (*domain.PasskeyUser, error) {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	passkeys, err := s.passkeyRepo.ListByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.PasskeyUser{
+		ID:          user.ID,
+		Handle:      []byte(strconv.FormatInt(user.ID, 10)),
+		Name:        user.AccountName(),
+		DisplayName: user.Nickname,
+		Passkeys:    passkeys,
+	}, nil
+}
+
+func (s *userService) saveCeremony(options []byte, ceremony *domain.PasskeyCeremony) (*domain.PasskeyChallenge, error) {
+	ceremonyID, err := utils.GenRandomHexToken()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if err := s.passkeyCache.SaveCeremony(ceremonyID, ceremony); err != nil {
+		return nil, err
+	}
+
+	return &domain.PasskeyChallenge{
+		CeremonyID: ceremonyID,
+		Options:    options,
+	}, nil
+}
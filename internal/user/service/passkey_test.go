@@ -0,0 +1,494 @@
+package service
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/user/adapters"
+	"scaffold/internal/user/domain"
+)
+
+const (
+	testRPID   = "localhost"
+	testOrigin = "http://localhost:5173"
+)
+
+// softAuthenticator 软件实现的认证器 按 WebAuthn 规范构造注册与登录响应
+// 使用 none 证明与 ES256 密钥 签名计数由测试显式指定
+type softAuthenticator struct {
+	t            *testing.T
+	key          *ecdsa.PrivateKey
+	credentialID []byte
+	userHandle   []byte
+}
+
+func newSoftAuthenticator(t *testing.T) *softAuthenticator {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	credentialID := make([]byte, 32)
+	if _, err := rand.Read(credentialID); err != nil {
+		t.Fatal(err)
+	}
+	return &softAuthenticator{t: t, key: key, credentialID: credentialID}
+}
+
+// ceremonyOptions 注册与登录选项中测试需要的字段 challenge 与 user.id 均为 base64url
+type ceremonyOptions struct {
+	PublicKey struct {
+		Challenge string `json:"challenge"`
+		User      struct {
+			ID string `json:"id"`
+		} `json:"user"`
+	} `json:"publicKey"`
+}
+
+func (a *softAuthenticator) parseOptions(options []byte) ceremonyOptions {
+	a.t.Helper()
+
+	var parsed ceremonyOptions
+	if err := json.Unmarshal(options, &parsed); err != nil {
+		a.t.Fatal(err)
+	}
+	return parsed
+}
+
+func (a *softAuthenticator) clientData(ceremonyType, challenge string) []byte {
+	a.t.Helper()
+
+	data, err := json.Marshal(map[string]string{
+		"type":      ceremonyType,
+		"challenge": challenge,
+		"origin":    testOrigin,
+	})
+	if err != nil {
+		a.t.Fatal(err)
+	}
+	return data
+}
+
+// authData rpIdHash(32) | flags(1) | signCount(4) | attestedCredentialData
+func (a *softAuthenticator) authData(flags byte, signCount uint32, attested []byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(testRPID))
+
+	data := append([]byte{}, rpIDHash[:]...)
+	data = append(data, flags)
+	data = binary.BigEndian.AppendUint32(data, signCount)
+	return append(data, attested...)
+}
+
+// Register 响应 navigator.credentials.create 用户在场(UP)与用户验证(UV)均已完成
+func (a *softAuthenticator) Register(options []byte) []byte {
+	a.t.Helper()
+
+	parsed := a.parseOptions(options)
+	handle, err := base64.RawURLEncoding.DecodeString(parsed.PublicKey.User.ID)
+	if err != nil {
+		a.t.Fatal(err)
+	}
+	a.userHandle = handle
+
+	coseKey, err := webauthncbor.Marshal(map[int]any{
+		1:  2,  // kty: EC2
+		3:  -7, // alg: ES256
+		-1: 1,  // crv: P-256
+		-2: a.key.X.FillBytes(make([]byte, 32)),
+		-3: a.key.Y.FillBytes(make([]byte, 32)),
+	})
+	if err != nil {
+		a.t.Fatal(err)
+	}
+
+	attested := make([]byte, 16) // aaguid
+	attested = binary.BigEndian.AppendUint16(attested, uint16(len(a.credentialID)))
+	attested = append(attested, a.credentialID...)
+	attested = append(attested, coseKey...)
+
+	attestationObject, err := webauthncbor.Marshal(map[string]any{
+		"fmt":      "none",
+		"attStmt":  map[string]any{},
+		"authData": a.authData(0x45, 0, attested), // UP | UV | AT
+	})
+	if err != nil {
+		a.t.Fatal(err)
+	}
+
+	return a.marshalCredential(map[string]string{
+		"clientDataJSON":    b64(a.clientData("webauthn.create", parsed.PublicKey.Challenge)),
+		"attestationObject": b64(attestationObject),
+	})
+}
+
+// Login 响应 navigator.credentials.get 对 authData 与 clientDataJSON 的哈希签名
+func (a *softAuthenticator) Login(options []byte, signCount uint32) []byte {
+	a.t.Helper()
+
+	parsed := a.parseOptions(options)
+	clientData := a.clientData("webauthn.get", parsed.PublicKey.Challenge)
+	authData := a.authData(0x05, signCount, nil) // UP | UV
+
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		a.t.Fatal(err)
+	}
+
+	return a.marshalCredential(map[string]string{
+		"clientDataJSON":    b64(clientData),
+		"authenticatorData": b64(authData),
+		"signature":         b64(signature),
+		"userHandle":        b64(a.userHandle),
+	})
+}
+
+func (a *softAuthenticator) marshalCredential(response map[string]string) []byte {
+	a.t.Helper()
+
+	data, err := json.Marshal(map[string]any{
+		"id":       b64(a.credentialID),
+		"rawId":    b64(a.credentialID),
+		"type":     "public-key",
+		"response": response,
+	})
+	if err != nil {
+		a.t.Fatal(err)
+	}
+	return data
+}
+
+func b64(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// fakePasskeyRepo 读取时返回副本 与数据库一样只有 UpdateUsage 能修改签名计数
+type fakePasskeyRepo struct {
+	domain.PasskeyRepository
+
+	mu       sync.Mutex
+	passkeys []*domain.Passkey
+	nextID   int64
+}
+
+func (r *fakePasskeyRepo) ListByUserID(userID int64) ([]*domain.Passkey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var passkeys []*domain.Passkey
+	for _, passkey := range r.passkeys {
+		if passkey.UserID == userID {
+			copied := *passkey
+			passkeys = append(passkeys, &copied)
+		}
+	}
+	return passkeys, nil
+}
+
+func (r *fakePasskeyRepo) Create(passkey *domain.Passkey) (*domain.Passkey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, existing := range r.passkeys {
+		if string(existing.CredentialID) == string(passkey.CredentialID) {
+			return nil, codes.ErrPasskeyAlreadyRegistered
+		}
+	}
+
+	r.nextID++
+	created := *passkey
+	created.ID = r.nextID
+	created.CreatedAt = time.Now()
+	r.passkeys = append(r.passkeys, &created)
+
+	copied := created
+	return &copied, nil
+}
+
+func (r *fakePasskeyRepo) UpdateUsage(id int64, signCount uint32, backupState bool, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, passkey := range r.passkeys {
+		if passkey.ID == id {
+			passkey.SignCount = signCount
+			passkey.BackupState = backupState
+			passkey.LastUsedAt = at
+			return nil
+		}
+	}
+	return codes.ErrPasskeyNotFound
+}
+
+func (r *fakePasskeyRepo) get(id int64) *domain.Passkey {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, passkey := range r.passkeys {
+		if passkey.ID == id {
+			copied := *passkey
+			return &copied
+		}
+	}
+	return nil
+}
+
+// fakePasskeyCache 与 Redis 实现一致 仪式读取即删除
+type fakePasskeyCache struct {
+	mu         sync.Mutex
+	ceremonies map[string]*domain.PasskeyCeremony
+}
+
+func (c *fakePasskeyCache) SaveCeremony(ceremonyID string, ceremony *domain.PasskeyCeremony) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ceremonies[ceremonyID] = ceremony
+	return nil
+}
+
+func (c *fakePasskeyCache) ConsumeCeremony(ceremonyID string) (*domain.PasskeyCeremony, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ceremony, ok := c.ceremonies[ceremonyID]
+	if !ok {
+		return nil, codes.ErrPasskeyCeremonyInvalid
+	}
+	delete(c.ceremonies, ceremonyID)
+	return ceremony, nil
+}
+
+type passkeyFixture struct {
+	service      *userService
+	passkeyRepo  *fakePasskeyRepo
+	tokenService *fakeTokenService
+}
+
+const (
+	passkeyUserID  int64 = 1001
+	passkeyOtherID int64 = 1002
+)
+
+func newPasskeyFixture() *passkeyFixture {
+	f := &passkeyFixture{
+		passkeyRepo:  &fakePasskeyRepo{},
+		tokenService: &fakeTokenService{},
+	}
+	f.service = &userService{
+		userRepo: newFakeUserRepo(
+			&domain.User{ID: passkeyUserID, Email: "alice@example.com", Nickname: "alice"},
+			&domain.User{ID: passkeyOtherID, Email: "bob@example.com", Nickname: "bob"},
+		),
+		tokenService: f.tokenService,
+		passkeyRepo:  f.passkeyRepo,
+		passkeyCache: &fakePasskeyCache{ceremonies: make(map[string]*domain.PasskeyCeremony)},
+		webAuthn:     adapters.NewWebAuthnProvider(),
+	}
+	return f
+}
+
+// register 完成一次注册仪式 返回保存的通行密钥
+func (f *passkeyFixture) register(t *testing.T, authenticator *softAuthenticator) *domain.Passkey {
+	t.Helper()
+
+	challenge, err := f.service.BeginPasskeyRegistration(passkeyUserID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	passkey, err := f.service.FinishPasskeyRegistration(passkeyUserID, challenge.CeremonyID, "laptop", authenticator.Register(challenge.Options))
+	if err != nil {
+		t.Fatalf("注册通行密钥失败: %v", err)
+	}
+	return passkey
+}
+
+func (f *passkeyFixture) login(t *testing.T, authenticator *softAuthenticator, signCount uint32) (*domain.User2Token, error) {
+	t.Helper()
+
+	challenge, err := f.service.BeginPasskeyLogin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f.service.FinishPasskeyLogin(challenge.CeremonyID, authenticator.Login(challenge.Options, signCount), nil)
+}
+
+func TestFinishPasskeyRegistration(t *testing.T) {
+	f := newPasskeyFixture()
+	authenticator := newSoftAuthenticator(t)
+
+	passkey := f.register(t, authenticator)
+
+	if passkey.UserID != passkeyUserID || passkey.Name != "laptop" {
+		t.Fatalf("通行密钥归属或名称错误: %+v", passkey)
+	}
+	if string(passkey.CredentialID) != string(authenticator.credentialID) {
+		t.Fatal("保存的凭证ID与认证器不一致")
+	}
+	if string(authenticator.userHandle) != "1001" {
+		t.Fatalf("用户句柄应为用户ID 实际为 %q", authenticator.userHandle)
+	}
+}
+
+func TestFinishPasskeyRegistrationRejectsOtherUsersCeremony(t *testing.T) {
+	f := newPasskeyFixture()
+	authenticator := newSoftAuthenticator(t)
+
+	challenge, err := f.service.BeginPasskeyRegistration(passkeyUserID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response := authenticator.Register(challenge.Options)
+
+	_, err = f.service.FinishPasskeyRegistration(passkeyOtherID, challenge.CeremonyID, "laptop", response)
+	assertErrCode(t, err, codes.ErrPasskeyCeremonyInvalid)
+
+	// 仪式已被消耗 发起者本人也不能再使用
+	_, err = f.service.FinishPasskeyRegistration(passkeyUserID, challenge.CeremonyID, "laptop", response)
+	assertErrCode(t, err, codes.ErrPasskeyCeremonyInvalid)
+
+	if passkeys, _ := f.passkeyRepo.ListByUserID(passkeyOtherID); len(passkeys) != 0 {
+		t.Fatal("不应为其他用户保存通行密钥")
+	}
+}
+
+func TestFinishPasskeyRegistrationRejectsReusedCeremony(t *testing.T) {
+	f := newPasskeyFixture()
+	authenticator := newSoftAuthenticator(t)
+
+	challenge, err := f.service.BeginPasskeyRegistration(passkeyUserID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response := authenticator.Register(challenge.Options)
+
+	if _, err := f.service.FinishPasskeyRegistration(passkeyUserID, challenge.CeremonyID, "laptop", response); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = f.service.FinishPasskeyRegistration(passkeyUserID, challenge.CeremonyID, "laptop", response)
+	assertErrCode(t, err, codes.ErrPasskeyCeremonyInvalid)
+}
+
+func TestFinishPasskeyRegistrationRejectsLoginCeremony(t *testing.T) {
+	f := newPasskeyFixture()
+	authenticator := newSoftAuthenticator(t)
+
+	challenge, err := f.service.BeginPasskeyLogin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = f.service.FinishPasskeyRegistration(passkeyUserID, challenge.CeremonyID, "laptop", authenticator.Register(challenge.Options))
+	assertErrCode(t, err, codes.ErrPasskeyCeremonyInvalid)
+}
+
+func TestFinishPasskeyLogin(t *testing.T) {
+	f := newPasskeyFixture()
+	authenticator := newSoftAuthenticator(t)
+	passkey := f.register(t, authenticator)
+
+	if _, err := f.login(t, authenticator, 1); err != nil {
+		t.Fatalf("通行密钥登录失败: %v", err)
+	}
+
+	if len(f.tokenService.issued) != 1 || f.tokenService.issued[0] != passkeyUserID {
+		t.Fatalf("应为用户 %d 签发会话 实际为 %v", passkeyUserID, f.tokenService.issued)
+	}
+	stored := f.passkeyRepo.get(passkey.ID)
+	if stored.SignCount != 1 || stored.LastUsedAt.IsZero() {
+		t.Fatalf("登录后应更新签名计数与使用时间: %+v", stored)
+	}
+}
+
+func TestFinishPasskeyLoginRejectsReusedCeremony(t *testing.T) {
+	f := newPasskeyFixture()
+	authenticator := newSoftAuthenticator(t)
+	f.register(t, authenticator)
+
+	challenge, err := f.service.BeginPasskeyLogin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	response := authenticator.Login(challenge.Options, 1)
+
+	if _, err := f.service.FinishPasskeyLogin(challenge.CeremonyID, response, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// 截获的断言不能重放
+	_, err = f.service.FinishPasskeyLogin(challenge.CeremonyID, response, nil)
+	assertErrCode(t, err, codes.ErrPasskeyCeremonyInvalid)
+
+	if len(f.tokenService.issued) != 1 {
+		t.Fatalf("重放的断言不应签发会话 实际签发 %d 次", len(f.tokenService.issued))
+	}
+}
+
+func TestFinishPasskeyLoginRejectsRegistrationCeremony(t *testing.T) {
+	f := newPasskeyFixture()
+	authenticator := newSoftAuthenticator(t)
+	f.register(t, authenticator)
+
+	challenge, err := f.service.BeginPasskeyRegistration(passkeyUserID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = f.service.FinishPasskeyLogin(challenge.CeremonyID, authenticator.Login(challenge.Options, 1), nil)
+	assertErrCode(t, err, codes.ErrPasskeyCeremonyInvalid)
+}
+
+func TestFinishPasskeyLoginRejectsCloneWarning(t *testing.T) {
+	f := newPasskeyFixture()
+	authenticator := newSoftAuthenticator(t)
+	passkey := f.register(t, authenticator)
+
+	if _, err := f.login(t, authenticator, 5); err != nil {
+		t.Fatal(err)
+	}
+
+	// 签名计数未递增 说明另一份认证器副本已使用过该凭证
+	for _, signCount := range []uint32{5, 3} {
+		_, err := f.login(t, authenticator, signCount)
+		assertErrCode(t, err, codes.ErrPasskeyCloneDetected)
+	}
+
+	if stored := f.passkeyRepo.get(passkey.ID); stored.SignCount != 5 {
+		t.Fatalf("疑似克隆时不应更新签名计数 实际为 %d", stored.SignCount)
+	}
+	if len(f.tokenService.issued) != 1 {
+		t.Fatalf("疑似克隆时不应签发会话 实际签发 %d 次", len(f.tokenService.issued))
+	}
+
+	if _, err := f.login(t, authenticator, 6); err != nil {
+		t.Fatalf("签名计数递增后应能正常登录: %v", err)
+	}
+}
+
+func TestFinishPasskeyLoginRejectsTamperedSignature(t *testing.T) {
+	f := newPasskeyFixture()
+	authenticator := newSoftAuthenticator(t)
+	f.register(t, authenticator)
+
+	// 另一把密钥冒用同一凭证ID与用户句柄
+	impostor := newSoftAuthenticator(t)
+	impostor.credentialID = authenticator.credentialID
+	impostor.userHandle = authenticator.userHandle
+
+	_, err := f.login(t, impostor, 1)
+	assertErrCode(t, err, codes.ErrPasskeyVerifyFailed)
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/utils"
+	"scaffold/internal/user/domain"
+)
+
+// ForgotPassword 无论邮箱是否存在都返回成功 避免被用于探测已注册邮箱
+func (s *userService) ForgotPassword(email string) error {
+	user, err := s.userRepo.FindByEmail(normalizeEmail(email))
+	if err != nil {
+		if errors.Is(err, codes.ErrUserNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	// 异步发送 使响应耗时与邮箱是否存在无关
+	go func() {
+		if err := s.sendPasswordResetEmail(user); err != nil {
+			zap.L().Error("发送密码重置邮件失败", zap.Int64("user_id", user.ID), zap.Error(err))
+		}
+	}()
+
+	return nil
+}
+
+func (s *userService) ResetPassword(token, newPassword string) error {
+	ticket, err := s.passwordResetCache.ConsumeToken(token)
+	if err != nil {
+		return err
+	}
+
+	user, err := s.userRepo.FindByID(ticket.UserID)
+	if err != nil {
+		return err
+	}
+
+	// 发送之后邮箱已变更 旧链接作废
+	if user.Email != ticket.Email {
+		return codes.ErrPasswordResetTokenInvalid
+	}
+
+	// 能收到重置邮件即证明拥有该邮箱 与无密码登录一样认领未验证的账号
+	if err := s.confirmEmailOwner(user); err != nil {
+		return err
+	}
+
+	hash, err := utils.EncryptPassword(newPassword)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := s.userRepo.UpdatePassword(user.ID, string(hash)); err != nil {
+		return err
+	}
+
+	// 密码已重置 吊销所有设备上的登录状态
+	return s.tokenService.RemoveUserSessions(user.ID)
+}
+
+func (s *userService) sendPasswordResetEmail(user *domain.User) error {
+	token, err := utils.GenRandomHexToken()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	ticket := &domain.PasswordResetTicket{
+		UserID: user.ID,
+		Email:  user.Email,
+	}
+	if err := s.passwordResetCache.SaveToken(token, ticket); err != nil {
+		return err
+	}
+
+	link, err := buildLink(passwordResetURL, token)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := s.mailer.SendPasswordReset(user.Email, user.Nickname, link); err != nil {
+		return errors.WithStack(codes.ErrEmailSendFailed.WithCause(err))
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/user/domain"
+)
+
+// fakePasswordResetCache 内存中的重置令牌 消费后即失效
+type fakePasswordResetCache struct {
+	tickets map[string]*domain.PasswordResetTicket
+}
+
+func (c *fakePasswordResetCache) SaveToken(token string, ticket *domain.PasswordResetTicket) error {
+	c.tickets[token] = ticket
+	return nil
+}
+
+func (c *fakePasswordResetCache) ConsumeToken(token string) (*domain.PasswordResetTicket, error) {
+	ticket, ok := c.tickets[token]
+	if !ok {
+		return nil, codes.ErrPasswordResetTokenInvalid
+	}
+	delete(c.tickets, token)
+	return ticket, nil
+}
+
+func newPasswordResetService(user *domain.User, identities ...*domain.UserIdentity) (*userService, *fakeUserRepo, *fakeTokenService) {
+	userRepo := newFakeUserRepo(user)
+	tokenService := &fakeTokenService{}
+	cache := &fakePasswordResetCache{tickets: map[string]*domain.PasswordResetTicket{
+		"token": {UserID: user.ID, Email: user.Email},
+	}}
+	return &userService{
+		userRepo:           userRepo,
+		identityRepo:       &fakeIdentityRepo{identities: identities},
+		tokenService:       tokenService,
+		passwordResetCache: cache,
+	}, userRepo, tokenService
+}
+
+func TestResetPasswordClaimsUnverifiedAccount(t *testing.T) {
+	// 他人抢先用该邮箱注册并绑定了自己的手机号
+	squatter := &domain.User{
+		ID:              1,
+		Email:           "victim@example.com",
+		PasswordHash:    "squatter-hash",
+		Phone:           "+8613800000000",
+		PhoneVerifiedAt: time.Now(),
+	}
+	service, userRepo, tokenService := newPasswordResetService(squatter)
+
+	if err := service.ResetPassword("token", "new-password"); err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(userRepo.claimed, []int64{squatter.ID}) {
+		t.Fatalf("未验证的账号应被认领: %v", userRepo.claimed)
+	}
+	user, _ := userRepo.FindByID(squatter.ID)
+	if user.Phone != "" || user.IsPhoneVerified() || !user.IsEmailVerified() {
+		t.Fatalf("重置后应清除手机号并标记邮箱已验证: %+v", user)
+	}
+	if !user.HasPassword() || user.PasswordHash == "squatter-hash" {
+		t.Fatal("重置后应只保留新密码")
+	}
+	if !slices.Contains(tokenService.revoked, squatter.ID) {
+		t.Fatal("重置后应吊销全部会话")
+	}
+}
+
+func TestResetPasswordMarksIdentityProvenAccountVerified(t *testing.T) {
+	owner := &domain.User{ID: 1, Email: "owner@example.com"}
+	service, userRepo, _ := newPasswordResetService(owner, &domain.UserIdentity{
+		ID:       1,
+		UserID:   owner.ID,
+		Provider: domain.OAuthProviderGithub,
+		Subject:  "owner",
+		Email:    "owner@example.com",
+	})
+
+	if err := service.ResetPassword("token", "new-password"); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(userRepo.claimed) != 0 {
+		t.Fatal("邮箱已由第三方身份证明的账号不应被清除凭证")
+	}
+	if user, _ := userRepo.FindByID(owner.ID); !user.IsEmailVerified() {
+		t.Fatal("重置后应标记邮箱已验证")
+	}
+}
+
+func TestResetPasswordRejectsChangedEmail(t *testing.T) {
+	owner := &domain.User{ID: 1, Email: "owner@example.com"}
+	service, userRepo, _ := newPasswordResetService(owner)
+	userRepo.users[owner.ID].Email = "new@example.com"
+
+	assertErrCode(t, service.ResetPassword("token", "new-password"), codes.ErrPasswordResetTokenInvalid)
+	if len(userRepo.claimed) != 0 {
+		t.Fatal("令牌作废时不应认领账号")
+	}
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/utils"
+	"scaffold/internal/user/domain"
+)
+
+// maxAvatarLength 与 users.avatar 字段长度一致
+const maxAvatarLength = 500
+
+func (s *userService) UpdateProfile(userID int64, update *domain.ProfileUpdate) (*domain.User, error) {
+	if update.Nickname != nil {
+		nickname := strings.TrimSpace(*update.Nickname)
+		if nickname == "" {
+			return nil, codes.ErrNicknameInvalid
+		}
+		update.Nickname = &nickname
+	}
+
+	if err := s.userRepo.UpdateProfile(userID, update); err != nil {
+		return nil, err
+	}
+	return s.userRepo.FindByID(userID)
+}
+
+// UploadAvatar 按文件内容识别类型 不信任客户端声明的 Content-Type
+func (s *userService) UploadAvatar(userID int64, data []byte) (*domain.User, error) {
+	if len(data) > domain.AvatarMaxSize {
+		return nil, codes.ErrAvatarTooLarge
+	}
+
+	contentType := http.DetectContentType(data)
+	if _, ok := domain.AvatarContentTypes[contentType]; !ok {
+		return nil, codes.ErrAvatarTypeInvalid
+	}
+
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := s.avatarStorage.SaveAvatar(userID, data, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.userRepo.UpdateAvatar(userID, url); err != nil {
+		_ = s.avatarStorage.RemoveAvatar(url)
+		return nil, err
+	}
+
+	// 旧头像删除失败不影响本次更新
+	if user.Avatar != "" {
+		if err := s.avatarStorage.RemoveAvatar(user.Avatar); err != nil {
+			zap.L().Error("删除旧头像失败", zap.Int64("user_id", userID), zap.Error(err))
+		}
+	}
+
+	user.Avatar = url
+	return user, nil
+}
+
+// RequestEmailChange 向新邮箱发送确认链接 确认后才会更换
+// 设置过密码的用户需验证当前密码 防止会话被盗用后账号被转移
+func (s *userService) RequestEmailChange(userID int64, email, password string) error {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return err
+	}
+
+	email = normalizeEmail(email)
+	if email == user.Email {
+		return codes.ErrEmailUnchanged
+	}
+
+	if user.HasPassword() && !utils.ComparePassword(user.PasswordHash, password) {
+		return codes.ErrPasswordIncorrect
+	}
+
+	exists, err := s.userRepo.EmailExists(email)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if exists {
+		return codes.ErrEmailAlreadyExists
+	}
+
+	ok, retryAfter, err := s.emailChangeCache.AcquireCooldown(userID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return codes.ErrEmailChangeTooFrequent.WithDetail(map[string]any{
+			"retry_after": int(retryAfter.Seconds()),
+		})
+	}
+
+	token, err := utils.GenRandomHexToken()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := s.emailChangeCache.SaveToken(token, &domain.EmailChangeTicket{
+		UserID:   userID,
+		OldEmail: user.Email,
+		NewEmail: email,
+	}); err != nil {
+		return err
+	}
+
+	link, err := buildLink(emailChangeURL, token)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := s.mailer.SendEmailChange(email, user.Nickname, link); err != nil {
+		return errors.WithStack(codes.ErrEmailSendFailed.WithCause(err))
+	}
+	return nil
+}
+
+// ConfirmEmailChange 能打开链接即证明拥有新邮箱 更换后直接标记为已验证
+func (s *userService) ConfirmEmailChange(token string) error {
+	ticket, err := s.emailChangeCache.ConsumeToken(token)
+	if err != nil {
+		return err
+	}
+
+	user, err := s.userRepo.FindByID(ticket.UserID)
+	if err != nil {
+		return err
+	}
+
+	// 发起之后邮箱已变更 旧链接作废
+	if user.Email != ticket.OldEmail {
+		return codes.ErrEmailChangeTokenInvalid
+	}
+
+	// 发起之后新邮箱可能已被其他账号注册
+	exists, err := s.userRepo.EmailExists(ticket.NewEmail)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if exists {
+		return codes.ErrEmailAlreadyExists
+	}
+
+	return s.userRepo.UpdateEmail(user.ID, ticket.NewEmail)
+}
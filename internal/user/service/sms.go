@@ -0,0 +1,133 @@
+package service
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/common/utils"
+	"scaffold/internal/user/domain"
+)
+
+// RequestSmsCode 手机号未注册时同样发送 首次登录时自动创建账号
+func (s *userService) RequestSmsCode(phone string, client *domain.ClientInfo) error {
+	// 1. 限制单个IP与单个手机号的发送频率 防止被用于短信轰炸
+	if client != nil && client.IP != "" {
+		count, err := s.smsCodeCache.IncrIPRequests(client.IP)
+		if err != nil {
+			return err
+		}
+		if count > domain.SmsMaxPerIP {
+			return codes.ErrSmsTooFrequent
+		}
+	}
+
+	ok, retryAfter, err := s.smsCodeCache.AcquireCooldown(phone)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return codes.ErrSmsTooFrequent.WithDetail(map[string]any{
+			"retry_after": int(retryAfter.Seconds()),
+		})
+	}
+
+	count, err := s.smsCodeCache.IncrPhoneRequests(phone)
+	if err != nil {
+		return err
+	}
+	if count > domain.SmsMaxPerPhone {
+		return codes.ErrSmsTooFrequent
+	}
+
+	// 2. 生成验证码 新验证码覆盖之前发送的验证码
+	code, err := genNumericCode(domain.SmsCodeLen)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := s.smsCodeCache.SaveCode(phone, utils.HashToken(code)); err != nil {
+		return err
+	}
+
+	// 3. 发送短信
+	content := fmt.Sprintf("您的登录验证码为%s，%d分钟内有效。如非本人操作请忽略。", code, int(domain.SmsCodeExpire.Minutes()))
+	if err := s.smsSender.Send(phone, content); err != nil {
+		return errors.WithStack(codes.ErrSmsSendFailed.WithCause(err))
+	}
+	return nil
+}
+
+func (s *userService) VerifySmsCode(phone, code string, client *domain.ClientInfo) (*domain.LoginResult, error) {
+	savedHash, err := s.smsCodeCache.GetCode(phone)
+	if err != nil {
+		return nil, err
+	}
+
+	codeHash := utils.HashToken(strings.TrimSpace(code))
+	if subtle.ConstantTimeCompare([]byte(savedHash), []byte(codeHash)) != 1 {
+		// 错误次数达到上限后作废验证码 防止暴力枚举
+		attempts, err := s.smsCodeCache.IncrAttempts(phone)
+		if err != nil {
+			return nil, err
+		}
+		if attempts >= domain.SmsCodeMaxAttempts {
+			_ = s.smsCodeCache.RemoveCode(phone)
+			return nil, codes.ErrSmsCodeTooManyAttempts
+		}
+		return nil, codes.ErrSmsCodeInvalid
+	}
+
+	if err := s.smsCodeCache.RemoveCode(phone); err != nil {
+		return nil, err
+	}
+
+	return s.loginByPhone(phone, client)
+}
+
+// loginByPhone 手机号所有权已确认 查找或创建用户
+func (s *userService) loginByPhone(phone string, client *domain.ClientInfo) (*domain.LoginResult, error) {
+	user, err := s.userRepo.FindByPhone(phone)
+	switch {
+	case err == nil:
+		if err := s.userRepo.UpdateLastLogin(user.ID); err != nil {
+			zap.L().Error("更新用户最后登录时间失败", zap.Int64("user_id", user.ID), zap.Error(err))
+		}
+	case errors.Is(err, codes.ErrUserNotFound):
+		if user, err = s.createUserFromPhone(phone); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.WithStack(err)
+	}
+
+	return s.beginLogin(user.ID, client)
+}
+
+func (s *userService) createUserFromPhone(phone string) (*domain.User, error) {
+	now := time.Now()
+
+	user, err := s.userRepo.Create(&domain.User{
+		Phone:           phone,
+		Nickname:        maskPhone(phone),
+		PhoneVerifiedAt: now,
+		LastLoginAt:     now,
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return user, nil
+}
+
+// maskPhone 默认昵称隐藏手机号中间四位
+func maskPhone(phone string) string {
+	if len(phone) != 11 {
+		return phone
+	}
+	return phone[:3] + "****" + phone[7:]
+}
@@ -2,43 +2,79 @@ package service
 
 import (
 	"scaffold/internal/common/jwt"
+	"scaffold/internal/common/reskit/codes"
 	"scaffold/internal/common/utils"
 	"scaffold/internal/user/domain"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
+	"go.uber.org/zap"
 )
 
 var (
-	secret string
-	expire time.Duration
+	keyring  *jwt.Keyring
+	expire   time.Duration
+	issuer   string
+	audience []string
 )
 
 func init() {
-	secret = utils.GetEnv("JWT_SECRET")
+	keyring = jwt.DefaultKeyring()
 	expireMinute := utils.GetEnvAsInt("JWT_EXPIRE_MINUTE")
 	expire = time.Minute * time.Duration(expireMinute)
+
+	issuer = utils.GetEnv("JWT_ISSUER")
+	// 第一个受众为本服务自身 校验时要求令牌包含该受众
+	for _, aud := range strings.Split(utils.GetEnvWithDefault("JWT_AUDIENCE", ""), ",") {
+		if aud = strings.TrimSpace(aud); aud != "" {
+			audience = append(audience, aud)
+		}
+	}
+}
+
+// verifyOptions 解析时校验 iss 与 aud
+func verifyOptions() []jwt.Option {
+	return []jwt.Option{jwt.WithIssuer(issuer), jwt.WithAudience(audience...)}
 }
 
 type tokenService struct {
-	tokenCache domain.TokenCache
-	userRepo   domain.UserRepository
+	tokenCache     domain.TokenCache
+	denylist       domain.AccessTokenDenylist
+	userRepo       domain.UserRepository
+	claimsProvider domain.TokenClaimsProvider
 }
 
-func NewTokenService(tokenCache domain.TokenCache, userRepo domain.UserRepository) domain.TokenService {
+func NewTokenService(
+	tokenCache domain.TokenCache,
+	denylist domain.AccessTokenDenylist,
+	userRepo domain.UserRepository,
+	claimsProvider domain.TokenClaimsProvider,
+) domain.TokenService {
 	return &tokenService{
-		tokenCache: tokenCache,
-		userRepo:   userRepo,
+		tokenCache:     tokenCache,
+		denylist:       denylist,
+		userRepo:       userRepo,
+		claimsProvider: claimsProvider,
 	}
 }
 
 func (t *tokenService) GenerateAccessToken(payload *domain.JwtPayload) (string, error) {
-	token, err := jwt.GenToken[domain.JwtPayload](payload, secret, expire)
+	return t.generateAccessToken(payload, expire)
+}
+
+func (t *tokenService) generateAccessToken(payload *domain.JwtPayload, duration time.Duration) (string, error) {
+	token, err := jwt.GenToken[domain.JwtPayload](payload, keyring, duration,
+		jwt.WithIssuer(issuer),
+		jwt.WithAudience(audience...),
+		jwt.WithSubject(strconv.FormatInt(payload.UserID, 10)),
+	)
 	return token, errors.WithStack(err)
 }
 
 func (t *tokenService) ValidateAccessToken(token string) (isExpire bool, err error) {
-	_, err = jwt.ParseToken[domain.JwtPayload](token, secret)
+	_, err = jwt.ParseToken[domain.JwtPayload](token, keyring, verifyOptions()...)
 	if err != nil {
 		switch {
 		case errors.Is(err, jwt.ErrTokenExpired):
@@ -52,7 +88,7 @@ func (t *tokenService) ValidateAccessToken(token string) (isExpire bool, err err
 }
 
 func (t *tokenService) ParseAccessToken(token string) (payload *domain.JwtPayload, err error) {
-	claims, err := jwt.ParseToken[domain.JwtPayload](token, secret)
+	claims, err := jwt.ParseToken[domain.JwtPayload](token, keyring, verifyOptions()...)
 	if err != nil {
 		return nil, err
 	}
@@ -60,29 +96,276 @@ func (t *tokenService) ParseAccessToken(token string) (payload *domain.JwtPayloa
 	return claims.PayLoad, nil
 }
 
-func (t *tokenService) RefreshAccessToken(refreshToken string) (string, error) {
-	payload, err := t.tokenCache.ValidateRefreshToken(refreshToken)
+// VerifyAccessToken 校验签名与有效期 并检查令牌是否已被吊销
+func (t *tokenService) VerifyAccessToken(token string) (*domain.AccessTokenClaims, error) {
+	claims, err := t.parseAccessTokenClaims(token)
+	if err != nil {
+		return nil, err
+	}
+
+	denied, err := t.denylist.IsDenied(claims)
+	if err != nil {
+		return nil, err
+	}
+	if denied {
+		return nil, codes.ErrTokenRevoked
+	}
+
+	return claims, nil
+}
+
+// RevokeAccessToken 吊销单个access token 名单条目存活到令牌过期
+func (t *tokenService) RevokeAccessToken(token string) error {
+	claims, err := t.parseAccessTokenClaims(token)
+	if err != nil {
+		// 已过期或无效的令牌无需吊销
+		return nil
+	}
+
+	ttl := time.Until(claims.ExpiresAt)
+	if ttl <= 0 || claims.ID == "" {
+		return nil
+	}
+	return t.denylist.DenyToken(claims.ID, ttl)
+}
+
+func (t *tokenService) parseAccessTokenClaims(token string) (*domain.AccessTokenClaims, error) {
+	claims, err := jwt.ParseToken[domain.JwtPayload](token, keyring, verifyOptions()...)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, codes.ErrTokenExpired
+		}
+		return nil, codes.ErrTokenInvalid
+	}
+	if claims.PayLoad == nil || claims.IssuedAt == nil || claims.ExpiresAt == nil {
+		return nil, codes.ErrTokenInvalid
+	}
+
+	// sub 与业务声明中的用户必须一致
+	if claims.Subject != strconv.FormatInt(claims.PayLoad.UserID, 10) {
+		return nil, codes.ErrTokenInvalid
+	}
+
+	var actorID int64
+	if act := claims.PayLoad.Act; act != nil {
+		if act.UserID == 0 || act.Subject != strconv.FormatInt(act.UserID, 10) {
+			return nil, codes.ErrTokenInvalid
+		}
+		actorID = act.UserID
+	}
+
+	return &domain.AccessTokenClaims{
+		ID:        claims.ID,
+		Issuer:    claims.Issuer,
+		Subject:   claims.Subject,
+		Audience:  claims.Audience,
+		UserID:    claims.PayLoad.UserID,
+		SessionID: claims.PayLoad.SessionID,
+		Roles:     claims.PayLoad.Roles,
+		Scopes:    claims.PayLoad.Scopes,
+		IssuedAt:  claims.IssuedAt.Time,
+		ExpiresAt: claims.ExpiresAt.Time,
+		ActorID:   actorID,
+	}, nil
+}
+
+// IssueSession 所有登录方式最终都经由此处签发令牌 在此统一拒绝已禁用的用户
+func (t *tokenService) IssueSession(userID int64, client *domain.ClientInfo) (*domain.User2Token, error) {
+	if err := t.ensureUserActive(userID); err != nil {
+		return nil, err
+	}
+
+	sessionID, err := utils.GenRandomHex(16)
 	if err != nil {
-		return "", err
+		return nil, errors.WithStack(err)
 	}
 
-	// 为后续扩展jwt携带的相应user字段保留空间
-	user, err := t.userRepo.FindByID(payload.UserID)
+	now := time.Now()
+	session := &domain.Session{
+		ID:            sessionID,
+		UserID:        userID,
+		CreatedAt:     now,
+		LastRefreshAt: now,
+	}
+	applyClientInfo(session, client)
+
+	return t.issueTokens(session, "")
+}
+
+func (t *tokenService) RotateSession(refreshToken string, client *domain.ClientInfo) (*domain.User2Token, error) {
+	// 1. 认领refresh token 认领即标记为已轮换 同一令牌只能成功轮换一次
+	record, err := t.tokenCache.ClaimRefreshToken(refreshToken)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	// 2. 已轮换的令牌再次出现 说明令牌泄露 吊销整个令牌族
+	if record.Rotated {
+		t.revokeTokenFamily(record, client)
+		return nil, codes.ErrRefreshTokenReused
+	}
+
+	session, err := t.tokenCache.GetSession(record.SessionID)
+	if err != nil {
+		if errors.Is(err, codes.ErrSessionNotFound) {
+			return nil, codes.ErrRefreshTokenNotFound
+		}
+		return nil, err
 	}
 
-	newPayload := &domain.JwtPayload{
-		UserID: user.ID,
+	// 用户可能已被删除或禁用 刷新时重新确认
+	if err := t.ensureUserActive(session.UserID); err != nil {
+		return nil, err
 	}
 
-	return t.GenerateAccessToken(newPayload)
+	// 3. 签发新令牌并更新会话 新令牌记录其父令牌
+	session.LastRefreshAt = time.Now()
+	applyClientInfo(session, client)
+
+	return t.issueTokens(session, refreshToken)
 }
 
-func (t *tokenService) GenerateRefreshToken(payload *domain.JwtPayload) (string, error) {
-	return t.tokenCache.GenRefreshToken(payload)
+func (t *tokenService) ensureUserActive(userID int64) error {
+	user, err := t.userRepo.FindByID(userID)
+	if err != nil {
+		return err
+	}
+	if user.IsDisabled() {
+		return codes.ErrUserDisabled
+	}
+	return nil
 }
 
-func (t *tokenService) RemoveRefreshToken(refreshToken string) error {
-	return t.tokenCache.RemoveRefreshToken(refreshToken)
+// IssueImpersonationToken 角色与权限范围取被模拟用户的 不签发refresh token 过期后需重新申请
+// 没有会话ID 被模拟用户退出全部设备或被禁用时同样失效
+func (t *tokenService) IssueImpersonationToken(userID, actorID int64) (string, time.Time, error) {
+	if err := t.ensureUserActive(userID); err != nil {
+		return "", time.Time{}, err
+	}
+
+	payload := &domain.JwtPayload{
+		UserID: userID,
+		Act: &domain.Actor{
+			Subject: strconv.FormatInt(actorID, 10),
+			UserID:  actorID,
+		},
+	}
+	if err := t.claimsProvider.FillClaims(payload); err != nil {
+		return "", time.Time{}, err
+	}
+
+	expiresAt := time.Now().Add(domain.ImpersonationTokenExpire)
+	token, err := t.generateAccessToken(payload, domain.ImpersonationTokenExpire)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return token, expiresAt, nil
+}
+
+// revokeTokenFamily 吊销令牌族并记录安全事件
+func (t *tokenService) revokeTokenFamily(record *domain.RefreshTokenRecord, client *domain.ClientInfo) {
+	fields := []zap.Field{
+		zap.String("event", "refresh_token_reuse"),
+		zap.Int64("user_id", record.UserID),
+		zap.String("session_id", record.SessionID),
+	}
+	if client != nil {
+		fields = append(fields, zap.String("ip", client.IP), zap.String("user_agent", client.UserAgent))
+	}
+	zap.L().Warn("检测到已轮换的refresh token被重放 吊销整个令牌族", fields...)
+
+	if err := t.removeSession(record.SessionID); err != nil {
+		zap.L().Error("吊销令牌族失败", zap.String("session_id", record.SessionID), zap.Error(err))
+	}
+}
+
+func (t *tokenService) ListSessions(userID int64) ([]*domain.Session, error) {
+	return t.tokenCache.ListUserSessions(userID)
+}
+
+func (t *tokenService) RemoveSession(userID int64, sessionID string) error {
+	session, err := t.tokenCache.GetSession(sessionID)
+	if err != nil {
+		return err
+	}
+
+	// 只能移除自己的会话
+	if session.UserID != userID {
+		return codes.ErrSessionNotFound
+	}
+
+	return t.removeSession(sessionID)
+}
+
+// RemoveSessionByRefreshToken 令牌不存在时视为已吊销
+func (t *tokenService) RemoveSessionByRefreshToken(refreshToken string) error {
+	payload, err := t.tokenCache.ValidateRefreshToken(refreshToken)
+	if err != nil {
+		if errors.Is(err, codes.ErrRefreshTokenNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	return t.removeSession(payload.SessionID)
+}
+
+func (t *tokenService) RemoveUserSessions(userID int64) error {
+	if err := t.tokenCache.RemoveUserSessions(userID); err != nil {
+		return err
+	}
+
+	// 已签发的access token 同时失效 模拟登录令牌有效期可能更长 名单条目需覆盖两者
+	return t.denylist.DenyUserTokensBefore(userID, time.Now(), max(expire, domain.ImpersonationTokenExpire))
+}
+
+// removeSession 删除会话 并吊销该会话已签发的access token
+func (t *tokenService) removeSession(sessionID string) error {
+	if err := t.tokenCache.RemoveSession(sessionID); err != nil {
+		return err
+	}
+	return t.denylist.DenySession(sessionID, expire)
+}
+
+func (t *tokenService) issueTokens(session *domain.Session, parentToken string) (*domain.User2Token, error) {
+	payload := &domain.JwtPayload{
+		UserID:    session.UserID,
+		SessionID: session.ID,
+	}
+
+	// 角色与权限范围在每次签发时重新获取 刷新后即反映最新授权
+	if err := t.claimsProvider.FillClaims(payload); err != nil {
+		return nil, err
+	}
+
+	accessToken, err := t.GenerateAccessToken(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := t.tokenCache.GenRefreshToken(payload, parentToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.tokenCache.SaveSession(session, refreshToken); err != nil {
+		return nil, err
+	}
+
+	return &domain.User2Token{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// applyClientInfo 刷新时客户端信息可能变化 以最近一次请求为准
+func applyClientInfo(session *domain.Session, client *domain.ClientInfo) {
+	if client == nil {
+		return
+	}
+	session.IP = client.IP
+	session.UserAgent = client.UserAgent
+	if client.DeviceName != "" {
+		session.DeviceName = client.DeviceName
+	}
 }
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"scaffold/internal/common/reskit/codes"
+	"scaffold/internal/user/adapters"
+	"scaffold/internal/user/domain"
+)
+
+var testUserSeq atomic.Int64
+
+// newTestTokenService 每次使用新的用户ID 避免用户级吊销影响其他测试
+func newTestTokenService(t *testing.T) (domain.TokenService, int64) {
+	t.Helper()
+
+	userID := 2000 + testUserSeq.Add(1)
+	service := NewTokenService(
+		adapters.NewTokenRedisCache(),
+		adapters.NewAccessTokenDenylist(),
+		newFakeUserRepo(&domain.User{ID: userID, Email: "token@example.com"}),
+		NewTokenClaimsProvider(),
+	)
+	return service, userID
+}
+
+func TestRotateSession(t *testing.T) {
+	service, userID := newTestTokenService(t)
+
+	first, err := service.IssueSession(userID, &domain.ClientInfo{IP: "127.0.0.1", DeviceName: "laptop"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := service.RotateSession(first.RefreshToken, nil)
+	if err != nil {
+		t.Fatalf("轮换refresh token失败: %v", err)
+	}
+	if second.RefreshToken == first.RefreshToken {
+		t.Fatal("轮换后应签发新的refresh token")
+	}
+	if _, err := service.VerifyAccessToken(second.AccessToken); err != nil {
+		t.Fatalf("轮换后签发的access token应有效: %v", err)
+	}
+
+	// 轮换不创建新会话
+	sessions, err := service.ListSessions(userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sessions) != 1 || sessions[0].DeviceName != "laptop" {
+		t.Fatalf("轮换后应保留原会话: %+v", sessions)
+	}
+
+	// 新令牌可以继续轮换
+	if _, err := service.RotateSession(second.RefreshToken, nil); err != nil {
+		t.Fatalf("再次轮换失败: %v", err)
+	}
+}
+
+func TestRotateSessionRejectsUnknownToken(t *testing.T) {
+	service, _ := newTestTokenService(t)
+
+	_, err := service.RotateSession("unknown-refresh-token", nil)
+	assertErrCode(t, err, codes.ErrRefreshTokenNotFound)
+}
+
+func TestRotateSessionDetectsReuse(t *testing.T) {
+	service, userID := newTestTokenService(t)
+
+	first, err := service.IssueSession(userID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := service.RotateSession(first.RefreshToken, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 已轮换的令牌被重放 视为泄露
+	_, err = service.RotateSession(first.RefreshToken, nil)
+	assertErrCode(t, err, codes.ErrRefreshTokenReused)
+
+	// 整个令牌族被吊销 合法持有者手中的新令牌同样失效
+	_, err = service.RotateSession(second.RefreshToken, nil)
+	assertErrCode(t, err, codes.ErrRefreshTokenNotFound)
+
+	_, err = service.VerifyAccessToken(second.AccessToken)
+	assertErrCode(t, err, codes.ErrTokenRevoked)
+
+	sessions, err := service.ListSessions(userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sessions) != 0 {
+		t.Fatalf("令牌族吊销后会话应被删除: %+v", sessions)
+	}
+}
+
+func TestRotateSessionConcurrentRefreshSucceedsOnce(t *testing.T) {
+	service, userID := newTestTokenService(t)
+
+	issued, err := service.IssueSession(userID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const workers = 8
+	var (
+		wg        sync.WaitGroup
+		succeeded atomic.Int32
+		reused    atomic.Int32
+	)
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := service.RotateSession(issued.RefreshToken, nil)
+			switch {
+			case err == nil:
+				succeeded.Add(1)
+			case errors.Is(err, codes.ErrRefreshTokenReused):
+				reused.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if succeeded.Load() != 1 || reused.Load() != workers-1 {
+		t.Fatalf("同一refresh token只能轮换一次 成功 %d 次 判定重放 %d 次", succeeded.Load(), reused.Load())
+	}
+}
+
+func TestRotateSessionRejectsDisabledUser(t *testing.T) {
+	userID := 2000 + testUserSeq.Add(1)
+	userRepo := newFakeUserRepo(&domain.User{ID: userID, Email: "token@example.com"})
+	service := NewTokenService(adapters.NewTokenRedisCache(), adapters.NewAccessTokenDenylist(), userRepo, NewTokenClaimsProvider())
+
+	issued, err := service.IssueSession(userID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	userRepo.users[userID].DisabledAt = time.Now()
+
+	_, err = service.RotateSession(issued.RefreshToken, nil)
+	assertErrCode(t, err, codes.ErrUserDisabled)
+}
+
+func TestRemoveUserSessionsRevokesAccessTokens(t *testing.T) {
+	service, userID := newTestTokenService(t)
+
+	issued, err := service.IssueSession(userID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	impersonation, _, err := service.IssueImpersonationToken(userID, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 令牌通常与吊销发生在同一秒内
+	if err := service.RemoveUserSessions(userID); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, token := range []string{issued.AccessToken, impersonation} {
+		_, err := service.VerifyAccessToken(token)
+		assertErrCode(t, err, codes.ErrTokenRevoked)
+	}
+
+	_, err = service.RotateSession(issued.RefreshToken, nil)
+	assertErrCode(t, err, codes.ErrRefreshTokenNotFound)
+}
@@ -3,6 +3,8 @@ package service
 import (
 	"scaffold/internal/common/reskit/codes"
 	"scaffold/internal/common/utils"
+	"strings"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -12,155 +14,215 @@ import (
 )
 
 type userService struct {
-	userRepo     domain.UserRepository
-	tokenService domain.TokenService
+	userRepo           domain.UserRepository
+	identityRepo       domain.UserIdentityRepository
+	tokenService       domain.TokenService
+	oauthProviders     domain.OAuthProviderRegistry
+	oauthStateCache    domain.OAuthStateCache
+	emailVerifyCache   domain.EmailVerifyCache
+	passwordResetCache domain.PasswordResetCache
+	mailer             domain.UserMailer
+	mfaRepo            domain.UserMFARepository
+	mfaCache           domain.MFACache
+	passkeyRepo        domain.PasskeyRepository
+	passkeyCache       domain.PasskeyCache
+	webAuthn           domain.WebAuthnProvider
+	magicLinkCache     domain.MagicLinkCache
+	smsSender          domain.SmsSender
+	smsCodeCache       domain.SmsCodeCache
+	loginThrottleCache domain.LoginThrottleCache
+	emailChangeCache   domain.EmailChangeCache
+	avatarStorage      domain.AvatarStorage
+	apiKeyRepo         domain.APIKeyRepository
 }
 
 var (
-	githubClientID     string
-	githubClientSecret string
+	emailVerifyURL   string
+	passwordResetURL string
+	mfaIssuer        string
+	magicLinkURL     string
+	emailChangeURL   string
 )
 
-func NewUserService(userRepo domain.UserRepository, tokenService domain.TokenService) domain.UserService {
-	githubClientID = utils.GetEnv("GITHUB_CLIENT_ID")
-	githubClientSecret = utils.GetEnv("GITHUB_CLIENT_SECRET")
+func NewUserService(
+	userRepo domain.UserRepository,
+	identityRepo domain.UserIdentityRepository,
+	tokenService domain.TokenService,
+	oauthProviders domain.OAuthProviderRegistry,
+	oauthStateCache domain.OAuthStateCache,
+	emailVerifyCache domain.EmailVerifyCache,
+	passwordResetCache domain.PasswordResetCache,
+	mailer domain.UserMailer,
+	mfaRepo domain.UserMFARepository,
+	mfaCache domain.MFACache,
+	passkeyRepo domain.PasskeyRepository,
+	passkeyCache domain.PasskeyCache,
+	webAuthn domain.WebAuthnProvider,
+	magicLinkCache domain.MagicLinkCache,
+	smsSender domain.SmsSender,
+	smsCodeCache domain.SmsCodeCache,
+	loginThrottleCache domain.LoginThrottleCache,
+	emailChangeCache domain.EmailChangeCache,
+	avatarStorage domain.AvatarStorage,
+	apiKeyRepo domain.APIKeyRepository,
+) domain.UserService {
+	emailVerifyURL = utils.GetEnv("EMAIL_VERIFY_URL")
+	passwordResetURL = utils.GetEnv("PASSWORD_RESET_URL")
+	mfaIssuer = utils.GetEnvWithDefault("MFA_ISSUER", "scaffold")
+	magicLinkURL = utils.GetEnv("MAGIC_LINK_URL")
+	emailChangeURL = utils.GetEnv("EMAIL_CHANGE_URL")
 
 	return &userService{
-		userRepo:     userRepo,
-		tokenService: tokenService,
+		userRepo:           userRepo,
+		identityRepo:       identityRepo,
+		tokenService:       tokenService,
+		oauthProviders:     oauthProviders,
+		oauthStateCache:    oauthStateCache,
+		emailVerifyCache:   emailVerifyCache,
+		passwordResetCache: passwordResetCache,
+		mailer:             mailer,
+		mfaRepo:            mfaRepo,
+		mfaCache:           mfaCache,
+		passkeyRepo:        passkeyRepo,
+		passkeyCache:       passkeyCache,
+		webAuthn:           webAuthn,
+		magicLinkCache:     magicLinkCache,
+		smsSender:          smsSender,
+		smsCodeCache:       smsCodeCache,
+		loginThrottleCache: loginThrottleCache,
+		emailChangeCache:   emailChangeCache,
+		avatarStorage:      avatarStorage,
+		apiKeyRepo:         apiKeyRepo,
 	}
 }
 
-func (s *userService) AuthenticateWithOAuth(provider string, userInfo *domain.OAuthUserInfo) (
-	*domain.User2Token, error,
-) {
-	// 1. 查找或创建用户
+func (s *userService) AuthenticateWithOAuth(provider, code, state, nonce string, client *domain.ClientInfo) (*domain.LoginResult, error) {
+	// 1. 校验 state 并获取第三方用户信息
+	userInfo, err := s.exchangeOAuthCode(provider, code, state, nonce, &domain.OAuthState{Purpose: domain.OAuthPurposeLogin})
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 查找或创建用户
 	user, _, err := s.findOrCreateUserByOAuth(provider, userInfo)
 	if err != nil {
 		return nil, err
 	}
 
-	// 2. 更新最后登录时间
+	// 3. 更新最后登录时间
 	if err := s.userRepo.UpdateLastLogin(user.ID); err != nil {
 		// 这个错误不应该阻止登录流程，记录日志即可
 		zap.L().Error("更新用户最后登录时间失败", zap.Int64("user_id", user.ID), zap.Error(err))
 	}
 
-	// 3. 生成 Token
-	payload := &domain.JwtPayload{
-		UserID: user.ID,
+	// 4. 生成 Token 开启两步验证时返回待验证令牌
+	return s.beginLogin(user.ID, client)
+}
+
+func (s *userService) Register(email, password, nickname string, client *domain.ClientInfo) (*domain.User2Token, error) {
+	email = normalizeEmail(email)
+
+	// 1. 校验邮箱是否已被使用
+	exists, err := s.userRepo.EmailExists(email)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if exists {
+		return nil, codes.ErrEmailAlreadyExists
 	}
 
-	accessToken, err := s.tokenService.GenerateAccessToken(payload)
+	// 2. 加密密码
+	hash, err := utils.EncryptPassword(password)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
-	refreshToken, err := s.tokenService.GenerateRefreshToken(payload)
+	// 3. 创建用户
+	user, err := s.userRepo.Create(&domain.User{
+		Email:        email,
+		PasswordHash: string(hash),
+		Nickname:     nickname,
+		LastLoginAt:  time.Now(),
+	})
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
-	return &domain.User2Token{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-	}, nil
+	// 4. 异步发送验证邮件 发送失败不影响注册 用户可稍后重发
+	go s.sendVerificationOnRegister(user)
+
+	// 5. 生成 Token
+	return s.tokenService.IssueSession(user.ID, client)
 }
 
-func (s *userService) RefreshUserToken(refreshToken string) (*domain.User2Token, error) {
-	//1 . 生成新的 access token
-	accessToken, err := s.tokenService.RefreshAccessToken(refreshToken)
-	if err != nil {
-		return nil, err
+func (s *userService) Login(email, password string, client *domain.ClientInfo) (*domain.LoginResult, error) {
+	account := normalizeEmail(email)
+	ip := ""
+	if client != nil {
+		ip = client.IP
 	}
 
-	//2. 获取payload
-	payload, err := s.tokenService.ParseAccessToken(accessToken)
-	if err != nil {
+	// 1. 账号锁定或失败过多时直接拒绝 不校验密码
+	if err := s.checkLoginAllowed(account, ip); err != nil {
 		return nil, err
 	}
 
-	//3. 生成新的refresh token
-	newRefreshToken, err := s.tokenService.GenerateRefreshToken(payload)
+	// 2. 查找用户 不区分用户不存在与密码错误 避免泄露邮箱是否注册
+	user, err := s.userRepo.FindByEmail(account)
 	if err != nil {
-		return nil, err
+		if errors.Is(err, codes.ErrUserNotFound) {
+			return nil, s.recordLoginFailure(account, ip)
+		}
+		return nil, errors.WithStack(err)
 	}
 
-	//4. 移除旧的refresh token
-	if err := s.tokenService.RemoveRefreshToken(refreshToken); err != nil {
-		return nil, err
+	// 3. 校验密码 仅通过OAuth注册的用户没有密码
+	if !user.HasPassword() || !utils.ComparePassword(user.PasswordHash, password) {
+		return nil, s.recordLoginFailure(account, ip)
 	}
+	s.resetLoginFailures(account)
 
-	return &domain.User2Token{
-		AccessToken:  accessToken,
-		RefreshToken: newRefreshToken,
-	}, nil
-}
-
-// 私有辅助方法
-func (s *userService) findOrCreateUserByOAuth(provider string, userInfo *domain.OAuthUserInfo) (
-	user *domain.User, isNew bool, err error,
-) {
-	// 1. 先通过 OAuth ID 查找
-	user, err = s.userRepo.FindByOAuthID(provider, userInfo.ID)
-	if err == nil {
-		// 找到用户，更新信息
-		return user, false, nil
-	}
-
-	if !errors.Is(err, codes.ErrUserNotFound) {
-		return nil, false, errors.WithStack(err)
-	}
-
-	// 2. 通过邮箱查找现有用户
-	if userInfo.Email != "" {
-		user, err = s.userRepo.FindByEmail(userInfo.Email)
-		if err == nil {
-			// 绑定 OAuth 到现有用户
-			user, err = s.bindOAuthToUser(user, provider, userInfo)
-			return user, false, err
-		}
-
-		if !errors.Is(err, codes.ErrUserNotFound) {
-			return nil, false, errors.WithStack(err)
-		}
+	// 4. 更新最后登录时间
+	if err := s.userRepo.UpdateLastLogin(user.ID); err != nil {
+		zap.L().Error("更新用户最后登录时间失败", zap.Int64("user_id", user.ID), zap.Error(err))
 	}
 
-	// 3. 创建新用户
-	user, err = s.createUserFromOAuth(provider, userInfo)
-	return user, true, err
+	// 5. 生成 Token 开启两步验证时返回待验证令牌
+	return s.beginLogin(user.ID, client)
 }
 
-func (s *userService) createUserFromOAuth(provider string, userInfo *domain.OAuthUserInfo) (*domain.User, error) {
-	user := &domain.User{
-		Email:    userInfo.Email,
-		Nickname: userInfo.Nickname,
+func (s *userService) RefreshUserToken(refreshToken string, client *domain.ClientInfo) (*domain.User2Token, error) {
+	return s.tokenService.RotateSession(refreshToken, client)
+}
+
+// Logout 结束refresh token所属会话 同时吊销请求携带的access token
+func (s *userService) Logout(refreshToken, accessToken string) error {
+	if err := s.tokenService.RemoveSessionByRefreshToken(refreshToken); err != nil {
+		return err
 	}
 
-	// 设置 OAuth ID
-	switch provider {
-	case "github":
-		user.GithubID = userInfo.ID
+	if accessToken == "" {
+		return nil
 	}
+	return s.tokenService.RevokeAccessToken(accessToken)
+}
 
-	return s.userRepo.Create(user)
+// LogoutAll 结束用户在所有设备上的会话
+func (s *userService) LogoutAll(userID int64) error {
+	return s.tokenService.RemoveUserSessions(userID)
 }
 
-func (s *userService) bindOAuthToUser(user *domain.User, provider string, userInfo *domain.OAuthUserInfo) (
-	*domain.User, error,
-) {
-	// 设置 OAuth ID
-	switch provider {
-	case "github":
-		user.GithubID = userInfo.ID
-	}
+func (s *userService) ListSessions(userID int64) ([]*domain.Session, error) {
+	return s.tokenService.ListSessions(userID)
+}
 
-	// 更新头像等信息
-	if userInfo.Avatar != "" {
-		user.Avatar = userInfo.Avatar
-	}
+func (s *userService) RevokeSession(userID int64, sessionID string) error {
+	return s.tokenService.RemoveSession(userID, sessionID)
+}
 
-	return s.userRepo.Update(user)
+// 私有辅助方法
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
 }
 
 func (s *userService) GetUser(id int64) (*domain.User, error) {
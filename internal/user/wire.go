@@ -4,7 +4,11 @@
 package user
 
 import (
+	rbacadapters "scaffold/internal/rbac/adapters"
+	rbacdomain "scaffold/internal/rbac/domain"
+	rbacservice "scaffold/internal/rbac/service"
 	"scaffold/internal/user/adapters"
+	"scaffold/internal/user/domain"
 	"scaffold/internal/user/handler"
 	"scaffold/internal/user/service"
 	"github.com/gin-gonic/gin"
@@ -16,9 +20,38 @@ func InitV1(r *gin.RouterGroup) func() {
 		RegisterV1,
 		handler.NewHttpHandler,
 		service.NewTokenService,
+		rbacservice.NewTokenClaimsProvider,
+		rbacadapters.NewUserRolePSQLRepository,
+		rbacservice.NewRBACService,
+		rbacadapters.NewRolePSQLRepository,
+		rbacadapters.NewPermissionPSQLRepository,
+		rbacadapters.NewPermissionRedisCache,
+		wire.Bind(new(domain.PermissionChecker), new(rbacdomain.RBACService)),
 		service.NewUserService,
+		service.NewAPIKeyService,
+		service.NewUserAdminService,
 		adapters.NewUserPSQLRepository,
+		adapters.NewUserIdentityPSQLRepository,
+		adapters.NewAPIKeyPSQLRepository,
+		adapters.NewUserMFAPSQLRepository,
+		adapters.NewPasskeyPSQLRepository,
 		adapters.NewTokenRedisCache,
+		adapters.NewAccessTokenDenylist,
+		adapters.NewOAuthProviderRegistry,
+		adapters.NewOAuthStateRedisCache,
+		adapters.NewEmailVerifyRedisCache,
+		adapters.NewPasswordResetRedisCache,
+		adapters.NewUserMailer,
+		adapters.NewMFARedisCache,
+		adapters.NewPasskeyRedisCache,
+		adapters.NewMagicLinkRedisCache,
+		adapters.NewWebAuthnProvider,
+		adapters.NewSmsSender,
+		adapters.NewSmsCodeRedisCache,
+		adapters.NewLoginThrottleRedisCache,
+		adapters.NewEmailChangeRedisCache,
+		adapters.NewAvatarStorage,
+		adapters.NewAuditLogPSQLRepository,
 	)
 	return nil
 }
@@ -8,19 +8,49 @@ package user
 
 import (
 	"github.com/gin-gonic/gin"
+	adapters2 "scaffold/internal/rbac/adapters"
+	"scaffold/internal/rbac/service"
 	"scaffold/internal/user/adapters"
 	"scaffold/internal/user/handler"
-	"scaffold/internal/user/service"
+	service2 "scaffold/internal/user/service"
 )
 
 // Injectors from wire.go:
 
 func InitV1(r *gin.RouterGroup) func() {
 	userRepository := adapters.NewUserPSQLRepository()
+	userIdentityRepository := adapters.NewUserIdentityPSQLRepository()
 	tokenCache := adapters.NewTokenRedisCache()
-	tokenService := service.NewTokenService(tokenCache, userRepository)
-	userService := service.NewUserService(userRepository, tokenService)
-	httpHandler := handler.NewHttpHandler(userService)
-	v := RegisterV1(r, httpHandler)
+	accessTokenDenylist := adapters.NewAccessTokenDenylist()
+	userRoleRepository := adapters2.NewUserRolePSQLRepository()
+	tokenClaimsProvider := service.NewTokenClaimsProvider(userRoleRepository)
+	tokenService := service2.NewTokenService(tokenCache, accessTokenDenylist, userRepository, tokenClaimsProvider)
+	oAuthProviderRegistry := adapters.NewOAuthProviderRegistry()
+	oAuthStateCache := adapters.NewOAuthStateRedisCache()
+	emailVerifyCache := adapters.NewEmailVerifyRedisCache()
+	passwordResetCache := adapters.NewPasswordResetRedisCache()
+	userMailer := adapters.NewUserMailer()
+	userMFARepository := adapters.NewUserMFAPSQLRepository()
+	mfaCache := adapters.NewMFARedisCache()
+	passkeyRepository := adapters.NewPasskeyPSQLRepository()
+	passkeyCache := adapters.NewPasskeyRedisCache()
+	webAuthnProvider := adapters.NewWebAuthnProvider()
+	magicLinkCache := adapters.NewMagicLinkRedisCache()
+	smsSender := adapters.NewSmsSender()
+	smsCodeCache := adapters.NewSmsCodeRedisCache()
+	loginThrottleCache := adapters.NewLoginThrottleRedisCache()
+	emailChangeCache := adapters.NewEmailChangeRedisCache()
+	avatarStorage := adapters.NewAvatarStorage()
+	apiKeyRepository := adapters.NewAPIKeyPSQLRepository()
+	userService := service2.NewUserService(userRepository, userIdentityRepository, tokenService, oAuthProviderRegistry, oAuthStateCache, emailVerifyCache, passwordResetCache, userMailer, userMFARepository, mfaCache, passkeyRepository, passkeyCache, webAuthnProvider, magicLinkCache, smsSender, smsCodeCache, loginThrottleCache, emailChangeCache, avatarStorage, apiKeyRepository)
+	apiKeyService := service2.NewAPIKeyService(apiKeyRepository, userRepository, tokenClaimsProvider)
+	auditLogRepository := adapters.NewAuditLogPSQLRepository()
+	roleRepository := adapters2.NewRolePSQLRepository()
+	permissionRepository := adapters2.NewPermissionPSQLRepository()
+	permissionCache := adapters2.NewPermissionRedisCache()
+	rbacService := service.NewRBACService(roleRepository, permissionRepository, userRoleRepository, permissionCache)
+	userAdminService := service2.NewUserAdminService(userRepository, userIdentityRepository, userMFARepository, tokenService, auditLogRepository, rbacService)
+	httpHandler := handler.NewHttpHandler(userService, apiKeyService, userAdminService)
+	v := RegisterV1(r, httpHandler, userService)
 	return v
 }
@@ -10,8 +10,11 @@ import (
 	"scaffold/internal/common/logger"
 	"scaffold/internal/common/metrics"
 	"scaffold/internal/common/server"
+	"scaffold/internal/common/storage"
 	"scaffold/internal/common/uid"
 	"scaffold/internal/common/utils"
+	"scaffold/internal/organization"
+	"scaffold/internal/rbac"
 	"scaffold/internal/user"
 	"time"
 
@@ -126,13 +129,21 @@ func main() {
 	metricsClient := metrics.NewPrometheusClient()
 	metrics.StartPrometheusServer()
 
+	// 各模块后台任务的停止函数 关闭服务时调用
+	var stopUserJobs func()
+
 	server.RunHttpServer(utils.GetEnv("SERVER_PORT"), metricsClient, func(r *gin.RouterGroup) {
 		r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerfiles.Handler,
 			ginSwagger.PersistAuthorization(true)))
 
-		user.InitV1(r)
+		storage.RegisterStatic(r)
+
+		stopUserJobs = user.InitV1(r)
 		captcha.InitV1(r)
+		rbac.InitV1(r)
+		organization.InitV1(r)
 	},
+		func() { stopUserJobs() },
 		clear,
 	)
 }
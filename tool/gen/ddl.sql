@@ -1,6 +1,7 @@
 CREATE TABLE public.$Domain
 (
     id          bigserial primary key ,
+    user_id     bigint    NOT NULL,
     title       varchar   NOT NULL,
     description varchar   NULL,
     created_at  timestamptz(6)    NOT NULL,